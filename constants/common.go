@@ -1,10 +1,182 @@
 package constants
 
+import "time"
+
 const (
 	CodeSystem = "customer-voucher-service"
 	FormatDate = "2006-01-02 15:04:05"
+	FormatDay  = "2006-01-02"
 )
 
 const (
 	Name = "Name"
 )
+
+const (
+	// DefaultProblemTypeBaseURI prefixes the type of the problem details served on
+	// /api/v2 unless PROBLEM_TYPE_BASE_URI is set. Clients resolve it against the
+	// request URL, as RFC 7807 allows for relative references.
+	DefaultProblemTypeBaseURI = "/problems/"
+)
+
+const (
+	DefaultGrpcPort = "9090"
+
+	InterceptorRecovery   = "recovery"
+	InterceptorRequestID  = "request_id"
+	InterceptorLogging    = "logging"
+	InterceptorMetrics    = "metrics"
+	InterceptorErrors     = "errors"
+	InterceptorDeadline   = "deadline"
+	InterceptorAuth       = "auth"
+	InterceptorValidation = "validation"
+
+	DefaultGrpcInterceptors      = "recovery,request_id,logging,metrics,errors,deadline,auth,validation"
+	DefaultGrpcTimeoutSeconds    = 30
+	DefaultGrpcMaxTimeoutSeconds = 120
+
+	DefaultShutdownTimeoutSeconds = 30
+)
+
+const (
+	TransactionStatusSuccess     int32 = 1
+	TransactionStatusGiftPending int32 = 2
+	TransactionStatusGiftClaimed int32 = 3
+	TransactionStatusGiftExpired int32 = 4
+	TransactionStatusHeld        int32 = 5
+	TransactionStatusRejected    int32 = 6
+)
+
+const (
+	GiftExpiryDuration      = 7 * 24 * time.Hour
+	GiftExpiryCheckInterval = 1 * time.Hour
+)
+
+const (
+	ReferralCodeLength                 = 8
+	DefaultReferralReferrerBonusPoints = 500
+	DefaultReferralRefereeBonusPoints  = 250
+	DefaultReferralMaxPerReferrer      = 20
+)
+
+const (
+	SearchTypeVoucher  = "voucher"
+	SearchTypeBrand    = "brand"
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+const (
+	TransactionSortByRedeemDate = "redeemDate"
+	TransactionSortByTotal      = "total"
+	SortOrderAsc                = "asc"
+	SortOrderDesc               = "desc"
+)
+
+const (
+	BrandDeletedReason = "brand deleted"
+)
+
+const (
+	RoleAdmin         = "admin"
+	RoleBrandOperator = "brand_operator"
+	RoleCustomer      = "customer"
+	RoleApiKey        = "api_key"
+)
+
+const (
+	PermissionBrandRead         = "brand:read"
+	PermissionBrandWrite        = "brand:write"
+	PermissionVoucherRead       = "voucher:read"
+	PermissionVoucherWrite      = "voucher:write"
+	PermissionCategoryRead      = "category:read"
+	PermissionCategoryWrite     = "category:write"
+	PermissionCustomerRead      = "customer:read"
+	PermissionCustomerWrite     = "customer:write"
+	PermissionTransactionRead   = "transaction:read"
+	PermissionTransactionRedeem = "transaction:redeem"
+	PermissionAuditRead         = "audit:read"
+	PermissionApiKeyRead        = "api_key:read"
+	PermissionApiKeyWrite       = "api_key:write"
+	PermissionVoucherBurn       = "voucher:burn"
+	PermissionFraudReview       = "fraud:review"
+	PermissionMetricsRead       = "metrics:read"
+)
+
+const (
+	ScopeVouchersRead  = "vouchers:read"
+	ScopeVouchersWrite = "vouchers:write"
+	ScopeCodesBurn     = "codes:burn"
+)
+
+const (
+	HeaderApiKey   = "X-API-Key"
+	MetadataApiKey = "x-api-key"
+	ApiKeyPrefix   = "cvs"
+)
+
+const (
+	FraudDecisionAllow = "allow"
+	FraudDecisionHold  = "hold"
+	FraudDecisionBlock = "block"
+
+	FraudRuleHighCostVelocity = "high_cost_velocity"
+	FraudRuleNewAccountDrain  = "new_account_drain"
+	FraudRuleDeviceSpread     = "device_spread"
+
+	FraudReviewStatusPending  = "pending"
+	FraudReviewStatusApproved = "approved"
+	FraudReviewStatusRejected = "rejected"
+
+	DefaultFraudHighCostPoints         = 1000
+	DefaultFraudHighCostMaxRedemptions = 3
+	DefaultFraudHighCostWindowMinutes  = 10
+	DefaultFraudNewAccountDays         = 7
+	DefaultFraudPointsSetWindowHours   = 24
+	DefaultFraudDrainPercent           = 80
+	DefaultFraudDeviceMaxCustomers     = 3
+	DefaultFraudDeviceWindowHours      = 1
+)
+
+const (
+	RateLimitRedemption  = "redemption"
+	RateLimitWrite       = "write"
+	RateLimitAuthFailure = "auth_failure"
+
+	DefaultRateLimitRedemption  = "10/1m"
+	DefaultRateLimitWrite       = "60/1m"
+	DefaultRateLimitAuthFailure = "10/1m"
+
+	HeaderRetryAfter   = "Retry-After"
+	MetadataRetryAfter = "retry-after"
+)
+
+const (
+	DefaultRecentTransactionLimit = 5
+	MaxRecentTransactionLimit     = 50
+)
+
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionDelete  = "delete"
+	AuditActionRestore = "restore"
+
+	AuditEntityBrand       = "brand"
+	AuditEntityVoucher     = "voucher"
+	AuditEntityCategory    = "category"
+	AuditEntityTag         = "tag"
+	AuditEntityCustomer    = "customer"
+	AuditEntityTransaction = "transaction"
+	AuditEntityApiKey      = "api_key"
+)
+
+const (
+	HeaderRequestID   = "X-Request-ID"
+	MetadataRequestID = "x-request-id"
+)
@@ -1,41 +1,195 @@
 package error_base
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Domain is sent as the ErrorInfo domain of every gRPC error.
+const Domain = "customer-voucher-service"
+
+// AppError is a domain error. Handlers answer with HttpCode, and gRPC calls end with
+// GrpcCode and an ErrorInfo carrying Reason and Code. Validation errors list the
+// fields that failed in Fields.
 type AppError struct {
 	HttpCode int
+	GrpcCode codes.Code
 	Code     string
+	Reason   string
 	Message  string
+	Fields   []FieldError
+}
+
+// FieldError is one field that failed validation, with the rule it broke.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (e AppError) Error() string {
+	return e.Message
+}
+
+// Is matches errors with the same Code, so errors.Is(err, ErrNotFound) holds whatever
+// the message says.
+func (e AppError) Is(target error) bool {
+	t, ok := target.(AppError)
+	return ok && t.Code == e.Code
+}
+
+// WithMessage keeps the codes and replaces the message.
+func (e AppError) WithMessage(message string) AppError {
+	e.Message = message
+	return e
+}
+
+func (e AppError) WithMessagef(format string, args ...interface{}) AppError {
+	return e.WithMessage(fmt.Sprintf(format, args...))
+}
+
+// WithFields keeps the codes and attaches the failed fields.
+func (e AppError) WithFields(fields ...FieldError) AppError {
+	e.Fields = fields
+	return e
+}
+
+// GRPCStatus is picked up by grpc-go and status.FromError. The failed fields are
+// sent as a google.rpc.BadRequest detail.
+func (e AppError) GRPCStatus() *status.Status {
+	st := status.New(e.GrpcCode, e.Message)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   e.Reason,
+		Domain:   Domain,
+		Metadata: map[string]string{"code": e.Code},
+	}}
+	if len(e.Fields) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, field := range e.Fields {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field.Field,
+				Reason:      field.Rule,
+				Description: field.Message,
+			})
+		}
+		details = append(details, badRequest)
+	}
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return withDetails
 }
 
 var (
 	ErrInvalidCredentials = AppError{
 		HttpCode: http.StatusUnauthorized,
+		GrpcCode: codes.Unauthenticated,
 		Code:     "4010",
-		Message:  "Username or password is incorrect",
+		Reason:   "INVALID_CREDENTIALS",
+		Message:  "Invalid or missing credentials",
 	}
 
 	ErrUserNotFound = AppError{
 		HttpCode: http.StatusUnauthorized,
+		GrpcCode: codes.Unauthenticated,
 		Code:     "4011",
+		Reason:   "USER_NOT_FOUND",
 		Message:  "User not found",
 	}
 
+	ErrForbidden = AppError{
+		HttpCode: http.StatusForbidden,
+		GrpcCode: codes.PermissionDenied,
+		Code:     "4031",
+		Reason:   "FORBIDDEN",
+		Message:  "You do not have permission to perform this action",
+	}
+
+	ErrRedemptionBlocked = AppError{
+		HttpCode: http.StatusForbidden,
+		GrpcCode: codes.PermissionDenied,
+		Code:     "4032",
+		Reason:   "REDEMPTION_BLOCKED",
+		Message:  "Redemption was declined",
+	}
+
 	ErrValidationFailed = AppError{
 		HttpCode: http.StatusBadRequest,
+		GrpcCode: codes.InvalidArgument,
 		Code:     "4001",
+		Reason:   "VALIDATION_FAILED",
 		Message:  "Invalid",
 	}
 
+	ErrNotFound = AppError{
+		HttpCode: http.StatusNotFound,
+		GrpcCode: codes.NotFound,
+		Code:     "4041",
+		Reason:   "NOT_FOUND",
+		Message:  "Not found",
+	}
+
+	ErrEmailAlreadyExists = AppError{
+		HttpCode: http.StatusConflict,
+		GrpcCode: codes.AlreadyExists,
+		Code:     "4091",
+		Reason:   "EMAIL_ALREADY_EXISTS",
+		Message:  "Email already exists",
+	}
+
+	ErrBrandHasActiveVouchers = AppError{
+		HttpCode: http.StatusConflict,
+		GrpcCode: codes.FailedPrecondition,
+		Code:     "4092",
+		Reason:   "BRAND_HAS_ACTIVE_VOUCHERS",
+		Message:  "Brand still has active vouchers",
+	}
+
+	// ErrInvalidState is for requests that are valid, but not for the resource as it is
+	// now, e.g. claiming a gift that was already claimed.
+	ErrInvalidState = AppError{
+		HttpCode: http.StatusConflict,
+		GrpcCode: codes.FailedPrecondition,
+		Code:     "4093",
+		Reason:   "INVALID_STATE",
+		Message:  "The resource is not in a state that allows this action",
+	}
+
+	ErrInsufficientPoints = AppError{
+		HttpCode: http.StatusUnprocessableEntity,
+		GrpcCode: codes.FailedPrecondition,
+		Code:     "4221",
+		Reason:   "INSUFFICIENT_POINTS",
+		Message:  "not enough points to redeem",
+	}
+
+	ErrTooManyRequests = AppError{
+		HttpCode: http.StatusTooManyRequests,
+		GrpcCode: codes.ResourceExhausted,
+		Code:     "4291",
+		Reason:   "RATE_LIMITED",
+		Message:  "Too many requests, try again later",
+	}
+
 	ErrInternalServer = AppError{
 		HttpCode: http.StatusInternalServerError,
+		GrpcCode: codes.Internal,
 		Code:     "5001",
+		Reason:   "INTERNAL",
 		Message:  "Something went wrong",
 	}
 
 	ErrDB = AppError{
 		HttpCode: http.StatusInternalServerError,
+		GrpcCode: codes.Internal,
 		Code:     "5002",
+		Reason:   "DATABASE",
 		Message:  "Something went wrong",
 	}
 )
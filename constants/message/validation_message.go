@@ -1,6 +1,9 @@
 package message
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 const (
 	Name  = "name"
@@ -26,3 +29,43 @@ func InvalidFormatMessage(label string) string {
 func NotFoundMessage(label string) string {
 	return fmt.Sprintf("%s not found", label)
 }
+
+func MinLengthMessage(label string, n int) string {
+	return fmt.Sprintf("%s must be at least %d characters", label, n)
+}
+
+func MinItemsMessage(label string, n int) string {
+	return fmt.Sprintf("%s must have at least %d items", label, n)
+}
+
+func MaxItemsMessage(label string, n int) string {
+	return fmt.Sprintf("%s must have at most %d items", label, n)
+}
+
+func GteMessage(label string, min string) string {
+	return fmt.Sprintf("%s must be at least %s", label, min)
+}
+
+func LteMessage(label string, max string) string {
+	return fmt.Sprintf("%s must be at most %s", label, max)
+}
+
+func OneOfMessage(label string, values []string) string {
+	return fmt.Sprintf("%s must be one of %s", label, strings.Join(values, ", "))
+}
+
+func URLMessage(label string) string {
+	return fmt.Sprintf("%s must be a valid URL", label)
+}
+
+func VoucherCodeMessage(label string) string {
+	return fmt.Sprintf("%s must contain only uppercase letters, digits, dashes and underscores", label)
+}
+
+func InvalidTypeMessage(label string, kind string) string {
+	return fmt.Sprintf("%s must be %s", label, kind)
+}
+
+func LimitMessage(label string, max int, def int) string {
+	return fmt.Sprintf("%s must be between 1 and %d, or 0 for the default of %d", label, max, def)
+}
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/env"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+const (
+	createdByField  = "CreatedBy"
+	modifiedByField = "ModifiedBy"
+)
+
+// RegisterAuditCallbacks stamps CreatedBy and ModifiedBy on every create and update
+// from the caller in the statement context, so repos must run with db.WithContext(ctx).
+// Without a caller, e.g. in background jobs, SYSTEM_IDENTITY is used. A value the
+// repo already set is kept, and UpdateColumn(s) is left alone like it is for hooks.
+func RegisterAuditCallbacks(db *gorm.DB) error {
+	err := db.Callback().Create().Before("gorm:create").Register("audit:stamp_create", stampCreate)
+	if err != nil {
+		return err
+	}
+	return db.Callback().Update().Before("gorm:update").Register("audit:stamp_update", stampUpdate)
+}
+
+func actor(db *gorm.DB) string {
+	return auth.Actor(db.Statement.Context, env.GetString("SYSTEM_IDENTITY", "system"))
+}
+
+func stampCreate(db *gorm.DB) {
+	if db.Statement.Schema == nil {
+		return
+	}
+	value := actor(db)
+	for _, name := range []string{createdByField, modifiedByField} {
+		field := db.Statement.Schema.LookUpField(name)
+		if field == nil {
+			continue
+		}
+		switch db.Statement.ReflectValue.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < db.Statement.ReflectValue.Len(); i++ {
+				stampField(db, field, reflect.Indirect(db.Statement.ReflectValue.Index(i)), value)
+			}
+		case reflect.Struct:
+			stampField(db, field, db.Statement.ReflectValue, value)
+		}
+	}
+}
+
+func stampField(db *gorm.DB, field *schema.Field, target reflect.Value, value string) {
+	if _, zero := field.ValueOf(db.Statement.Context, target); zero {
+		db.AddError(field.Set(db.Statement.Context, target, value))
+	}
+}
+
+func stampUpdate(db *gorm.DB) {
+	stmt := db.Statement
+	if stmt.Schema == nil || stmt.SkipHooks {
+		return
+	}
+	field := stmt.Schema.LookUpField(modifiedByField)
+	if field == nil || isModifiedBySet(stmt, field) {
+		return
+	}
+	stmt.SetColumn(field.DBName, actor(db), true)
+	if len(stmt.Selects) > 0 && !isSelected(stmt, field) {
+		stmt.Selects = append(stmt.Selects, field.DBName)
+	}
+}
+
+// isModifiedBySet reports whether the update already writes a non-empty modified_by.
+func isModifiedBySet(stmt *gorm.Statement, field *schema.Field) bool {
+	if len(stmt.Selects) > 0 && !isSelected(stmt, field) {
+		return false
+	}
+	switch dest := stmt.Dest.(type) {
+	case map[string]interface{}:
+		for _, key := range []string{field.DBName, field.Name} {
+			if value, ok := dest[key]; ok && value != "" {
+				return true
+			}
+		}
+		return false
+	}
+	destValue := reflect.Indirect(reflect.ValueOf(stmt.Dest))
+	if destValue.Kind() != reflect.Struct {
+		return false
+	}
+	_, zero := field.ValueOf(stmt.Context, destValue)
+	return !zero
+}
+
+func isSelected(stmt *gorm.Statement, field *schema.Field) bool {
+	for _, column := range stmt.Selects {
+		if column == "*" || column == field.DBName || column == field.Name {
+			return true
+		}
+	}
+	return false
+}
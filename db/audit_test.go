@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"customer-voucher-service/models/brand_model"
+	"customer-voucher-service/models/transaction_model"
+	"customer-voucher-service/models/voucher_model"
+	"customer-voucher-service/utils/auth"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func setupAuditDB(t *testing.T) (*gorm.DB, func()) {
+	sqlDB, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{DryRun: true, SkipDefaultTransaction: true})
+	if err != nil {
+		t.Fatalf("failed to open gorm DB: %v", err)
+	}
+	if err := RegisterAuditCallbacks(gormDB); err != nil {
+		t.Fatalf("failed to register callbacks: %v", err)
+	}
+	return gormDB, func() { sqlDB.Close() }
+}
+
+func callerContext() context.Context {
+	return auth.WithCaller(context.Background(), &auth.Caller{ID: "user-1"})
+}
+
+func TestStampCreate_FromCaller(t *testing.T) {
+	db, closeFn := setupAuditDB(t)
+	defer closeFn()
+
+	brand := &brand_model.Brand{Name: "Kopi"}
+	err := db.WithContext(callerContext()).Create(brand).Error
+
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", brand.CreatedBy)
+	assert.Equal(t, "user-1", brand.ModifiedBy)
+}
+
+func TestStampCreate_SystemIdentity(t *testing.T) {
+	t.Setenv("SYSTEM_IDENTITY", "gift-expiry-job")
+	db, closeFn := setupAuditDB(t)
+	defer closeFn()
+
+	tags := []voucher_model.Tag{{Name: "food"}, {Name: "drink"}}
+	transaction := &transaction_model.Transaction{CustomerID: 1, CreatedBy: "kept"}
+	assert.NoError(t, db.Create(&tags).Error)
+	assert.NoError(t, db.Create(transaction).Error)
+
+	assert.Equal(t, "kept", transaction.CreatedBy)
+	assert.Equal(t, "gift-expiry-job", transaction.ModifiedBy)
+}
+
+func TestStampUpdate_SelectedStruct(t *testing.T) {
+	db, closeFn := setupAuditDB(t)
+	defer closeFn()
+
+	stmt := db.WithContext(callerContext()).Model(&voucher_model.Voucher{}).Where("id = ?", 1).
+		Select("name").Updates(&voucher_model.Voucher{Name: "New"}).Statement
+
+	assert.Contains(t, stmt.SQL.String(), `"modified_by"=`)
+	assert.Contains(t, stmt.Vars, "user-1")
+}
+
+func TestStampUpdate_Map(t *testing.T) {
+	db, closeFn := setupAuditDB(t)
+	defer closeFn()
+
+	stmt := db.WithContext(callerContext()).Model(&transaction_model.Transaction{}).Where("id = ?", 1).
+		Update("status", 3).Statement
+
+	assert.Contains(t, stmt.SQL.String(), `"modified_by"=`)
+	assert.Contains(t, stmt.Vars, "user-1")
+}
+
+func TestStampUpdate_KeepsExplicitValue(t *testing.T) {
+	db, closeFn := setupAuditDB(t)
+	defer closeFn()
+
+	stmt := db.WithContext(callerContext()).Model(&brand_model.Brand{}).Where("id = ?", 1).
+		Updates(map[string]interface{}{"is_deleted": true, "modified_by": "admin"}).Statement
+
+	assert.Contains(t, stmt.Vars, "admin")
+	assert.NotContains(t, stmt.Vars, "user-1")
+}
+
+func TestStampUpdate_SkipsUpdateColumn(t *testing.T) {
+	db, closeFn := setupAuditDB(t)
+	defer closeFn()
+
+	stmt := db.WithContext(callerContext()).Model(&brand_model.Brand{}).Where("id = ?", 1).
+		UpdateColumn("name", "Kopi").Statement
+
+	assert.NotContains(t, stmt.SQL.String(), "modified_by")
+}
@@ -1,8 +1,13 @@
 package db
 
 import (
+	"customer-voucher-service/models/api_key_model"
+	"customer-voucher-service/models/audit_model"
 	"customer-voucher-service/models/brand_model"
+	"customer-voucher-service/models/category_model"
 	"customer-voucher-service/models/customer_model"
+	"customer-voucher-service/models/fraud_model"
+	"customer-voucher-service/models/search_model"
 	"customer-voucher-service/models/transaction_model"
 	"customer-voucher-service/models/voucher_model"
 	"fmt"
@@ -36,19 +41,53 @@ func InitDB() {
 
 	var err error
 	DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger:         logger.Default.LogMode(logger.Info),
+		TranslateError: true,
 	})
 	if err != nil {
 		log.Fatal("Failed to connect to DB:", err)
 	}
 
+	err = RegisterAuditCallbacks(DB)
+	if err != nil {
+		log.Fatal("Failed to register audit callbacks:", err)
+	}
+
 	err = DB.AutoMigrate(
 		&brand_model.Brand{},
+		&category_model.Category{},
+		&voucher_model.Tag{},
 		&voucher_model.Voucher{},
 		&customer_model.Customer{},
+		&customer_model.CustomerEmailHistory{},
 		&transaction_model.Transaction{},
+		&audit_model.AuditLog{},
+		&api_key_model.ApiKey{},
+		&fraud_model.FraudReview{},
 	)
 	if err != nil {
 		log.Fatal("Failed to auto migrate:", err)
 	}
+
+	err = search_model.CreateSearchIndex(DB)
+	if err != nil {
+		log.Fatal("Failed to create search index:", err)
+	}
+
+	err = customer_model.BackfillReferralCode(DB)
+	if err != nil {
+		log.Fatal("Failed to backfill referral codes:", err)
+	}
+}
+
+// CloseDB closes the connection pool. Nothing may use DB afterwards.
+func CloseDB() {
+	sqlDB, err := DB.DB()
+	if err != nil {
+		log.Println("Error closing DB:", err)
+		return
+	}
+	if err := sqlDB.Close(); err != nil {
+		log.Println("Error closing DB:", err)
+	}
 }
@@ -0,0 +1,77 @@
+package api_key_handler
+
+import (
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"customer-voucher-service/middleware"
+	pbApiKey "customer-voucher-service/protogen/api_key"
+	"customer-voucher-service/services/api_key_service"
+	"customer-voucher-service/utils/validator"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HttpHandler struct {
+	apiKeyService api_key_service.IApiKeyService
+}
+
+func NewHttpHandler() *HttpHandler {
+	return &HttpHandler{apiKeyService: api_key_service.NewApiKeyService()}
+}
+
+func ApiKeyRoutes(rg *gin.RouterGroup) {
+	handler := NewHttpHandler()
+	apiKey := rg.Group("/api-key")
+	{
+		apiKey.POST("/create", middleware.RequirePermission(constants.PermissionApiKeyWrite), middleware.Handle(handler.CreateApiKey))
+		apiKey.GET("/list", middleware.RequirePermission(constants.PermissionApiKeyRead), middleware.Handle(handler.ListApiKey))
+		apiKey.PUT("/revoke", middleware.RequirePermission(constants.PermissionApiKeyWrite), middleware.Handle(handler.RevokeApiKey))
+		apiKey.PUT("/rotate", middleware.RequirePermission(constants.PermissionApiKeyWrite), middleware.Handle(handler.RotateApiKey))
+	}
+}
+
+func (h *HttpHandler) CreateApiKey(c *gin.Context) (interface{}, error) {
+	payload := &pbApiKey.CreateApiKeyReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
+	}
+	return h.apiKeyService.CreateApiKey(c, payload)
+}
+
+func (h *HttpHandler) ListApiKey(c *gin.Context) (interface{}, error) {
+	req := &pbApiKey.ListApiKeyReq{
+		PageToken: c.Query("pageToken"),
+	}
+	if brandIdStr := c.Query("brandId"); brandIdStr != "" {
+		var brandId int32
+		if _, err := fmt.Sscanf(brandIdStr, "%d", &brandId); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("brandId"))
+		}
+		req.BrandId = &brandId
+	}
+	if pageSizeStr := c.Query("pageSize"); pageSizeStr != "" {
+		if _, err := fmt.Sscanf(pageSizeStr, "%d", &req.PageSize); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("pageSize"))
+		}
+	}
+
+	return h.apiKeyService.ListApiKey(c, req)
+}
+
+func (h *HttpHandler) RevokeApiKey(c *gin.Context) (interface{}, error) {
+	payload := &pbApiKey.RevokeApiKeyReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
+	}
+	return h.apiKeyService.RevokeApiKey(c, payload)
+}
+
+func (h *HttpHandler) RotateApiKey(c *gin.Context) (interface{}, error) {
+	payload := &pbApiKey.RotateApiKeyReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
+	}
+	return h.apiKeyService.RotateApiKey(c, payload)
+}
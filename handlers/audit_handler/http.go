@@ -0,0 +1,49 @@
+package audit_handler
+
+import (
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"customer-voucher-service/middleware"
+	pbAudit "customer-voucher-service/protogen/audit"
+	"customer-voucher-service/services/audit_service"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HttpHandler struct {
+	auditService audit_service.IAuditService
+}
+
+func NewHttpHandler() *HttpHandler {
+	return &HttpHandler{auditService: audit_service.NewAuditService()}
+}
+
+func AuditRoutes(rg *gin.RouterGroup) {
+	handler := NewHttpHandler()
+	audit := rg.Group("/audit")
+	{
+		audit.GET("/list", middleware.RequirePermission(constants.PermissionAuditRead), middleware.Handle(handler.ListAuditLog))
+	}
+}
+
+func (h *HttpHandler) ListAuditLog(c *gin.Context) (interface{}, error) {
+	req := &pbAudit.ListAuditLogReq{
+		EntityType: c.Query("entityType"),
+		Actor:      c.Query("actor"),
+		PageToken:  c.Query("pageToken"),
+	}
+	if entityIdStr := c.Query("entityId"); entityIdStr != "" {
+		if _, err := fmt.Sscanf(entityIdStr, "%d", &req.EntityId); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("entityId"))
+		}
+	}
+	if pageSizeStr := c.Query("pageSize"); pageSizeStr != "" {
+		if _, err := fmt.Sscanf(pageSizeStr, "%d", &req.PageSize); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("pageSize"))
+		}
+	}
+
+	return h.auditService.ListAuditLog(c, req)
+}
@@ -3,9 +3,12 @@ package brand_handler
 import (
 	"customer-voucher-service/constants"
 	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"customer-voucher-service/middleware"
 	pbBrand "customer-voucher-service/protogen/brand"
 	"customer-voucher-service/services/brand_service"
-	"customer-voucher-service/utils/json_response"
+	"customer-voucher-service/utils/validator"
+	"fmt"
 
 	"github.com/gin-gonic/gin"
 )
@@ -22,31 +25,83 @@ func BrandRoutes(rg *gin.RouterGroup) {
 	handler := NewHttpHandler()
 	brand := rg.Group("/brand")
 	{
-		brand.POST("/create", handler.CreateBrand)
-		brand.GET("/list", handler.ListBrand)
+		brand.POST("/create", middleware.RequirePermission(constants.PermissionBrandWrite), middleware.Handle(handler.CreateBrand))
+		brand.GET("/list", middleware.RequirePermission(constants.PermissionBrandRead), middleware.Handle(handler.ListBrand))
+		brand.GET("/detail", middleware.RequirePermission(constants.PermissionBrandRead), middleware.Handle(handler.DetailBrand))
+		brand.PUT("/update", middleware.RequirePermission(constants.PermissionBrandWrite), middleware.Handle(handler.UpdateBrand))
+		brand.DELETE("/delete", middleware.RequirePermission(constants.PermissionBrandWrite), middleware.Handle(handler.DeleteBrand))
+		brand.PUT("/restore", middleware.RequirePermission(constants.PermissionBrandWrite), middleware.Handle(handler.RestoreBrand))
 	}
 }
 
-func (h *HttpHandler) CreateBrand(c *gin.Context) {
+func (h *HttpHandler) CreateBrand(c *gin.Context) (interface{}, error) {
 	payload := &pbBrand.CreateBrandReq{}
 	if err := c.ShouldBindJSON(payload); err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, error_base.ErrValidationFailed.Message)
+		return nil, validator.BindError(err)
 	}
-	res, err := h.brandService.CreateBrand(c, payload)
-	if err != nil && res != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, err.Error())
+	return h.brandService.CreateBrand(c, payload)
+}
+
+func (h *HttpHandler) ListBrand(c *gin.Context) (interface{}, error) {
+	req := &pbBrand.ListBrandReq{}
+	if pageSizeStr := c.Query("pageSize"); pageSizeStr != "" {
+		if _, err := fmt.Sscanf(pageSizeStr, "%d", &req.PageSize); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("pageSize"))
+		}
+	}
+	req.PageToken = c.Query("pageToken")
+
+	return h.brandService.ListBrand(c, req)
+}
+
+func (h *HttpHandler) DetailBrand(c *gin.Context) (interface{}, error) {
+	brandIdStr := c.Query("brandId")
+	if brandIdStr == "" {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.RequiredMessage("brandId"))
+	}
+	var brandId int32
+	if _, err := fmt.Sscanf(brandIdStr, "%d", &brandId); err != nil {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("brandId"))
 	}
-	if err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrInternalServer.HttpCode, error_base.ErrInternalServer.Code, error_base.ErrInternalServer.Message)
+
+	req := &pbBrand.DetailBrandReq{
+		Id: brandId,
 	}
-	json_response.Success(c, constants.CodeSystem, res)
+
+	return h.brandService.DetailBrand(c, req)
 }
 
-func (h *HttpHandler) ListBrand(c *gin.Context) {
-	req := &pbBrand.ListBrandReq{}
-	res, err := h.brandService.ListBrand(c, req)
-	if err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrInternalServer.HttpCode, error_base.ErrInternalServer.Code, error_base.ErrInternalServer.Message)
+func (h *HttpHandler) UpdateBrand(c *gin.Context) (interface{}, error) {
+	payload := &pbBrand.UpdateBrandReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
+	}
+	return h.brandService.UpdateBrand(c, payload)
+}
+
+func (h *HttpHandler) DeleteBrand(c *gin.Context) (interface{}, error) {
+	brandIdStr := c.Query("brandId")
+	if brandIdStr == "" {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.RequiredMessage("brandId"))
+	}
+	var brandId int32
+	if _, err := fmt.Sscanf(brandIdStr, "%d", &brandId); err != nil {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("brandId"))
+	}
+
+	req := &pbBrand.DeleteBrandReq{
+		Id:              brandId,
+		CascadeVouchers: c.Query("cascadeVouchers") == "true",
+		ModifiedBy:      c.Query("modifiedBy"),
+	}
+
+	return h.brandService.DeleteBrand(c, req)
+}
+
+func (h *HttpHandler) RestoreBrand(c *gin.Context) (interface{}, error) {
+	payload := &pbBrand.RestoreBrandReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
 	}
-	json_response.Success(c, constants.CodeSystem, res)
+	return h.brandService.RestoreBrand(c, payload)
 }
@@ -0,0 +1,86 @@
+package category_handler
+
+import (
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"customer-voucher-service/middleware"
+	pbCategory "customer-voucher-service/protogen/category"
+	"customer-voucher-service/services/category_service"
+	"customer-voucher-service/utils/validator"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HttpHandler struct {
+	categoryService category_service.ICategoryService
+}
+
+func NewHttpHandler() *HttpHandler {
+	return &HttpHandler{categoryService: category_service.NewCategoryService()}
+}
+
+func CategoryRoutes(rg *gin.RouterGroup) {
+	handler := NewHttpHandler()
+	category := rg.Group("/category")
+	{
+		category.POST("/create", middleware.RequirePermission(constants.PermissionCategoryWrite), middleware.Handle(handler.CreateCategory))
+		category.GET("/list", middleware.RequirePermission(constants.PermissionCategoryRead), middleware.Handle(handler.ListCategory))
+		category.PUT("/update", middleware.RequirePermission(constants.PermissionCategoryWrite), middleware.Handle(handler.UpdateCategory))
+		category.DELETE("/delete", middleware.RequirePermission(constants.PermissionCategoryWrite), middleware.Handle(handler.DeleteCategory))
+	}
+}
+
+func (h *HttpHandler) CreateCategory(c *gin.Context) (interface{}, error) {
+	payload := &pbCategory.CreateCategoryReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
+	}
+	return h.categoryService.CreateCategory(c, payload)
+}
+
+func (h *HttpHandler) ListCategory(c *gin.Context) (interface{}, error) {
+	req := &pbCategory.ListCategoryReq{}
+
+	if parentIdStr := c.Query("parentId"); parentIdStr != "" {
+		var parentId int32
+		if _, err := fmt.Sscanf(parentIdStr, "%d", &parentId); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("parentId"))
+		}
+		req.ParentId = &parentId
+	}
+	if pageSizeStr := c.Query("pageSize"); pageSizeStr != "" {
+		if _, err := fmt.Sscanf(pageSizeStr, "%d", &req.PageSize); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("pageSize"))
+		}
+	}
+	req.PageToken = c.Query("pageToken")
+
+	return h.categoryService.ListCategory(c, req)
+}
+
+func (h *HttpHandler) UpdateCategory(c *gin.Context) (interface{}, error) {
+	payload := &pbCategory.UpdateCategoryReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
+	}
+	return h.categoryService.UpdateCategory(c, payload)
+}
+
+func (h *HttpHandler) DeleteCategory(c *gin.Context) (interface{}, error) {
+	categoryIdStr := c.Query("categoryId")
+	if categoryIdStr == "" {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.RequiredMessage("categoryId"))
+	}
+	var categoryId int32
+	if _, err := fmt.Sscanf(categoryIdStr, "%d", &categoryId); err != nil {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("categoryId"))
+	}
+
+	req := &pbCategory.DeleteCategoryReq{
+		Id: categoryId,
+	}
+
+	return h.categoryService.DeleteCategory(c, req)
+}
@@ -3,9 +3,12 @@ package customer_handler
 import (
 	"customer-voucher-service/constants"
 	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"customer-voucher-service/middleware"
 	pbCustomer "customer-voucher-service/protogen/customer"
 	"customer-voucher-service/services/customer_service"
-	"customer-voucher-service/utils/json_response"
+	"customer-voucher-service/utils/validator"
+	"fmt"
 
 	"github.com/gin-gonic/gin"
 )
@@ -22,44 +25,92 @@ func CustomerRoutes(rg *gin.RouterGroup) {
 	handler := NewHttpHandler()
 	customer := rg.Group("/customer")
 	{
-		customer.POST("/create", handler.CreateCustomer)
-		customer.GET("/list", handler.ListCustomer)
-		customer.PUT("/update-points", handler.UpdateCustomerPoints)
+		customer.POST("/create", middleware.RequirePermission(constants.PermissionCustomerWrite), middleware.Handle(handler.CreateCustomer))
+		customer.GET("/list", middleware.RequirePermission(constants.PermissionCustomerRead), middleware.Handle(handler.ListCustomer))
+		customer.GET("/detail", middleware.RequirePermission(constants.PermissionCustomerRead), middleware.Handle(handler.DetailCustomer))
+		customer.PUT("/update", middleware.RequirePermission(constants.PermissionCustomerWrite), middleware.Handle(handler.UpdateCustomer))
+		customer.PUT("/update-points", middleware.RequirePermission(constants.PermissionCustomerWrite), middleware.Handle(handler.UpdateCustomerPoints))
+		customer.PUT("/deactivate", middleware.RequirePermission(constants.PermissionCustomerWrite), middleware.Handle(handler.DeactivateCustomer))
+		customer.PUT("/reactivate", middleware.RequirePermission(constants.PermissionCustomerWrite), middleware.Handle(handler.ReactivateCustomer))
+		customer.GET("/referral-report", middleware.RequirePermission(constants.PermissionCustomerRead), middleware.Handle(handler.ReferralReport))
 	}
 }
 
-func (h *HttpHandler) CreateCustomer(c *gin.Context) {
+func (h *HttpHandler) CreateCustomer(c *gin.Context) (interface{}, error) {
 	payload := &pbCustomer.CreateCustomerReq{}
 	if err := c.ShouldBindJSON(payload); err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, error_base.ErrValidationFailed.Message)
+		return nil, validator.BindError(err)
 	}
-	res, err := h.customerService.CreateCustomer(c, payload)
-	if err != nil && res != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, err.Error())
-	}
-	if err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrInternalServer.HttpCode, error_base.ErrInternalServer.Code, error_base.ErrInternalServer.Message)
+	return h.customerService.CreateCustomer(c, payload)
+}
+
+func (h *HttpHandler) UpdateCustomer(c *gin.Context) (interface{}, error) {
+	payload := &pbCustomer.UpdateCustomerReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
 	}
-	json_response.Success(c, constants.CodeSystem, res)
+	return h.customerService.UpdateCustomer(c, payload)
 }
 
-func (h *HttpHandler) ListCustomer(c *gin.Context) {
+func (h *HttpHandler) ListCustomer(c *gin.Context) (interface{}, error) {
 	req := &pbCustomer.ListCustomerReq{}
-	res, err := h.customerService.ListCustomer(c, req)
-	if err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrInternalServer.HttpCode, error_base.ErrInternalServer.Code, error_base.ErrInternalServer.Message)
+	if pageSizeStr := c.Query("pageSize"); pageSizeStr != "" {
+		if _, err := fmt.Sscanf(pageSizeStr, "%d", &req.PageSize); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("pageSize"))
+		}
+	}
+	req.PageToken = c.Query("pageToken")
+
+	return h.customerService.ListCustomer(c, req)
+}
+
+func (h *HttpHandler) DetailCustomer(c *gin.Context) (interface{}, error) {
+	customerIdStr := c.Query("customerId")
+	if customerIdStr == "" {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.RequiredMessage("customerId"))
+	}
+	var customerId int32
+	if _, err := fmt.Sscanf(customerIdStr, "%d", &customerId); err != nil {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("customerId"))
+	}
+
+	req := &pbCustomer.DetailCustomerReq{
+		Id: customerId,
 	}
-	json_response.Success(c, constants.CodeSystem, res)
+	if recentLimitStr := c.Query("recentLimit"); recentLimitStr != "" {
+		if _, err := fmt.Sscanf(recentLimitStr, "%d", &req.RecentLimit); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("recentLimit"))
+		}
+	}
+
+	return h.customerService.DetailCustomer(c, req)
 }
 
-func (h *HttpHandler) UpdateCustomerPoints(c *gin.Context) {
+func (h *HttpHandler) UpdateCustomerPoints(c *gin.Context) (interface{}, error) {
 	payload := &pbCustomer.UpdateCustomerPointsReq{}
 	if err := c.ShouldBindJSON(payload); err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, error_base.ErrValidationFailed.Message)
+		return nil, validator.BindError(err)
 	}
-	res, err := h.customerService.UpdateCustomerPoints(c, payload)
-	if err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrInternalServer.HttpCode, error_base.ErrInternalServer.Code, error_base.ErrInternalServer.Message)
+	return h.customerService.UpdateCustomerPoints(c, payload)
+}
+
+func (h *HttpHandler) ReferralReport(c *gin.Context) (interface{}, error) {
+	req := &pbCustomer.ReferralReportReq{}
+	return h.customerService.ReferralReport(c, req)
+}
+
+func (h *HttpHandler) DeactivateCustomer(c *gin.Context) (interface{}, error) {
+	payload := &pbCustomer.DeactivateCustomerReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
+	}
+	return h.customerService.DeactivateCustomer(c, payload)
+}
+
+func (h *HttpHandler) ReactivateCustomer(c *gin.Context) (interface{}, error) {
+	payload := &pbCustomer.ReactivateCustomerReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
 	}
-	json_response.Success(c, constants.CodeSystem, res)
+	return h.customerService.ReactivateCustomer(c, payload)
 }
@@ -0,0 +1,42 @@
+package search_handler
+
+import (
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"customer-voucher-service/middleware"
+	pbSearch "customer-voucher-service/protogen/search"
+	"customer-voucher-service/services/search_service"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HttpHandler struct {
+	searchService search_service.ISearchService
+}
+
+func NewHttpHandler() *HttpHandler {
+	return &HttpHandler{searchService: search_service.NewSearchService()}
+}
+
+func SearchRoutes(rg *gin.RouterGroup) {
+	handler := NewHttpHandler()
+	rg.GET("/search", middleware.RequirePermission(constants.PermissionVoucherRead), middleware.Handle(handler.Search))
+}
+
+func (h *HttpHandler) Search(c *gin.Context) (interface{}, error) {
+	req := &pbSearch.SearchReq{
+		Query: c.Query("q"),
+	}
+	if searchType := c.Query("type"); searchType != "" {
+		req.Type = &searchType
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if _, err := fmt.Sscanf(limitStr, "%d", &req.Limit); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("limit"))
+		}
+	}
+
+	return h.searchService.Search(c, req)
+}
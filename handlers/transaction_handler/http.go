@@ -4,9 +4,10 @@ import (
 	"customer-voucher-service/constants"
 	"customer-voucher-service/constants/error_base"
 	"customer-voucher-service/constants/message"
+	"customer-voucher-service/middleware"
 	pbTransaction "customer-voucher-service/protogen/transaction"
 	"customer-voucher-service/services/transaction_service"
-	"customer-voucher-service/utils/json_response"
+	"customer-voucher-service/utils/validator"
 	"fmt"
 
 	"github.com/gin-gonic/gin"
@@ -24,65 +25,141 @@ func TransactionRoutes(rg *gin.RouterGroup) {
 	handler := NewHttpHandler()
 	transaction := rg.Group("/transaction")
 	{
-		transaction.POST("/redemption", handler.TransactionRedeemPoint)
-		transaction.GET("/list", handler.ListTransaction)
-		transaction.GET("/detail", handler.DetailTransaction)
+		transaction.POST("/redemption", middleware.RequirePermission(constants.PermissionTransactionRedeem, constants.PermissionVoucherBurn), middleware.Handle(handler.TransactionRedeemPoint))
+		transaction.GET("/list", middleware.RequirePermission(constants.PermissionTransactionRead), middleware.Handle(handler.ListTransaction))
+		transaction.GET("/detail", middleware.RequirePermission(constants.PermissionTransactionRead), middleware.Handle(handler.DetailTransaction))
+		transaction.POST("/gift", middleware.RequirePermission(constants.PermissionTransactionRedeem), middleware.Handle(handler.GiftVoucher))
+		transaction.POST("/gift/claim", middleware.RequirePermission(constants.PermissionTransactionRedeem), middleware.Handle(handler.ClaimGiftVoucher))
+		transaction.GET("/review/list", middleware.RequirePermission(constants.PermissionFraudReview), middleware.Handle(handler.ListFraudReview))
+		transaction.PUT("/review", middleware.RequirePermission(constants.PermissionFraudReview), middleware.Handle(handler.ReviewTransaction))
 	}
 }
 
-func (h *HttpHandler) TransactionRedeemPoint(c *gin.Context) {
+func (h *HttpHandler) TransactionRedeemPoint(c *gin.Context) (interface{}, error) {
 	payload := &pbTransaction.TransactionRedeemPointReq{}
 	if err := c.ShouldBindJSON(payload); err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, error_base.ErrValidationFailed.Message)
+		return nil, validator.BindError(err)
 	}
-	res, err := h.transactionService.TransactionRedeemPoint(c, payload)
-	if err != nil && res != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, err.Error())
-	}
-	if err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrInternalServer.HttpCode, error_base.ErrInternalServer.Code, error_base.ErrInternalServer.Message)
-	}
-	json_response.Success(c, constants.CodeSystem, res)
+	return h.transactionService.TransactionRedeemPoint(c, payload)
 }
 
-func (h *HttpHandler) ListTransaction(c *gin.Context) {
+func (h *HttpHandler) ListTransaction(c *gin.Context) (interface{}, error) {
 	req := &pbTransaction.ListTransactionReq{}
 
 	if customerIdStr := c.Query("customerId"); customerIdStr != "" {
 		var customerId int32
 		if _, err := fmt.Sscanf(customerIdStr, "%d", &customerId); err != nil {
-			json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, "Invalid customerId format")
-			return
+			return nil, error_base.ErrValidationFailed.WithMessage("Invalid customerId format")
 		}
 		req.CustomerId = &customerId
 	}
-
-	res, err := h.transactionService.ListTransaction(c, req)
-	if err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrInternalServer.HttpCode, error_base.ErrInternalServer.Code, error_base.ErrInternalServer.Message)
+	if voucherIdStr := c.Query("voucherId"); voucherIdStr != "" {
+		var voucherId int32
+		if _, err := fmt.Sscanf(voucherIdStr, "%d", &voucherId); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("voucherId"))
+		}
+		req.VoucherId = &voucherId
+	}
+	if brandIdStr := c.Query("brandId"); brandIdStr != "" {
+		var brandId int32
+		if _, err := fmt.Sscanf(brandIdStr, "%d", &brandId); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("brandId"))
+		}
+		req.BrandId = &brandId
+	}
+	if statusStr := c.Query("status"); statusStr != "" {
+		var status int32
+		if _, err := fmt.Sscanf(statusStr, "%d", &status); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("status"))
+		}
+		req.Status = &status
+	}
+	if startDate := c.Query("startDate"); startDate != "" {
+		req.StartDate = &startDate
 	}
-	json_response.Success(c, constants.CodeSystem, res)
+	if endDate := c.Query("endDate"); endDate != "" {
+		req.EndDate = &endDate
+	}
+	if minTotalStr := c.Query("minTotal"); minTotalStr != "" {
+		var minTotal int64
+		if _, err := fmt.Sscanf(minTotalStr, "%d", &minTotal); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("minTotal"))
+		}
+		req.MinTotal = &minTotal
+	}
+	if maxTotalStr := c.Query("maxTotal"); maxTotalStr != "" {
+		var maxTotal int64
+		if _, err := fmt.Sscanf(maxTotalStr, "%d", &maxTotal); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("maxTotal"))
+		}
+		req.MaxTotal = &maxTotal
+	}
+	req.SortBy = c.Query("sortBy")
+	req.SortOrder = c.Query("sortOrder")
+	req.Embed = c.Query("embed") == "true"
+	if pageSizeStr := c.Query("pageSize"); pageSizeStr != "" {
+		if _, err := fmt.Sscanf(pageSizeStr, "%d", &req.PageSize); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("pageSize"))
+		}
+	}
+	req.PageToken = c.Query("pageToken")
+
+	return h.transactionService.ListTransaction(c, req)
 }
 
-func (h *HttpHandler) DetailTransaction(c *gin.Context) {
+func (h *HttpHandler) DetailTransaction(c *gin.Context) (interface{}, error) {
 	transactionIdStr := c.Query("transactionId")
 	if transactionIdStr == "" {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, message.RequiredMessage("transactionId"))
+		return nil, error_base.ErrValidationFailed.WithMessage(message.RequiredMessage("transactionId"))
 	}
 	var transactionId int32
 	if _, err := fmt.Sscanf(transactionIdStr, "%d", &transactionId); err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, "Invalid transactionId format")
-		return
+		return nil, error_base.ErrValidationFailed.WithMessage("Invalid transactionId format")
 	}
 
 	req := &pbTransaction.DetailTransactionReq{
-		Id: transactionId,
+		Id:    transactionId,
+		Embed: c.Query("embed") == "true",
 	}
 
-	res, err := h.transactionService.DetailTransaction(c, req)
-	if err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrInternalServer.HttpCode, error_base.ErrInternalServer.Code, error_base.ErrInternalServer.Message)
-		return
+	return h.transactionService.DetailTransaction(c, req)
+}
+
+func (h *HttpHandler) GiftVoucher(c *gin.Context) (interface{}, error) {
+	payload := &pbTransaction.GiftVoucherReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
+	}
+	return h.transactionService.GiftVoucher(c, payload)
+}
+
+func (h *HttpHandler) ClaimGiftVoucher(c *gin.Context) (interface{}, error) {
+	payload := &pbTransaction.ClaimGiftVoucherReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
+	}
+	return h.transactionService.ClaimGiftVoucher(c, payload)
+}
+
+func (h *HttpHandler) ListFraudReview(c *gin.Context) (interface{}, error) {
+	req := &pbTransaction.ListFraudReviewReq{}
+	if status := c.Query("status"); status != "" {
+		req.Status = &status
+	}
+	if pageSizeStr := c.Query("pageSize"); pageSizeStr != "" {
+		if _, err := fmt.Sscanf(pageSizeStr, "%d", &req.PageSize); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("pageSize"))
+		}
+	}
+	req.PageToken = c.Query("pageToken")
+
+	return h.transactionService.ListFraudReview(c, req)
+}
+
+func (h *HttpHandler) ReviewTransaction(c *gin.Context) (interface{}, error) {
+	payload := &pbTransaction.ReviewTransactionReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
 	}
-	json_response.Success(c, constants.CodeSystem, res)
+	return h.transactionService.ReviewTransaction(c, payload)
 }
@@ -4,9 +4,10 @@ import (
 	"customer-voucher-service/constants"
 	"customer-voucher-service/constants/error_base"
 	"customer-voucher-service/constants/message"
+	"customer-voucher-service/middleware"
 	pbVoucher "customer-voucher-service/protogen/voucher"
 	"customer-voucher-service/services/voucher_service"
-	"customer-voucher-service/utils/json_response"
+	"customer-voucher-service/utils/validator"
 	"fmt"
 
 	"github.com/gin-gonic/gin"
@@ -24,62 +25,155 @@ func VoucherRoutes(rg *gin.RouterGroup) {
 	handler := NewHttpHandler()
 	customer := rg.Group("/voucher")
 	{
-		customer.POST("/create", handler.CreateVoucher)
-		customer.GET("/list", handler.ListVoucher)
-		customer.GET("/detail", handler.DetailVoucher)
+		customer.POST("/create", middleware.RequirePermission(constants.PermissionVoucherWrite), middleware.Handle(handler.CreateVoucher))
+		customer.GET("/list", middleware.RequirePermission(constants.PermissionVoucherRead), middleware.Handle(handler.ListVoucher))
+		customer.GET("/detail", middleware.RequirePermission(constants.PermissionVoucherRead), middleware.Handle(handler.DetailVoucher))
+		customer.PUT("/update", middleware.RequirePermission(constants.PermissionVoucherWrite), middleware.Handle(handler.UpdateVoucher))
+		customer.DELETE("/delete", middleware.RequirePermission(constants.PermissionVoucherWrite), middleware.Handle(handler.DeleteVoucher))
+		customer.PUT("/restore", middleware.RequirePermission(constants.PermissionVoucherWrite), middleware.Handle(handler.RestoreVoucher))
+		customer.PUT("/tags", middleware.RequirePermission(constants.PermissionVoucherWrite), middleware.Handle(handler.SetVoucherTags))
+		customer.GET("/tag/list", middleware.RequirePermission(constants.PermissionVoucherRead), middleware.Handle(handler.ListTag))
+		customer.DELETE("/tag/delete", middleware.RequirePermission(constants.PermissionCategoryWrite), middleware.Handle(handler.DeleteTag))
 	}
 }
 
-func (h *HttpHandler) CreateVoucher(c *gin.Context) {
+func (h *HttpHandler) CreateVoucher(c *gin.Context) (interface{}, error) {
 	payload := &pbVoucher.CreateVoucherReq{}
 	if err := c.ShouldBindJSON(payload); err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, error_base.ErrValidationFailed.Message)
+		return nil, validator.BindError(err)
 	}
-	res, err := h.voucherService.CreateVoucher(c, payload)
-	if err != nil && res != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, err.Error())
-	}
-	if err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrInternalServer.HttpCode, error_base.ErrInternalServer.Code, error_base.ErrInternalServer.Message)
-	}
-	json_response.Success(c, constants.CodeSystem, res)
+	return h.voucherService.CreateVoucher(c, payload)
 }
 
-func (h *HttpHandler) ListVoucher(c *gin.Context) {
+func (h *HttpHandler) ListVoucher(c *gin.Context) (interface{}, error) {
 	req := &pbVoucher.ListVoucherReq{}
 
 	if brandIdStr := c.Query("brandId"); brandIdStr != "" {
 		var brandId int32
 		if _, err := fmt.Sscanf(brandIdStr, "%d", &brandId); err != nil {
-			json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, message.InvalidFormatMessage("brandId"))
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("brandId"))
 		}
 		req.BrandId = &brandId
 	}
-
-	res, err := h.voucherService.ListVoucher(c, req)
-	if err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrInternalServer.HttpCode, error_base.ErrInternalServer.Code, error_base.ErrInternalServer.Message)
+	if categoryIdStr := c.Query("categoryId"); categoryIdStr != "" {
+		var categoryId int32
+		if _, err := fmt.Sscanf(categoryIdStr, "%d", &categoryId); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("categoryId"))
+		}
+		req.CategoryId = &categoryId
+	}
+	if tag := c.Query("tag"); tag != "" {
+		req.Tag = &tag
+	}
+	if minCostStr := c.Query("minCostInPoint"); minCostStr != "" {
+		var minCost int64
+		if _, err := fmt.Sscanf(minCostStr, "%d", &minCost); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("minCostInPoint"))
+		}
+		req.MinCostInPoint = &minCost
+	}
+	if maxCostStr := c.Query("maxCostInPoint"); maxCostStr != "" {
+		var maxCost int64
+		if _, err := fmt.Sscanf(maxCostStr, "%d", &maxCost); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("maxCostInPoint"))
+		}
+		req.MaxCostInPoint = &maxCost
 	}
-	json_response.Success(c, constants.CodeSystem, res)
+	if pageSizeStr := c.Query("pageSize"); pageSizeStr != "" {
+		if _, err := fmt.Sscanf(pageSizeStr, "%d", &req.PageSize); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("pageSize"))
+		}
+	}
+	req.PageToken = c.Query("pageToken")
+
+	return h.voucherService.ListVoucher(c, req)
 }
 
-func (h *HttpHandler) DetailVoucher(c *gin.Context) {
+func (h *HttpHandler) DetailVoucher(c *gin.Context) (interface{}, error) {
 	voucherIdStr := c.Query("voucherId")
 	if voucherIdStr == "" {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, message.RequiredMessage("voucherId"))
+		return nil, error_base.ErrValidationFailed.WithMessage(message.RequiredMessage("voucherId"))
 	}
 	var voucherId int32
 	if _, err := fmt.Sscanf(voucherIdStr, "%d", &voucherId); err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrValidationFailed.HttpCode, error_base.ErrValidationFailed.Code, message.InvalidFormatMessage("voucherId"))
+		return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("voucherId"))
 	}
 
 	req := &pbVoucher.DetailVoucherReq{
 		Id: voucherId,
 	}
 
-	res, err := h.voucherService.DetailVoucher(c, req)
-	if err != nil {
-		json_response.Error(c, constants.CodeSystem, error_base.ErrInternalServer.HttpCode, error_base.ErrInternalServer.Code, error_base.ErrInternalServer.Message)
+	return h.voucherService.DetailVoucher(c, req)
+}
+
+func (h *HttpHandler) UpdateVoucher(c *gin.Context) (interface{}, error) {
+	payload := &pbVoucher.UpdateVoucherReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
+	}
+	return h.voucherService.UpdateVoucher(c, payload)
+}
+
+func (h *HttpHandler) DeleteVoucher(c *gin.Context) (interface{}, error) {
+	voucherIdStr := c.Query("voucherId")
+	if voucherIdStr == "" {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.RequiredMessage("voucherId"))
+	}
+	var voucherId int32
+	if _, err := fmt.Sscanf(voucherIdStr, "%d", &voucherId); err != nil {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("voucherId"))
+	}
+
+	req := &pbVoucher.DeleteVoucherReq{
+		Id:         voucherId,
+		Reason:     c.Query("reason"),
+		ModifiedBy: c.Query("modifiedBy"),
+	}
+
+	return h.voucherService.DeleteVoucher(c, req)
+}
+
+func (h *HttpHandler) RestoreVoucher(c *gin.Context) (interface{}, error) {
+	payload := &pbVoucher.RestoreVoucherReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
+	}
+	return h.voucherService.RestoreVoucher(c, payload)
+}
+
+func (h *HttpHandler) SetVoucherTags(c *gin.Context) (interface{}, error) {
+	payload := &pbVoucher.SetVoucherTagsReq{}
+	if err := c.ShouldBindJSON(payload); err != nil {
+		return nil, validator.BindError(err)
 	}
-	json_response.Success(c, constants.CodeSystem, res)
+	return h.voucherService.SetVoucherTags(c, payload)
+}
+
+func (h *HttpHandler) ListTag(c *gin.Context) (interface{}, error) {
+	req := &pbVoucher.ListTagReq{}
+	if pageSizeStr := c.Query("pageSize"); pageSizeStr != "" {
+		if _, err := fmt.Sscanf(pageSizeStr, "%d", &req.PageSize); err != nil {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("pageSize"))
+		}
+	}
+	req.PageToken = c.Query("pageToken")
+
+	return h.voucherService.ListTag(c, req)
+}
+
+func (h *HttpHandler) DeleteTag(c *gin.Context) (interface{}, error) {
+	tagIdStr := c.Query("tagId")
+	if tagIdStr == "" {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.RequiredMessage("tagId"))
+	}
+	var tagId int32
+	if _, err := fmt.Sscanf(tagIdStr, "%d", &tagId); err != nil {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("tagId"))
+	}
+
+	req := &pbVoucher.DeleteTagReq{
+		Id: tagId,
+	}
+
+	return h.voucherService.DeleteTag(c, req)
 }
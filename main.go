@@ -1,11 +1,29 @@
 package main
 
 import (
+	"context"
+	"customer-voucher-service/constants"
 	"customer-voucher-service/db"
+	"customer-voucher-service/middleware"
 	"customer-voucher-service/routes"
+	"customer-voucher-service/services/api_key_service"
+	"customer-voucher-service/services/transaction_service"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/env"
+	"customer-voucher-service/utils/rate_limit"
+	"errors"
+	"expvar"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
 	"log"
+	"net"
+	"net/http"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 )
 
 func main() {
@@ -14,10 +32,88 @@ func main() {
 		log.Fatal("Error loading .env file")
 	}
 
+	verifier, err := auth.NewVerifierFromEnv()
+	if err != nil {
+		log.Fatal("Error loading JWT configuration: ", err)
+	}
+
+	// Cancelled on SIGINT or SIGTERM, which stops the background jobs.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	db.InitDB()
-	r := gin.Default()
+	giftExpiryStopped := transaction_service.NewTransactionService().StartGiftExpiryJob(ctx, constants.GiftExpiryCheckInterval)
+
+	r := gin.New()
+	// c.ClientIP() only reads X-Forwarded-For from these proxies, so that clients
+	// cannot pick the IP their rate limit buckets are keyed on.
+	var trustedProxies []string
+	if value := env.GetString("TRUSTED_PROXIES", ""); value != "" {
+		trustedProxies = strings.Split(value, ",")
+	}
+	if err := r.SetTrustedProxies(trustedProxies); err != nil {
+		log.Fatal("Error loading TRUSTED_PROXIES: ", err)
+	}
+	r.Use(gin.Logger(), middleware.Recovery())
+	// Lets services read the caller from the gin.Context handlers pass them.
+	r.ContextWithFallback = true
+
+	limiter := rate_limit.NewLimiterFromEnv(rate_limit.NewMemoryStore())
+	apiKeys := api_key_service.NewApiKeyService()
+	routes.ApiRoutes(r, verifier, apiKeys, limiter)
+
+	lis, err := net.Listen("tcp", ":"+env.GetString("GRPC_PORT", constants.DefaultGrpcPort))
+	if err != nil {
+		log.Fatal("Error listening for gRPC: ", err)
+	}
+	grpcConfig := routes.GrpcConfigFromEnv()
+	grpcServer, err := routes.GrpcServer(grpcConfig, verifier, apiKeys, limiter)
+	if err != nil {
+		log.Fatal("Error configuring gRPC: ", err)
+	}
+	// Served on /api/v1/debug/vars next to the runtime stats.
+	expvar.Publish("grpc_server", expvar.Func(func() any { return grpcConfig.Metrics.Snapshot() }))
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatal("Error serving gRPC: ", err)
+		}
+	}()
 
-	routes.ApiRoutes(r)
+	httpServer := &http.Server{Addr: ":8080", Handler: r}
+	go func() {
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("Error serving HTTP: ", err)
+		}
+	}()
 
-	r.Run(":8080")
+	<-ctx.Done()
+	stop()
+	shutdown(grpcConfig.Health, grpcServer, httpServer)
+	<-giftExpiryStopped
+	db.CloseDB()
+}
+
+// shutdown reports NOT_SERVING first so that load balancers stop sending calls, then
+// lets in-flight gRPC calls and HTTP requests finish. Whatever is still running after
+// SHUTDOWN_TIMEOUT_SECONDS is cut off.
+func shutdown(healthServer *health.Server, grpcServer *grpc.Server, httpServer *http.Server) {
+	log.Println("Shutting down")
+	timeout := time.Duration(env.GetInt64("SHUTDOWN_TIMEOUT_SECONDS", constants.DefaultShutdownTimeoutSeconds)) * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	healthServer.Shutdown()
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Println("Error shutting down HTTP: ", err)
+	}
 }
@@ -0,0 +1,324 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	pbCustomer "customer-voucher-service/protogen/customer"
+	pbTransaction "customer-voucher-service/protogen/transaction"
+	"customer-voucher-service/utils/auth"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testSecret = []byte("test-secret")
+
+func signHMAC(t *testing.T, claims auth.Claims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(testSecret)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func validClaims() auth.Claims {
+	return auth.Claims{
+		Name:       "Jane",
+		Role:       "admin",
+		CustomerID: 7,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func newHMACVerifier(t *testing.T) *auth.Verifier {
+	verifier, err := auth.NewVerifier(auth.Config{Algorithm: auth.AlgorithmHMAC, Secret: testSecret})
+	if err != nil {
+		t.Fatalf("failed to create verifier: %v", err)
+	}
+	return verifier
+}
+
+func TestVerify_HMAC(t *testing.T) {
+	caller, err := newHMACVerifier(t).Verify(signHMAC(t, validClaims()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", caller.ID)
+	assert.Equal(t, "Jane", caller.Name)
+	assert.Equal(t, "admin", caller.Role)
+	assert.Equal(t, uint(7), caller.CustomerID)
+}
+
+func TestVerify_RejectsInvalidTokens(t *testing.T) {
+	verifier := newHMACVerifier(t)
+
+	expired := validClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	noExpiry := validClaims()
+	noExpiry.ExpiresAt = nil
+	wrongSecret, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims()).SignedString([]byte("other"))
+
+	for name, token := range map[string]string{
+		"expired":      signHMAC(t, expired),
+		"no expiry":    signHMAC(t, noExpiry),
+		"wrong secret": wrongSecret,
+		"garbage":      "not-a-token",
+	} {
+		_, err := verifier.Verify(token)
+		assert.ErrorIs(t, err, error_base.ErrInvalidCredentials, name)
+	}
+}
+
+func TestVerify_MissingSubject(t *testing.T) {
+	claims := validClaims()
+	claims.Subject = ""
+
+	_, err := newHMACVerifier(t).Verify(signHMAC(t, claims))
+
+	assert.ErrorIs(t, err, error_base.ErrUserNotFound)
+}
+
+func TestVerify_RSA(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	verifier, err := auth.NewVerifier(auth.Config{Algorithm: auth.AlgorithmRSA, PublicKey: &key.PublicKey})
+	if err != nil {
+		t.Fatalf("failed to create verifier: %v", err)
+	}
+
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodRS256, validClaims()).SignedString(key)
+	caller, err := verifier.Verify(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", caller.ID)
+
+	// An HMAC token must not be accepted by an RSA verifier.
+	_, err = verifier.Verify(signHMAC(t, validClaims()))
+	assert.ErrorIs(t, err, error_base.ErrInvalidCredentials)
+}
+
+func TestNewVerifier_RequiresKey(t *testing.T) {
+	_, err := auth.NewVerifier(auth.Config{Algorithm: auth.AlgorithmHMAC})
+	assert.Error(t, err)
+
+	_, err = auth.NewVerifier(auth.Config{Algorithm: auth.AlgorithmRSA})
+	assert.Error(t, err)
+
+	_, err = auth.NewVerifier(auth.Config{Algorithm: "none", Secret: testSecret})
+	assert.Error(t, err)
+}
+
+func newTestEngine(verifier *auth.Verifier) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.GET("/me", Authenticate(verifier), func(c *gin.Context) {
+		// Services receive the gin.Context as their context.Context.
+		var ctx context.Context = c
+		caller, _ := auth.CallerFromContext(ctx)
+		c.JSON(http.StatusOK, gin.H{"id": caller.ID, "actor": auth.Actor(ctx, "client")})
+	})
+	return r
+}
+
+func TestAuthenticate(t *testing.T) {
+	r := newTestEngine(newHMACVerifier(t))
+
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	req.Header.Set("Authorization", "Bearer "+signHMAC(t, validClaims()))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var body map[string]string
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "user-1", body["id"])
+	assert.Equal(t, "user-1", body["actor"])
+}
+
+func TestAuthenticate_Unauthorized(t *testing.T) {
+	r := newTestEngine(newHMACVerifier(t))
+
+	for _, header := range []string{"", "Basic abc", "Bearer not-a-token"} {
+		req := httptest.NewRequest(http.MethodGet, "/me", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code, header)
+		var body map[string]string
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, error_base.ErrInvalidCredentials.Code, body["code"])
+	}
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	interceptor := UnaryAuthInterceptor(newHMACVerifier(t))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		caller, _ := auth.CallerFromContext(ctx)
+		return caller.ID, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/customer.CustomerService/ListCustomer"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+signHMAC(t, validClaims())))
+	res, err := interceptor(ctx, nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", res)
+
+	_, err = interceptor(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func tokenForRole(t *testing.T, role string) string {
+	claims := validClaims()
+	claims.Role = role
+	return signHMAC(t, claims)
+}
+
+func TestRequirePermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/brand/create", Authenticate(newHMACVerifier(t)), RequirePermission(constants.PermissionBrandWrite), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	for role, expected := range map[string]int{
+		constants.RoleAdmin:         http.StatusOK,
+		constants.RoleBrandOperator: http.StatusForbidden,
+		constants.RoleCustomer:      http.StatusForbidden,
+		"":                          http.StatusForbidden,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/brand/create", nil)
+		req.Header.Set("Authorization", "Bearer "+tokenForRole(t, role))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, expected, w.Code, role)
+		if expected == http.StatusForbidden {
+			var body map[string]string
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, error_base.ErrForbidden.Code, body["code"])
+		}
+	}
+}
+
+func TestUnaryPermissionInterceptor(t *testing.T) {
+	interceptor := UnaryPermissionInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	customer := auth.WithCaller(context.Background(), &auth.Caller{ID: "user-1", Role: constants.RoleCustomer})
+	admin := auth.WithCaller(context.Background(), &auth.Caller{ID: "user-1", Role: constants.RoleAdmin})
+
+	_, err := interceptor(customer, nil, &grpc.UnaryServerInfo{FullMethod: pbTransaction.TransactionServiceTransactionRedeemPointFullMethodName}, handler)
+	assert.NoError(t, err)
+
+	_, err = interceptor(customer, nil, &grpc.UnaryServerInfo{FullMethod: pbCustomer.CustomerServiceUpdateCustomerPointsFullMethodName}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = interceptor(admin, nil, &grpc.UnaryServerInfo{FullMethod: "/unknown.Service/Method"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: pbCustomer.CustomerServiceListCustomerFullMethodName}, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+type stubApiKeyAuthenticator struct{}
+
+func (stubApiKeyAuthenticator) AuthenticateApiKey(ctx context.Context, key string) (*auth.Caller, error) {
+	if key != "cvs_abc_secret" {
+		return nil, error_base.ErrInvalidCredentials
+	}
+	return &auth.Caller{ID: "api_key:abc", Role: constants.RoleApiKey, BrandID: 1, Scopes: []string{constants.ScopeVouchersRead}}, nil
+}
+
+func TestAuthenticateApiKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	verifier := newHMACVerifier(t)
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	r.GET("/voucher/list", AuthenticateApiKey(stubApiKeyAuthenticator{}), Authenticate(verifier), RequirePermission(constants.PermissionVoucherRead), ok)
+	r.POST("/voucher/create", AuthenticateApiKey(stubApiKeyAuthenticator{}), Authenticate(verifier), RequirePermission(constants.PermissionVoucherWrite), ok)
+
+	for _, tc := range []struct {
+		method, path, key string
+		expected          int
+	}{
+		{http.MethodGet, "/voucher/list", "cvs_abc_secret", http.StatusOK},
+		{http.MethodPost, "/voucher/create", "cvs_abc_secret", http.StatusForbidden},
+		{http.MethodGet, "/voucher/list", "cvs_abc_wrong", http.StatusUnauthorized},
+		{http.MethodGet, "/voucher/list", "", http.StatusUnauthorized},
+	} {
+		req := httptest.NewRequest(tc.method, tc.path, nil)
+		if tc.key != "" {
+			req.Header.Set(constants.HeaderApiKey, tc.key)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, tc.expected, w.Code, tc.path+" "+tc.key)
+	}
+}
+
+type stubBurnKeyAuthenticator struct{}
+
+func (stubBurnKeyAuthenticator) AuthenticateApiKey(ctx context.Context, key string) (*auth.Caller, error) {
+	return &auth.Caller{ID: "api_key:pos", Role: constants.RoleApiKey, BrandID: 1, Scopes: []string{constants.ScopeCodesBurn}}, nil
+}
+
+func TestAuthenticateApiKey_RedeemOnlyForPresentedCustomer(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	verifier := newHMACVerifier(t)
+	r.POST("/transaction/redemption", AuthenticateApiKey(stubBurnKeyAuthenticator{}), Authenticate(verifier), RequirePermission(constants.PermissionVoucherBurn), func(c *gin.Context) {
+		if err := auth.AuthorizeCustomer(c.Request.Context(), 7); err != nil {
+			c.Status(http.StatusForbidden)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	customerToken := func(customerId uint) string {
+		claims := validClaims()
+		claims.Role, claims.CustomerID = constants.RoleCustomer, customerId
+		return signHMAC(t, claims)
+	}
+	for name, tc := range map[string]struct {
+		token    string
+		expected int
+	}{
+		"no customer token":      {"", http.StatusForbidden},
+		"unrelated customer":     {customerToken(8), http.StatusForbidden},
+		"presented customer":     {customerToken(7), http.StatusOK},
+		"admin token":            {signHMAC(t, validClaims()), http.StatusUnauthorized},
+		"invalid customer token": {"not-a-token", http.StatusUnauthorized},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/transaction/redemption", nil)
+		req.Header.Set(constants.HeaderApiKey, "cvs_pos_secret")
+		if tc.token != "" {
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, tc.expected, w.Code, name)
+	}
+}
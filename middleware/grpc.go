@@ -0,0 +1,239 @@
+package middleware
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	pbApiKey "customer-voucher-service/protogen/api_key"
+	pbAudit "customer-voucher-service/protogen/audit"
+	pbBrand "customer-voucher-service/protogen/brand"
+	pbCategory "customer-voucher-service/protogen/category"
+	pbCustomer "customer-voucher-service/protogen/customer"
+	pbSearch "customer-voucher-service/protogen/search"
+	pbTransaction "customer-voucher-service/protogen/transaction"
+	pbVoucher "customer-voucher-service/protogen/voucher"
+	"customer-voucher-service/utils/auth"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionpbalpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// publicServices are left open by the auth and permission interceptors, so that load
+// balancers and tools such as grpcurl can use them without credentials.
+var publicServices = []string{
+	healthpb.Health_ServiceDesc.ServiceName,
+	reflectionpb.ServerReflection_ServiceDesc.ServiceName,
+	reflectionpbalpha.ServerReflection_ServiceDesc.ServiceName,
+}
+
+func isPublicMethod(fullMethod string) bool {
+	for _, service := range publicServices {
+		if strings.HasPrefix(fullMethod, "/"+service+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryAuthInterceptor is the gRPC counterpart of Authenticate, reading the token
+// from the "authorization" metadata.
+func UnaryAuthInterceptor(verifier *auth.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isPublicMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticateContext(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamAuthInterceptor(verifier *auth.Verifier) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isPublicMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, err := authenticateContext(ss.Context(), verifier)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticateContext binds a caller already authenticated by UnaryApiKeyInterceptor
+// to the customer token in the metadata, if any, like Authenticate does over HTTP.
+func authenticateContext(ctx context.Context, verifier *auth.Verifier) (context.Context, error) {
+	apiKeyCaller, isApiKey := auth.CallerFromContext(ctx)
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		if isApiKey {
+			return ctx, nil
+		}
+		return nil, error_base.ErrInvalidCredentials
+	}
+	token, ok := auth.BearerToken(values[0])
+	if !ok {
+		return nil, error_base.ErrInvalidCredentials
+	}
+	var caller *auth.Caller
+	var err error
+	if isApiKey {
+		caller, err = verifier.BindCustomer(apiKeyCaller, token)
+	} else {
+		caller, err = verifier.Verify(token)
+	}
+	if err != nil {
+		var appErr error_base.AppError
+		if !errors.As(err, &appErr) {
+			appErr = error_base.ErrInvalidCredentials
+		}
+		return nil, appErr
+	}
+	return auth.WithCaller(ctx, caller), nil
+}
+
+// UnaryApiKeyInterceptor is the gRPC counterpart of AuthenticateApiKey, reading the
+// key from the "x-api-key" metadata. It must be chained before UnaryAuthInterceptor.
+func UnaryApiKeyInterceptor(authenticator auth.ApiKeyAuthenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isPublicMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticateApiKeyContext(ctx, authenticator)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamApiKeyInterceptor(authenticator auth.ApiKeyAuthenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isPublicMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, err := authenticateApiKeyContext(ss.Context(), authenticator)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticateApiKeyContext(ctx context.Context, authenticator auth.ApiKeyAuthenticator) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(constants.MetadataApiKey)
+	if len(values) == 0 {
+		return ctx, nil
+	}
+	caller, err := authenticator.AuthenticateApiKey(ctx, values[0])
+	if err != nil {
+		return nil, error_base.ErrInvalidCredentials
+	}
+	return auth.WithCaller(ctx, caller), nil
+}
+
+// contextStream hands the handler a stream with a different context.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// methodPermissions mirrors the RequirePermission calls on the REST routes.
+var methodPermissions = map[string][]string{
+	pbApiKey.ApiKeyServiceCreateApiKeyFullMethodName: {constants.PermissionApiKeyWrite},
+	pbApiKey.ApiKeyServiceListApiKeyFullMethodName:   {constants.PermissionApiKeyRead},
+	pbApiKey.ApiKeyServiceRevokeApiKeyFullMethodName: {constants.PermissionApiKeyWrite},
+	pbApiKey.ApiKeyServiceRotateApiKeyFullMethodName: {constants.PermissionApiKeyWrite},
+
+	pbAudit.AuditServiceListAuditLogFullMethodName: {constants.PermissionAuditRead},
+
+	pbBrand.BrandServiceCreateBrandFullMethodName:  {constants.PermissionBrandWrite},
+	pbBrand.BrandServiceListBrandFullMethodName:    {constants.PermissionBrandRead},
+	pbBrand.BrandServiceDetailBrandFullMethodName:  {constants.PermissionBrandRead},
+	pbBrand.BrandServiceUpdateBrandFullMethodName:  {constants.PermissionBrandWrite},
+	pbBrand.BrandServiceDeleteBrandFullMethodName:  {constants.PermissionBrandWrite},
+	pbBrand.BrandServiceRestoreBrandFullMethodName: {constants.PermissionBrandWrite},
+
+	pbCategory.CategoryServiceCreateCategoryFullMethodName: {constants.PermissionCategoryWrite},
+	pbCategory.CategoryServiceListCategoryFullMethodName:   {constants.PermissionCategoryRead},
+	pbCategory.CategoryServiceUpdateCategoryFullMethodName: {constants.PermissionCategoryWrite},
+	pbCategory.CategoryServiceDeleteCategoryFullMethodName: {constants.PermissionCategoryWrite},
+
+	pbCustomer.CustomerServiceCreateCustomerFullMethodName:       {constants.PermissionCustomerWrite},
+	pbCustomer.CustomerServiceListCustomerFullMethodName:         {constants.PermissionCustomerRead},
+	pbCustomer.CustomerServiceDetailCustomerFullMethodName:       {constants.PermissionCustomerRead},
+	pbCustomer.CustomerServiceUpdateCustomerFullMethodName:       {constants.PermissionCustomerWrite},
+	pbCustomer.CustomerServiceUpdateCustomerPointsFullMethodName: {constants.PermissionCustomerWrite},
+	pbCustomer.CustomerServiceDeactivateCustomerFullMethodName:   {constants.PermissionCustomerWrite},
+	pbCustomer.CustomerServiceReactivateCustomerFullMethodName:   {constants.PermissionCustomerWrite},
+	pbCustomer.CustomerServiceReferralReportFullMethodName:       {constants.PermissionCustomerRead},
+
+	pbSearch.SearchServiceSearchFullMethodName: {constants.PermissionVoucherRead},
+
+	pbTransaction.TransactionServiceTransactionRedeemPointFullMethodName: {constants.PermissionTransactionRedeem, constants.PermissionVoucherBurn},
+	pbTransaction.TransactionServiceListTransactionFullMethodName:        {constants.PermissionTransactionRead},
+	pbTransaction.TransactionServiceDetailTransactionFullMethodName:      {constants.PermissionTransactionRead},
+	pbTransaction.TransactionServiceGiftVoucherFullMethodName:            {constants.PermissionTransactionRedeem},
+	pbTransaction.TransactionServiceClaimGiftVoucherFullMethodName:       {constants.PermissionTransactionRedeem},
+	pbTransaction.TransactionServiceListFraudReviewFullMethodName:        {constants.PermissionFraudReview},
+	pbTransaction.TransactionServiceReviewTransactionFullMethodName:      {constants.PermissionFraudReview},
+
+	pbVoucher.VoucherServiceCreateVoucherFullMethodName:  {constants.PermissionVoucherWrite},
+	pbVoucher.VoucherServiceListVoucherFullMethodName:    {constants.PermissionVoucherRead},
+	pbVoucher.VoucherServiceDetailVoucherFullMethodName:  {constants.PermissionVoucherRead},
+	pbVoucher.VoucherServiceUpdateVoucherFullMethodName:  {constants.PermissionVoucherWrite},
+	pbVoucher.VoucherServiceDeleteVoucherFullMethodName:  {constants.PermissionVoucherWrite},
+	pbVoucher.VoucherServiceRestoreVoucherFullMethodName: {constants.PermissionVoucherWrite},
+	pbVoucher.VoucherServiceSetVoucherTagsFullMethodName: {constants.PermissionVoucherWrite},
+	pbVoucher.VoucherServiceListTagFullMethodName:        {constants.PermissionVoucherRead},
+	pbVoucher.VoucherServiceDeleteTagFullMethodName:      {constants.PermissionCategoryWrite},
+}
+
+// UnaryPermissionInterceptor must be chained after UnaryAuthInterceptor. Methods
+// missing from methodPermissions are denied.
+func UnaryPermissionInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorizeMethod(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamPermissionInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorizeMethod(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func authorizeMethod(ctx context.Context, fullMethod string) error {
+	if isPublicMethod(fullMethod) {
+		return nil
+	}
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok {
+		return error_base.ErrInvalidCredentials
+	}
+	permissions, found := methodPermissions[fullMethod]
+	if !found || !hasAnyPermission(caller, permissions) {
+		return error_base.ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/utils/auth"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Authenticate verifies the bearer token and stores the caller in the request context.
+// Services read it through the context they receive, which needs ContextWithFallback
+// on the engine because handlers pass the gin.Context itself. For a request already
+// authenticated by AuthenticateApiKey, a bearer token is optional and must be the
+// token of the customer the key acts for.
+func Authenticate(verifier *auth.Verifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiKeyCaller, isApiKey := auth.CallerFromContext(c.Request.Context())
+		header := c.GetHeader("Authorization")
+		if isApiKey && header == "" {
+			c.Next()
+			return
+		}
+		token, ok := auth.BearerToken(header)
+		if !ok {
+			abort(c, error_base.ErrInvalidCredentials)
+			return
+		}
+		var caller *auth.Caller
+		var err error
+		if isApiKey {
+			caller, err = verifier.BindCustomer(apiKeyCaller, token)
+		} else {
+			caller, err = verifier.Verify(token)
+		}
+		if err != nil {
+			var appErr error_base.AppError
+			if !errors.As(err, &appErr) {
+				appErr = error_base.ErrInvalidCredentials
+			}
+			abort(c, appErr)
+			return
+		}
+		c.Request = c.Request.WithContext(auth.WithCaller(c.Request.Context(), caller))
+		c.Next()
+	}
+}
+
+// AuthenticateApiKey authenticates partner requests that send an X-API-Key header
+// and must run before Authenticate, which then lets them through. Requests without
+// the header are left to Authenticate.
+func AuthenticateApiKey(authenticator auth.ApiKeyAuthenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(constants.HeaderApiKey)
+		if key == "" {
+			c.Next()
+			return
+		}
+		caller, err := authenticator.AuthenticateApiKey(c.Request.Context(), key)
+		if err != nil {
+			abort(c, error_base.ErrInvalidCredentials)
+			return
+		}
+		c.Request = c.Request.WithContext(auth.WithCaller(c.Request.Context(), caller))
+		c.Next()
+	}
+}
+
+// RequirePermission must run after Authenticate. The caller needs any one of permissions.
+func RequirePermission(permissions ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		caller, ok := auth.CallerFromContext(c.Request.Context())
+		if !ok {
+			abort(c, error_base.ErrInvalidCredentials)
+			return
+		}
+		if !hasAnyPermission(caller, permissions) {
+			abort(c, error_base.ErrForbidden)
+			return
+		}
+		c.Next()
+	}
+}
+
+func hasAnyPermission(caller *auth.Caller, permissions []string) bool {
+	for _, permission := range permissions {
+		if caller.HasPermission(permission) {
+			return true
+		}
+	}
+	return false
+}
+
+func abort(c *gin.Context, appErr error_base.AppError) {
+	rendererFrom(c).Error(c, appErr)
+	c.Abort()
+}
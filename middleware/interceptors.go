@@ -0,0 +1,339 @@
+package middleware
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"customer-voucher-service/utils/request_id"
+	"customer-voucher-service/utils/validator"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Interceptor pairs the unary and stream form of one gRPC interceptor, so the server
+// can chain them in a configured order.
+type Interceptor struct {
+	Unary  grpc.UnaryServerInterceptor
+	Stream grpc.StreamServerInterceptor
+}
+
+// RecoveryInterceptor turns a panic in a handler into codes.Internal and logs its stack.
+func RecoveryInterceptor() Interceptor {
+	recoverPanic := func(ctx context.Context, fullMethod string, err *error) {
+		if r := recover(); r != nil {
+			slog.ErrorContext(ctx, "grpc panic", "method", fullMethod, "panic", fmt.Sprint(r), "stack", string(debug.Stack()))
+			*err = error_base.ErrInternalServer
+		}
+	}
+	return Interceptor{
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res interface{}, err error) {
+			defer recoverPanic(ctx, info.FullMethod, &err)
+			return handler(ctx, req)
+		},
+		Stream: func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+			defer recoverPanic(ss.Context(), info.FullMethod, &err)
+			return handler(srv, ss)
+		},
+	}
+}
+
+// LoggingInterceptor writes one structured line per call. It must be chained after the
+// request ID interceptor to log the request ID. A panicking call is logged as Internal.
+func LoggingInterceptor(logger *slog.Logger) Interceptor {
+	logCall := func(ctx context.Context, fullMethod string, start time.Time, err error) {
+		code := status.Code(err)
+		level := slog.LevelInfo
+		if code != codes.OK {
+			level = slog.LevelWarn
+		}
+		attrs := []slog.Attr{
+			slog.String("method", fullMethod),
+			slog.String("code", code.String()),
+			slog.Float64("duration_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.String("request_id", request_id.FromContext(ctx)),
+		}
+		if p, ok := peer.FromContext(ctx); ok {
+			attrs = append(attrs, slog.String("peer", p.Addr.String()))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+		}
+		logger.LogAttrs(ctx, level, "grpc request", attrs...)
+	}
+	return Interceptor{
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			start := time.Now()
+			defer onPanic(func(err error) { logCall(ctx, info.FullMethod, start, err) })
+			res, err := handler(ctx, req)
+			logCall(ctx, info.FullMethod, start, err)
+			return res, err
+		},
+		Stream: func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			start := time.Now()
+			defer onPanic(func(err error) { logCall(ss.Context(), info.FullMethod, start, err) })
+			err := handler(srv, ss)
+			logCall(ss.Context(), info.FullMethod, start, err)
+			return err
+		},
+	}
+}
+
+// onPanic, deferred, hands observe the Internal error RecoveryInterceptor will return
+// for a panic, then panics again for RecoveryInterceptor, chained outside, to recover.
+func onPanic(observe func(err error)) {
+	if r := recover(); r != nil {
+		observe(error_base.ErrInternalServer)
+		panic(r)
+	}
+}
+
+// ErrorInterceptor passes on status and domain errors and hides any other error behind
+// codes.Internal, so database errors do not reach the client. It must be chained inside
+// the logging and metrics interceptors for them to see the final code.
+func ErrorInterceptor() Interceptor {
+	return Interceptor{
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			res, err := handler(ctx, req)
+			if err != nil {
+				return nil, toStatusError(ctx, info.FullMethod, err)
+			}
+			return res, nil
+		},
+		Stream: func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := handler(srv, ss); err != nil {
+				return toStatusError(ss.Context(), info.FullMethod, err)
+			}
+			return nil
+		},
+	}
+}
+
+func toStatusError(ctx context.Context, fullMethod string, err error) error {
+	var appErr error_base.AppError
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	slog.ErrorContext(ctx, "grpc error", "method", fullMethod, "error", err.Error())
+	return error_base.ErrInternalServer
+}
+
+// latencyBuckets are the upper bounds, in milliseconds, of the latency histogram.
+var latencyBuckets = []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000}
+
+// GrpcMetrics counts calls, status codes and latency per method.
+type GrpcMetrics struct {
+	mu      sync.Mutex
+	methods map[string]*methodMetrics
+}
+
+type methodMetrics struct {
+	count   int64
+	codes   map[string]int64
+	totalMs float64
+	maxMs   float64
+	buckets []int64
+}
+
+// MethodStats is a snapshot of one method. Buckets counts calls by latency, keyed by
+// upper bound in milliseconds, with "+Inf" for the rest.
+type MethodStats struct {
+	Count   int64            `json:"count"`
+	Codes   map[string]int64 `json:"codes"`
+	AvgMs   float64          `json:"avg_ms"`
+	MaxMs   float64          `json:"max_ms"`
+	Buckets map[string]int64 `json:"buckets"`
+}
+
+func NewGrpcMetrics() *GrpcMetrics {
+	return &GrpcMetrics{methods: map[string]*methodMetrics{}}
+}
+
+func (m *GrpcMetrics) observe(fullMethod string, duration time.Duration, err error) {
+	ms := float64(duration.Microseconds()) / 1000
+	bucket := sort.SearchFloat64s(latencyBuckets, ms)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	method, ok := m.methods[fullMethod]
+	if !ok {
+		method = &methodMetrics{codes: map[string]int64{}, buckets: make([]int64, len(latencyBuckets)+1)}
+		m.methods[fullMethod] = method
+	}
+	method.count++
+	method.codes[status.Code(err).String()]++
+	method.totalMs += ms
+	if ms > method.maxMs {
+		method.maxMs = ms
+	}
+	method.buckets[bucket]++
+}
+
+func (m *GrpcMetrics) Snapshot() map[string]MethodStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	snapshot := map[string]MethodStats{}
+	for fullMethod, method := range m.methods {
+		stats := MethodStats{
+			Count:   method.count,
+			Codes:   map[string]int64{},
+			AvgMs:   method.totalMs / float64(method.count),
+			MaxMs:   method.maxMs,
+			Buckets: map[string]int64{},
+		}
+		for code, count := range method.codes {
+			stats.Codes[code] = count
+		}
+		for i, count := range method.buckets {
+			bound := "+Inf"
+			if i < len(latencyBuckets) {
+				bound = fmt.Sprint(latencyBuckets[i])
+			}
+			stats.Buckets[bound] = count
+		}
+		snapshot[fullMethod] = stats
+	}
+	return snapshot
+}
+
+func MetricsInterceptor(metrics *GrpcMetrics) Interceptor {
+	return Interceptor{
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			start := time.Now()
+			defer onPanic(func(err error) { metrics.observe(info.FullMethod, time.Since(start), err) })
+			res, err := handler(ctx, req)
+			metrics.observe(info.FullMethod, time.Since(start), err)
+			return res, err
+		},
+		Stream: func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			start := time.Now()
+			defer onPanic(func(err error) { metrics.observe(info.FullMethod, time.Since(start), err) })
+			err := handler(srv, ss)
+			metrics.observe(info.FullMethod, time.Since(start), err)
+			return err
+		},
+	}
+}
+
+// DeadlineInterceptor gives calls without a deadline defaultTimeout and caps longer
+// deadlines at maxTimeout. Calls whose deadline already passed are not run.
+func DeadlineInterceptor(defaultTimeout time.Duration, maxTimeout time.Duration) Interceptor {
+	withDeadline := func(ctx context.Context) (context.Context, context.CancelFunc, error) {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, nil, status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+		}
+		deadline, ok := ctx.Deadline()
+		switch {
+		case !ok:
+			ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+			return ctx, cancel, nil
+		case time.Until(deadline) > maxTimeout:
+			ctx, cancel := context.WithTimeout(ctx, maxTimeout)
+			return ctx, cancel, nil
+		}
+		return ctx, func() {}, nil
+	}
+	deadlineError := func(ctx context.Context, err error) error {
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+		}
+		return err
+	}
+	return Interceptor{
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			ctx, cancel, err := withDeadline(ctx)
+			if err != nil {
+				return nil, err
+			}
+			defer cancel()
+			res, err := handler(ctx, req)
+			return res, deadlineError(ctx, err)
+		},
+		Stream: func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			ctx, cancel, err := withDeadline(ss.Context())
+			if err != nil {
+				return err
+			}
+			defer cancel()
+			return deadlineError(ctx, handler(srv, &contextStream{ServerStream: ss, ctx: ctx}))
+		},
+	}
+}
+
+// requestValidator is implemented by requests that can check themselves.
+type requestValidator interface {
+	Validate() error
+}
+
+type pagedRequest interface {
+	GetPageSize() int32
+}
+
+// ValidationInterceptor rejects out of range page sizes, requests with a Validate method
+// that fails, and requests that break the rules of their method, with
+// codes.InvalidArgument and the failed fields before they reach the service.
+func ValidationInterceptor(rules ...validator.Rules) Interceptor {
+	methodRules := validator.Rules{}
+	for _, r := range rules {
+		for method, rule := range r {
+			methodRules[method] = rule
+		}
+	}
+	return Interceptor{
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := validateRequest(ctx, req, methodRules[info.FullMethod]); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		},
+		Stream: func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, &validatingStream{ServerStream: ss, rule: methodRules[info.FullMethod]})
+		},
+	}
+}
+
+func validateRequest(ctx context.Context, req interface{}, rule validator.Rule) error {
+	if v, ok := req.(requestValidator); ok {
+		if err := v.Validate(); err != nil {
+			var appErr error_base.AppError
+			if errors.As(err, &appErr) {
+				return appErr
+			}
+			return error_base.ErrValidationFailed.WithMessage(err.Error())
+		}
+	}
+	if p, ok := req.(pagedRequest); ok {
+		if size := p.GetPageSize(); size < 0 || size > constants.MaxPageSize {
+			return error_base.ErrValidationFailed.WithMessage(message.LimitMessage("pageSize", constants.MaxPageSize, constants.DefaultPageSize))
+		}
+	}
+	if rule != nil {
+		return rule(ctx, req)
+	}
+	return nil
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+	rule validator.Rule
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(s.Context(), m, s.rule)
+}
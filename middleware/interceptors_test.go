@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	pbBrand "customer-voucher-service/protogen/brand"
+	"customer-voucher-service/utils/validator"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testUnaryInfo = &grpc.UnaryServerInfo{FullMethod: pbBrand.BrandServiceListBrandFullMethodName}
+
+func TestRecoveryInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { panic("boom") }
+
+	res, err := RecoveryInterceptor().Unary(context.Background(), nil, testUnaryInfo, handler)
+	assert.Nil(t, res)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestLoggingInterceptor_Panic(t *testing.T) {
+	var logged bytes.Buffer
+	logging := LoggingInterceptor(slog.New(slog.NewJSONHandler(&logged, nil)))
+	metrics := NewGrpcMetrics()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { panic("boom") }
+	chained := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return logging.Unary(ctx, req, testUnaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return MetricsInterceptor(metrics).Unary(ctx, req, testUnaryInfo, handler)
+		})
+	}
+
+	_, err := RecoveryInterceptor().Unary(context.Background(), nil, testUnaryInfo, chained)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, logged.String(), `"code":"Internal"`)
+	assert.Equal(t, map[string]int64{"Internal": 1}, metrics.Snapshot()[testUnaryInfo.FullMethod].Codes)
+}
+
+func TestErrorInterceptor(t *testing.T) {
+	interceptor := ErrorInterceptor()
+	call := func(err error) error {
+		_, err = interceptor.Unary(context.Background(), nil, testUnaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, err
+		})
+		return err
+	}
+
+	err := call(fmt.Errorf("find brand: %w", error_base.ErrNotFound.WithMessage(message.NotFoundMessage("brand"))))
+	st := status.Convert(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "brand not found", st.Message())
+	assert.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	assert.True(t, ok)
+	assert.Equal(t, error_base.ErrNotFound.Reason, info.GetReason())
+	assert.Equal(t, error_base.Domain, info.GetDomain())
+	assert.Equal(t, error_base.ErrNotFound.Code, info.GetMetadata()["code"])
+
+	err = call(error_base.ErrValidationFailed.WithMessage("Name is required").WithFields(
+		error_base.FieldError{Field: "name", Rule: "required", Message: "Name is required"},
+	))
+	st = status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Len(t, st.Details(), 2)
+	badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+	assert.True(t, ok)
+	assert.Len(t, badRequest.GetFieldViolations(), 1)
+	assert.Equal(t, "name", badRequest.GetFieldViolations()[0].GetField())
+	assert.Equal(t, "required", badRequest.GetFieldViolations()[0].GetReason())
+	assert.Equal(t, "Name is required", badRequest.GetFieldViolations()[0].GetDescription())
+
+	err = call(errors.New(`pq: relation "brand" does not exist`))
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, error_base.ErrInternalServer.Message, status.Convert(err).Message())
+
+	err = call(status.Error(codes.Unavailable, "unavailable"))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestDeadlineInterceptor(t *testing.T) {
+	interceptor := DeadlineInterceptor(time.Second, time.Minute)
+	var remaining time.Duration
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		remaining = time.Until(deadline)
+		return nil, nil
+	}
+
+	_, err := interceptor.Unary(context.Background(), nil, testUnaryInfo, handler)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, remaining, time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	_, err = interceptor.Unary(ctx, nil, testUnaryInfo, handler)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, remaining, time.Minute)
+
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	_, err = interceptor.Unary(expired, nil, testUnaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("handler must not run after the deadline")
+		return nil, nil
+	})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestDeadlineInterceptor_HandlerTimesOut(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	_, err := DeadlineInterceptor(10*time.Millisecond, time.Minute).Unary(context.Background(), nil, testUnaryInfo, handler)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+type validatedReq struct {
+	err error
+}
+
+func (r *validatedReq) Validate() error {
+	return r.err
+}
+
+func TestValidationInterceptor(t *testing.T) {
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+	rule := validator.RuleFor(func(ctx context.Context, req *pbBrand.ListBrandReq) error {
+		if req.PageToken == "bad" {
+			return error_base.ErrValidationFailed.WithMessage("pageToken is invalid")
+		}
+		return nil
+	})
+	interceptor := ValidationInterceptor(validator.Rules{testUnaryInfo.FullMethod: rule})
+
+	_, err := interceptor.Unary(context.Background(), &pbBrand.ListBrandReq{PageSize: 1000}, testUnaryInfo, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = interceptor.Unary(context.Background(), &validatedReq{err: errors.New("name is required")}, testUnaryInfo, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "name is required", status.Convert(err).Message())
+	_, err = interceptor.Unary(context.Background(), &pbBrand.ListBrandReq{PageToken: "bad"}, testUnaryInfo, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "pageToken is invalid", status.Convert(err).Message())
+	assert.False(t, called)
+
+	_, err = interceptor.Unary(context.Background(), &pbBrand.ListBrandReq{PageSize: 10}, testUnaryInfo, handler)
+	assert.NoError(t, err)
+	assert.True(t, called)
+
+	// Rules only apply to their own method.
+	called = false
+	otherInfo := &grpc.UnaryServerInfo{FullMethod: pbBrand.BrandServiceDetailBrandFullMethodName}
+	_, err = interceptor.Unary(context.Background(), &pbBrand.ListBrandReq{PageToken: "bad"}, otherInfo, handler)
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestMetricsInterceptor(t *testing.T) {
+	metrics := NewGrpcMetrics()
+	interceptor := MetricsInterceptor(metrics)
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	failed := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	_, _ = interceptor.Unary(context.Background(), nil, testUnaryInfo, ok)
+	_, _ = interceptor.Unary(context.Background(), nil, testUnaryInfo, ok)
+	_, _ = interceptor.Unary(context.Background(), nil, testUnaryInfo, failed)
+
+	stats := metrics.Snapshot()[pbBrand.BrandServiceListBrandFullMethodName]
+	assert.Equal(t, int64(3), stats.Count)
+	assert.Equal(t, map[string]int64{"OK": 2, "NotFound": 1}, stats.Codes)
+	var bucketed int64
+	for _, count := range stats.Buckets {
+		bucketed += count
+	}
+	assert.Equal(t, int64(3), bucketed)
+}
@@ -0,0 +1,299 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	pbTransaction "customer-voucher-service/protogen/transaction"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/rate_limit"
+	"encoding/json"
+	"io"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// maxRedemptionPeek is how much of a redemption body is read for its customerId.
+const maxRedemptionPeek = 64 << 10
+
+// routeRateLimits picks the policy of a REST route ("<method> <path>") or gRPC method.
+// Other writes fall under constants.RateLimitWrite and reads are not limited. A route
+// in RATE_LIMIT_ROUTES is limited by its own policy instead, see routePolicy.
+var routeRateLimits = map[string]string{
+	http.MethodPost + " /api/v1/transaction/redemption":                  constants.RateLimitRedemption,
+	http.MethodPost + " /api/v2/transaction/redemption":                  constants.RateLimitRedemption,
+	pbTransaction.TransactionServiceTransactionRedeemPointFullMethodName: constants.RateLimitRedemption,
+}
+
+// RateLimit must run after Authenticate so that customers and API keys get their own
+// bucket. It answers 429 with Retry-After once the bucket is empty.
+func RateLimit(limiter *rate_limit.Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.Request.Method + " " + c.FullPath()
+		policy, ok := routePolicy(limiter, route, c.Request.Method != http.MethodGet)
+		if !ok {
+			c.Next()
+			return
+		}
+		keys := []string{rateLimitKey(c.Request.Context(), c.ClientIP())}
+		if routeRateLimits[route] == constants.RateLimitRedemption {
+			keys = append(keys, redemptionCustomerKey(c))
+		}
+		retryAfter, allowed := allowRequest(c.Request.Context(), limiter, policy, keys...)
+		if !allowed {
+			c.Header(constants.HeaderRetryAfter, retryAfter)
+			abort(c, error_base.ErrTooManyRequests)
+			return
+		}
+		c.Next()
+	}
+}
+
+// UnaryRateLimitInterceptor is the gRPC counterpart of RateLimit. It must be chained
+// after UnaryAuthInterceptor and returns ResourceExhausted with a retry-after header.
+func UnaryRateLimitInterceptor(limiter *rate_limit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := limitMethod(ctx, limiter, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamRateLimitInterceptor(limiter *rate_limit.Limiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := limitMethod(ss.Context(), limiter, info.FullMethod, nil); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func limitMethod(ctx context.Context, limiter *rate_limit.Limiter, fullMethod string, req interface{}) error {
+	policy, ok := routePolicy(limiter, fullMethod, isWriteMethod(fullMethod))
+	if !ok {
+		return nil
+	}
+	keys := []string{rateLimitKey(ctx, peerIP(ctx))}
+	if redeem, isRedeem := req.(*pbTransaction.TransactionRedeemPointReq); isRedeem {
+		keys = append(keys, customerKey(uint(redeem.GetCustomerId())))
+	}
+	retryAfter, allowed := allowRequest(ctx, limiter, policy, keys...)
+	if !allowed {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(constants.MetadataRetryAfter, retryAfter))
+		return error_base.ErrTooManyRequests
+	}
+	return nil
+}
+
+// routePolicy returns the policy of a route and whether the route is limited at all.
+// A route with a limit in RATE_LIMIT_ROUTES is its own policy.
+func routePolicy(limiter *rate_limit.Limiter, route string, write bool) (string, bool) {
+	if limiter.HasPolicy(route) {
+		return route, true
+	}
+	if policy, ok := routeRateLimits[route]; ok {
+		return policy, true
+	}
+	return constants.RateLimitWrite, write
+}
+
+// isWriteMethod treats a method as a write unless it only needs read permissions.
+func isWriteMethod(fullMethod string) bool {
+	for _, permission := range methodPermissions[fullMethod] {
+		if !strings.HasSuffix(permission, ":read") {
+			return true
+		}
+	}
+	return false
+}
+
+func peerIP(ctx context.Context) string {
+	p, found := peer.FromContext(ctx)
+	if !found {
+		return ""
+	}
+	ip := p.Addr.String()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return ip
+}
+
+// allowRequest charges the bucket of every key, skipping empty and repeated ones, and
+// returns the Retry-After value in seconds when one of them is empty. All buckets are
+// checked before any is charged, so a request denied by one bucket costs the others
+// nothing. A failing store lets the request through, an outage of a shared store
+// should not stop redemptions.
+func allowRequest(ctx context.Context, limiter *rate_limit.Limiter, policy string, keys ...string) (string, bool) {
+	unique := []string{}
+	seen := map[string]bool{}
+	for _, key := range keys {
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, key)
+	}
+	if retryAfter, allowed := allowAll(ctx, limiter.Peek, policy, unique); !allowed {
+		return retryAfter, false
+	}
+	return allowAll(ctx, limiter.Allow, policy, unique)
+}
+
+// allowAll runs check on the bucket of every key and stops at the first empty one.
+func allowAll(ctx context.Context, check func(ctx context.Context, policy string, key string) (rate_limit.Result, error), policy string, keys []string) (string, bool) {
+	for _, key := range keys {
+		result, err := check(ctx, policy, key)
+		if err != nil {
+			log.Printf("rate limit: %v", err)
+			continue
+		}
+		if !result.Allowed {
+			return retryAfterSeconds(result), false
+		}
+	}
+	return "", true
+}
+
+func retryAfterSeconds(result rate_limit.Result) string {
+	seconds := int64(math.Ceil(result.RetryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return strconv.FormatInt(seconds, 10)
+}
+
+// rateLimitKey gives customers and API keys a bucket of their own, wherever they call
+// from. Everyone else, admins and brand operators included, is limited per IP.
+func rateLimitKey(ctx context.Context, ip string) string {
+	if caller, ok := auth.CallerFromContext(ctx); ok {
+		switch {
+		case caller.Role == constants.RoleApiKey:
+			return caller.ID
+		case caller.Role == constants.RoleCustomer && caller.CustomerID != 0:
+			return customerKey(caller.CustomerID)
+		}
+	}
+	return "ip:" + ip
+}
+
+// customerKey is the bucket of the customer whose points are redeemed, whoever redeems
+// them, so that rotating callers or IPs does not get a customer more redemptions.
+func customerKey(customerId uint) string {
+	if customerId == 0 {
+		return ""
+	}
+	return "customer:" + strconv.FormatUint(uint64(customerId), 10)
+}
+
+// redemptionCustomerKey reads customerId from the first maxRedemptionPeek bytes of the
+// body and puts them back in front of the rest for the handler. A body it cannot read
+// is left to the handler to reject.
+func redemptionCustomerKey(c *gin.Context) string {
+	if c.Request.Body == nil {
+		return ""
+	}
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxRedemptionPeek))
+	c.Request.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), c.Request.Body), Closer: c.Request.Body}
+	if err != nil {
+		return ""
+	}
+	var payload struct {
+		CustomerId uint `json:"customerId"`
+	}
+	if json.Unmarshal(body, &payload) != nil {
+		return ""
+	}
+	return customerKey(payload.CustomerId)
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// LimitFailedAuth must run before AuthenticateApiKey. Every 401 takes a token from
+// the RateLimitAuthFailure bucket of the client IP, and once it is empty the IP gets
+// 429 without its credentials being checked, valid or not, until tokens come back.
+func LimitFailedAuth(limiter *rate_limit.Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := "ip:" + c.ClientIP()
+		if retryAfter, blocked := authFailureBlocked(c.Request.Context(), limiter, key); blocked {
+			c.Header(constants.HeaderRetryAfter, retryAfter)
+			abort(c, error_base.ErrTooManyRequests)
+			return
+		}
+		c.Next()
+		if c.Writer.Status() == http.StatusUnauthorized {
+			allowRequest(c.Request.Context(), limiter, constants.RateLimitAuthFailure, key)
+		}
+	}
+}
+
+// UnaryAuthFailureInterceptor is the gRPC counterpart of LimitFailedAuth and must be
+// chained before UnaryApiKeyInterceptor.
+func UnaryAuthFailureInterceptor(limiter *rate_limit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key := "ip:" + peerIP(ctx)
+		if err := checkAuthFailures(ctx, limiter, info.FullMethod, key); err != nil {
+			return nil, err
+		}
+		resp, err := handler(ctx, req)
+		countAuthFailure(ctx, limiter, err, key)
+		return resp, err
+	}
+}
+
+func StreamAuthFailureInterceptor(limiter *rate_limit.Limiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		key := "ip:" + peerIP(ss.Context())
+		if err := checkAuthFailures(ss.Context(), limiter, info.FullMethod, key); err != nil {
+			return err
+		}
+		err := handler(srv, ss)
+		countAuthFailure(ss.Context(), limiter, err, key)
+		return err
+	}
+}
+
+func checkAuthFailures(ctx context.Context, limiter *rate_limit.Limiter, fullMethod string, key string) error {
+	if isPublicMethod(fullMethod) {
+		return nil
+	}
+	if retryAfter, blocked := authFailureBlocked(ctx, limiter, key); blocked {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(constants.MetadataRetryAfter, retryAfter))
+		return error_base.ErrTooManyRequests
+	}
+	return nil
+}
+
+func countAuthFailure(ctx context.Context, limiter *rate_limit.Limiter, err error, key string) {
+	if status.Code(err) == codes.Unauthenticated {
+		allowRequest(ctx, limiter, constants.RateLimitAuthFailure, key)
+	}
+}
+
+func authFailureBlocked(ctx context.Context, limiter *rate_limit.Limiter, key string) (string, bool) {
+	result, err := limiter.Peek(ctx, constants.RateLimitAuthFailure, key)
+	if err != nil {
+		log.Printf("rate limit: %v", err)
+		return "", false
+	}
+	if result.Allowed {
+		return "", false
+	}
+	return retryAfterSeconds(result), true
+}
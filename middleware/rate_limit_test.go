@@ -0,0 +1,241 @@
+package middleware
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	pbBrand "customer-voucher-service/protogen/brand"
+	pbTransaction "customer-voucher-service/protogen/transaction"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/rate_limit"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func newTestLimiter() *rate_limit.Limiter {
+	return rate_limit.NewLimiter(rate_limit.NewMemoryStore(), map[string]rate_limit.Limit{
+		constants.RateLimitRedemption:  {Requests: 1, Period: time.Minute},
+		constants.RateLimitWrite:       {Requests: 2, Period: time.Minute},
+		constants.RateLimitAuthFailure: {Requests: 2, Period: time.Minute},
+	})
+}
+
+func TestRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	customer := func(c *gin.Context) {
+		customerId := uint(1)
+		if c.GetHeader("X-Customer") == "2" {
+			customerId = 2
+		}
+		c.Request = c.Request.WithContext(auth.WithCaller(c.Request.Context(), &auth.Caller{ID: "user", Role: constants.RoleCustomer, CustomerID: customerId}))
+	}
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	api := r.Group("/api/v1", customer, RateLimit(newTestLimiter()))
+	api.POST("/transaction/redemption", ok)
+	api.GET("/voucher/list", ok)
+
+	send := func(method, path, customerId string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("X-Customer", customerId)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, send(http.MethodPost, "/api/v1/transaction/redemption", "1").Code)
+	w := send(http.MethodPost, "/api/v1/transaction/redemption", "1")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "60", w.Header().Get(constants.HeaderRetryAfter))
+	var body map[string]string
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, error_base.ErrTooManyRequests.Code, body["code"])
+
+	// Another customer has its own bucket, and reads are not limited.
+	assert.Equal(t, http.StatusOK, send(http.MethodPost, "/api/v1/transaction/redemption", "2").Code)
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, send(http.MethodGet, "/api/v1/voucher/list", "1").Code)
+	}
+}
+
+func TestRateLimit_RedemptionKeyedOnCustomer(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	apiKey := func(c *gin.Context) {
+		c.Request = c.Request.WithContext(auth.WithCaller(c.Request.Context(), &auth.Caller{ID: "api_key:" + c.GetHeader("X-Key"), Role: constants.RoleApiKey}))
+	}
+	var received string
+	api := r.Group("/api/v1", apiKey, RateLimit(newTestLimiter()))
+	api.POST("/transaction/redemption", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		received = string(body)
+		c.Status(http.StatusOK)
+	})
+
+	send := func(key, body string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/transaction/redemption", strings.NewReader(body))
+		req.Header.Set("X-Key", key)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, send("a", `{"customerId":7}`))
+	assert.Equal(t, `{"customerId":7}`, received)
+	// Another key redeeming for the same customer shares its bucket.
+	assert.Equal(t, http.StatusTooManyRequests, send("b", `{"customerId":7}`))
+	assert.Equal(t, http.StatusOK, send("c", `{"customerId":8}`))
+	// The denied request did not use up the token of key b.
+	assert.Equal(t, http.StatusOK, send("b", `{"customerId":9}`))
+
+	// A body longer than the part read for customerId reaches the handler whole.
+	long := `{"customerId":10,"note":"` + strings.Repeat("x", maxRedemptionPeek) + `"}`
+	assert.Equal(t, http.StatusOK, send("d", long))
+	assert.Equal(t, long, received)
+}
+
+func TestRateLimit_RouteOverride(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	limiter := rate_limit.NewLimiter(rate_limit.NewMemoryStore(), map[string]rate_limit.Limit{
+		constants.RateLimitWrite:       {Requests: 2, Period: time.Minute},
+		"POST /api/v1/brand/create":    {Requests: 1, Period: time.Minute},
+		"DELETE /api/v1/brand/delete":  {},
+		constants.RateLimitRedemption:  {Requests: 1, Period: time.Minute},
+		constants.RateLimitAuthFailure: {Requests: 2, Period: time.Minute},
+	})
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	api := r.Group("/api/v1", RateLimit(limiter))
+	api.POST("/brand/create", ok)
+	api.PUT("/brand/update", ok)
+	api.DELETE("/brand/delete", ok)
+
+	send := func(method, path string) int {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, send(http.MethodPost, "/api/v1/brand/create"))
+	assert.Equal(t, http.StatusTooManyRequests, send(http.MethodPost, "/api/v1/brand/create"))
+	// The override has its own bucket, the write bucket is still full.
+	assert.Equal(t, http.StatusOK, send(http.MethodPut, "/api/v1/brand/update"))
+	assert.Equal(t, http.StatusOK, send(http.MethodPut, "/api/v1/brand/update"))
+	// A disabled override turns the limit off for its route.
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, send(http.MethodDelete, "/api/v1/brand/delete"))
+	}
+}
+
+func TestLimitFailedAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	assert.NoError(t, r.SetTrustedProxies(nil))
+	r.Use(LimitFailedAuth(newTestLimiter()))
+	r.GET("/", func(c *gin.Context) {
+		if c.GetHeader("Authorization") != "Bearer valid" {
+			abort(c, error_base.ErrInvalidCredentials)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	send := func(token, forwardedFor string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, send("valid", "").Code)
+	// A forged X-Forwarded-For does not give the client a fresh bucket.
+	assert.Equal(t, http.StatusUnauthorized, send("guess", "1.1.1.1").Code)
+	assert.Equal(t, http.StatusUnauthorized, send("guess", "2.2.2.2").Code)
+	w := send("valid", "3.3.3.3")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "30", w.Header().Get(constants.HeaderRetryAfter))
+}
+
+func TestUnaryAuthFailureInterceptor(t *testing.T) {
+	interceptor := UnaryAuthFailureInterceptor(newTestLimiter())
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}})
+	fail := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, error_base.ErrInvalidCredentials
+	}
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: pbBrand.BrandServiceListBrandFullMethodName}
+
+	for i := 0; i < 2; i++ {
+		_, err := interceptor(ctx, nil, info, fail)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+	_, err := interceptor(ctx, nil, info, ok)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Health checks stay open.
+	health := &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}
+	_, err = interceptor(ctx, nil, health, ok)
+	assert.NoError(t, err)
+}
+
+func TestRateLimitKey(t *testing.T) {
+	apiKey := auth.WithCaller(context.Background(), &auth.Caller{ID: "api_key:abc", Role: constants.RoleApiKey})
+	customer := auth.WithCaller(context.Background(), &auth.Caller{ID: "user-1", Role: constants.RoleCustomer, CustomerID: 7})
+	admin := auth.WithCaller(context.Background(), &auth.Caller{ID: "user-1", Role: constants.RoleAdmin})
+
+	assert.Equal(t, "api_key:abc", rateLimitKey(apiKey, "10.0.0.1"))
+	assert.Equal(t, "customer:7", rateLimitKey(customer, "10.0.0.1"))
+	assert.Equal(t, "ip:10.0.0.1", rateLimitKey(admin, "10.0.0.1"))
+	assert.Equal(t, "ip:10.0.0.1", rateLimitKey(context.Background(), "10.0.0.1"))
+}
+
+func TestUnaryRateLimitInterceptor(t *testing.T) {
+	interceptor := UnaryRateLimitInterceptor(newTestLimiter())
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{ID: "api_key:abc", Role: constants.RoleApiKey})
+	redeem := &grpc.UnaryServerInfo{FullMethod: pbTransaction.TransactionServiceTransactionRedeemPointFullMethodName}
+
+	_, err := interceptor(ctx, nil, redeem, handler)
+	assert.NoError(t, err)
+	_, err = interceptor(ctx, nil, redeem, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Another key redeeming for a customer already limited is limited too.
+	other := auth.WithCaller(context.Background(), &auth.Caller{ID: "api_key:def", Role: constants.RoleApiKey})
+	_, err = interceptor(other, &pbTransaction.TransactionRedeemPointReq{CustomerId: 7}, redeem, handler)
+	assert.NoError(t, err)
+	third := auth.WithCaller(context.Background(), &auth.Caller{ID: "api_key:ghi", Role: constants.RoleApiKey})
+	_, err = interceptor(third, &pbTransaction.TransactionRedeemPointReq{CustomerId: 7}, redeem, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	list := &grpc.UnaryServerInfo{FullMethod: pbBrand.BrandServiceListBrandFullMethodName}
+	create := &grpc.UnaryServerInfo{FullMethod: pbBrand.BrandServiceCreateBrandFullMethodName}
+	for i := 0; i < 2; i++ {
+		_, err = interceptor(ctx, nil, create, handler)
+		assert.NoError(t, err)
+	}
+	_, err = interceptor(ctx, nil, create, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = interceptor(ctx, nil, list, handler)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/utils/request_id"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestID keeps the client's X-Request-ID, or generates one, and echoes it on the
+// response. Services read it from their context, e.g. for the audit log.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := request_id.Resolve(c.GetHeader(constants.HeaderRequestID))
+		c.Request = c.Request.WithContext(request_id.WithRequestID(c.Request.Context(), id))
+		c.Header(constants.HeaderRequestID, id)
+		c.Next()
+	}
+}
+
+func UnaryRequestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		id := resolveRequestID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(constants.MetadataRequestID, id))
+		return handler(request_id.WithRequestID(ctx, id), req)
+	}
+}
+
+func StreamRequestIDInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		id := resolveRequestID(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(constants.MetadataRequestID, id))
+		return handler(srv, &contextStream{ServerStream: ss, ctx: request_id.WithRequestID(ss.Context(), id)})
+	}
+}
+
+func resolveRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var id string
+	if values := md.Get(constants.MetadataRequestID); len(values) > 0 {
+		id = values[0]
+	}
+	return request_id.Resolve(id)
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"customer-voucher-service/constants"
+	"customer-voucher-service/utils/request_id"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.GET("/ping", RequestID(), func(c *gin.Context) {
+		c.String(http.StatusOK, request_id.FromContext(c))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.Header.Set(constants.HeaderRequestID, "req-1")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, "req-1", w.Body.String())
+	assert.Equal(t, "req-1", w.Header().Get(constants.HeaderRequestID))
+
+	for _, sent := range []string{"", strings.Repeat("x", 65)} {
+		req = httptest.NewRequest(http.MethodGet, "/ping", nil)
+		req.Header.Set(constants.HeaderRequestID, sent)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		assert.Len(t, w.Body.String(), 32)
+		assert.Equal(t, w.Body.String(), w.Header().Get(constants.HeaderRequestID))
+	}
+}
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/utils/json_response"
+	"errors"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	resultKey   = "response.result"
+	rendererKey = "response.renderer"
+)
+
+// HandlerFunc returns the result of a request, or the error to answer with, and leaves
+// writing the response to Response.
+type HandlerFunc func(c *gin.Context) (interface{}, error)
+
+// Handle adapts a HandlerFunc to gin. The error goes to c.Errors and the result is kept
+// on the context for Response.
+func Handle(handler HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		result, err := handler(c)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		c.Set(resultKey, result)
+	}
+}
+
+// Renderer writes the response of a route group.
+type Renderer struct {
+	Success func(c *gin.Context, result interface{})
+	Error   func(c *gin.Context, err error)
+}
+
+// EnvelopeRenderer is the APIResponse envelope of /api/v1.
+var EnvelopeRenderer = Renderer{
+	Success: func(c *gin.Context, result interface{}) { json_response.Success(c, constants.CodeSystem, result) },
+	Error:   func(c *gin.Context, err error) { json_response.Error(c, constants.CodeSystem, err) },
+}
+
+// ProblemRenderer answers /api/v2 with the bare result, and with RFC 7807 problem
+// details on errors.
+var ProblemRenderer = Renderer{
+	Success: json_response.Result,
+	Error:   json_response.Problem,
+}
+
+// Response renders the result or the error with renderer once the handler has
+// returned, and so do the middlewares that abort before it. It must run after
+// RequestID. Responses that are already written are left alone.
+func Response(renderer Renderer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(rendererKey, renderer)
+		c.Next()
+		if c.Writer.Written() {
+			return
+		}
+		if err := c.Errors.Last(); err != nil {
+			var appErr error_base.AppError
+			if !errors.As(err.Err, &appErr) {
+				log.Printf("%s %s: %v", c.Request.Method, c.FullPath(), err.Err)
+			}
+			renderer.Error(c, err.Err)
+			return
+		}
+		if result, ok := c.Get(resultKey); ok {
+			renderer.Success(c, result)
+		}
+	}
+}
+
+// Recovery answers a panic with a 500 from the renderer of the route group, so that
+// /api/v2 answers with problem details too, and logs it with its stack.
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecovery(func(c *gin.Context, recovered any) {
+		abort(c, error_base.ErrInternalServer)
+	})
+}
+
+// rendererFrom falls back to the envelope outside of Response.
+func rendererFrom(c *gin.Context) Renderer {
+	if renderer, ok := c.Get(rendererKey); ok {
+		return renderer.(Renderer)
+	}
+	return EnvelopeRenderer
+}
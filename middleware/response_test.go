@@ -0,0 +1,149 @@
+package middleware
+
+import (
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/utils/json_response"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	api := r.Group("/api/v1", RequestID(), Response(EnvelopeRenderer))
+	api.GET("/ok", Handle(func(c *gin.Context) (interface{}, error) {
+		return map[string]int{"id": 1}, nil
+	}))
+	api.GET("/not-found", Handle(func(c *gin.Context) (interface{}, error) {
+		return nil, error_base.ErrNotFound.WithMessage("brand not found")
+	}))
+	api.GET("/db", Handle(func(c *gin.Context) (interface{}, error) {
+		return nil, errors.New("connection refused")
+	}))
+	api.GET("/forbidden", RequirePermission(constants.PermissionAuditRead), Handle(func(c *gin.Context) (interface{}, error) {
+		t.Fatal("handler must not run after abort")
+		return nil, nil
+	}))
+
+	send := func(path string) (*httptest.ResponseRecorder, json_response.APIResponse) {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(constants.HeaderRequestID, "req-1")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		var body json_response.APIResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		return w, body
+	}
+
+	w, body := send("/api/v1/ok")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "00", body.Code)
+	assert.Equal(t, "req-1", body.RequestID)
+	assert.Equal(t, map[string]interface{}{"id": float64(1)}, body.Result)
+
+	w, body = send("/api/v1/not-found")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, error_base.ErrNotFound.Code, body.Code)
+	assert.Equal(t, "brand not found", body.MessageError)
+	assert.Equal(t, "req-1", body.RequestID)
+
+	w, body = send("/api/v1/db")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, error_base.ErrInternalServer.Code, body.Code)
+	assert.Equal(t, error_base.ErrInternalServer.Message, body.MessageError)
+
+	w, body = send("/api/v1/forbidden")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, error_base.ErrInvalidCredentials.Code, body.Code)
+	assert.Equal(t, "req-1", body.RequestID)
+}
+
+func TestResponse_Problem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	api := r.Group("/api/v2", RequestID(), Response(ProblemRenderer))
+	api.GET("/ok", Handle(func(c *gin.Context) (interface{}, error) {
+		return map[string]int{"id": 1}, nil
+	}))
+	api.POST("/invalid", Handle(func(c *gin.Context) (interface{}, error) {
+		return nil, error_base.ErrValidationFailed.WithMessage("Name is required").WithFields(error_base.FieldError{Field: "name", Rule: "required", Message: "Name is required"})
+	}))
+	api.GET("/forbidden", RequirePermission(constants.PermissionAuditRead), Handle(func(c *gin.Context) (interface{}, error) {
+		return nil, nil
+	}))
+
+	send := func(method, path string) (*httptest.ResponseRecorder, json_response.ProblemDetails) {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set(constants.HeaderRequestID, "req-1")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		var body json_response.ProblemDetails
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		return w, body
+	}
+
+	w, _ := send(http.MethodGet, "/api/v2/ok")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"id": 1}`, w.Body.String())
+
+	w, body := send(http.MethodPost, "/api/v2/invalid")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, json_response.ProblemContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, json_response.ProblemDetails{
+		Type:      "/problems/validation-failed",
+		Title:     "Validation failed",
+		Status:    http.StatusBadRequest,
+		Detail:    "Name is required",
+		Instance:  "/api/v2/invalid",
+		Code:      error_base.ErrValidationFailed.Code,
+		RequestID: "req-1",
+		Errors:    []error_base.FieldError{{Field: "name", Rule: "required", Message: "Name is required"}},
+	}, body)
+
+	w, body = send(http.MethodGet, "/api/v2/forbidden")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, json_response.ProblemContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, "/problems/invalid-credentials", body.Type)
+}
+
+func TestRecovery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(Recovery())
+	panicking := func(c *gin.Context) (interface{}, error) { panic("boom") }
+	r.Group("/api/v1", RequestID(), Response(EnvelopeRenderer)).GET("/panic", Handle(panicking))
+	r.Group("/api/v2", RequestID(), Response(ProblemRenderer)).GET("/panic", Handle(panicking))
+
+	send := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	w := send("/api/v1/panic")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	var envelope map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &envelope))
+	assert.Equal(t, error_base.ErrInternalServer.Code, envelope["code"])
+
+	w = send("/api/v2/panic")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, json_response.ProblemContentType, w.Header().Get("Content-Type"))
+	var problem json_response.ProblemDetails
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, "/problems/internal", problem.Type)
+}
+
+func TestProblemType_BaseURI(t *testing.T) {
+	assert.Equal(t, "/problems/not-found", json_response.ProblemType(error_base.ErrNotFound))
+
+	t.Setenv("PROBLEM_TYPE_BASE_URI", "https://api.example.com/problems/")
+	assert.Equal(t, "https://api.example.com/problems/not-found", json_response.ProblemType(error_base.ErrNotFound))
+}
@@ -0,0 +1,22 @@
+package api_key_model
+
+import "time"
+
+type ApiKey struct {
+	ID           uint       `gorm:"primaryKey;autoIncrement" json:"id"`
+	BrandID      uint       `gorm:"not null;index" json:"brand_id"`
+	Name         string     `gorm:"type:varchar(255);not null" json:"name"`
+	Prefix       string     `gorm:"type:varchar(16);uniqueIndex;not null" json:"prefix"`
+	KeyHash      string     `gorm:"type:varchar(64);not null" json:"-"`
+	Scopes       []string   `gorm:"serializer:json;type:text;not null" json:"scopes"`
+	IsRevoked    bool       `gorm:"default:false;not null" json:"is_revoked"`
+	RevokedDate  *time.Time `json:"revoked_date"`
+	CreatedDate  time.Time  `gorm:"autoCreateTime" json:"created_date"`
+	CreatedBy    string     `gorm:"type:varchar(255)" json:"created_by"`
+	ModifiedDate time.Time  `gorm:"autoUpdateTime" json:"modified_date"`
+	ModifiedBy   string     `gorm:"type:varchar(255)" json:"modified_by"`
+}
+
+func (ApiKey) TableName() string {
+	return "api_key"
+}
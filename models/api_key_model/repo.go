@@ -0,0 +1,107 @@
+package api_key_model
+
+import (
+	"context"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type IApiKeyRepo interface {
+	WithContext(ctx context.Context) IApiKeyRepo
+	CreateApiKey(apiKey *ApiKey) error
+	ListApiKey(brandId *uint, page *pagination.Page) ([]*ApiKey, int64, error)
+	FindApiKeyById(id uint) (*ApiKey, error)
+	FindApiKeyByPrefix(prefix string) (*ApiKey, error)
+	RevokeApiKey(id uint, modifiedBy string) error
+	RotateApiKey(id uint, modifiedBy string, newApiKey *ApiKey) error
+}
+
+type ApiKeyRepo struct {
+	db *gorm.DB
+}
+
+func NewApiKeyRepo(db *gorm.DB) *ApiKeyRepo {
+	return &ApiKeyRepo{
+		db: db,
+	}
+}
+
+func (r *ApiKeyRepo) WithContext(ctx context.Context) IApiKeyRepo {
+	return &ApiKeyRepo{db: transactor.DB(ctx, r.db)}
+}
+
+func (r *ApiKeyRepo) CreateApiKey(apiKey *ApiKey) error {
+	return r.db.Create(apiKey).Error
+}
+
+// ListApiKey includes revoked keys, they stay listed for reference.
+func (r *ApiKeyRepo) ListApiKey(brandId *uint, page *pagination.Page) ([]*ApiKey, int64, error) {
+	var apiKeys []*ApiKey
+	var total int64
+	query := r.db.Model(&ApiKey{})
+	if brandId != nil {
+		query = query.Where("brand_id = ?", *brandId)
+	}
+
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page.Cursor != nil {
+		query = query.Where("id > ?", page.Cursor.ID)
+	}
+
+	err := query.Order("id").Limit(page.Limit()).Find(&apiKeys).Error
+	return apiKeys, total, err
+}
+
+func (r *ApiKeyRepo) FindApiKeyById(id uint) (*ApiKey, error) {
+	var apiKey ApiKey
+	err := r.db.Where("id = ?", id).First(&apiKey).Error
+	if err != nil {
+		return nil, err
+	}
+	return &apiKey, nil
+}
+
+// FindApiKeyByPrefix only finds active keys whose brand is active.
+func (r *ApiKeyRepo) FindApiKeyByPrefix(prefix string) (*ApiKey, error) {
+	var apiKey ApiKey
+	err := r.db.Joins(`JOIN "brand" ON "brand"."id" = "api_key"."brand_id" AND "brand"."is_deleted" = ?`, false).
+		Where(`"api_key"."prefix" = ? AND "api_key"."is_revoked" = ?`, prefix, false).
+		First(&apiKey).Error
+	if err != nil {
+		return nil, err
+	}
+	return &apiKey, nil
+}
+
+func (r *ApiKeyRepo) RevokeApiKey(id uint, modifiedBy string) error {
+	return revoke(r.db, id, modifiedBy)
+}
+
+// RotateApiKey revokes the key and creates its replacement in one transaction.
+func (r *ApiKeyRepo) RotateApiKey(id uint, modifiedBy string, newApiKey *ApiKey) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := revoke(tx, id, modifiedBy); err != nil {
+			return err
+		}
+		return tx.Create(newApiKey).Error
+	})
+}
+
+// revoke only revokes an active key. It returns gorm.ErrRecordNotFound when the key
+// is already revoked, so two concurrent rotations cannot both create a replacement.
+func revoke(db *gorm.DB, id uint, modifiedBy string) error {
+	result := db.Model(&ApiKey{}).Where("id = ? AND is_revoked = ?", id, false).
+		Updates(map[string]interface{}{"is_revoked": true, "revoked_date": time.Now(), "modified_by": modifiedBy})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
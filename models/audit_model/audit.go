@@ -0,0 +1,78 @@
+package audit_model
+
+import (
+	"context"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/env"
+	"customer-voucher-service/utils/request_id"
+	"encoding/json"
+	"reflect"
+)
+
+// NewAuditLog builds the entry for a change of entityType/entityId from before to after.
+// Either side may be nil, e.g. before for a create. The actor and request ID come from ctx.
+func NewAuditLog(ctx context.Context, action string, entityType string, entityId uint, before interface{}, after interface{}) (*AuditLog, error) {
+	beforeFields, err := toFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := toFields(after)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range beforeFields {
+		if afterValue, ok := afterFields[key]; ok && reflect.DeepEqual(value, afterValue) {
+			delete(beforeFields, key)
+			delete(afterFields, key)
+		}
+	}
+
+	auditLog := &AuditLog{
+		Actor:      auth.Actor(ctx, env.GetString("SYSTEM_IDENTITY", "system")),
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityId,
+		RequestID:  request_id.FromContext(ctx),
+	}
+	if auditLog.Before, err = toJSON(beforeFields); err != nil {
+		return nil, err
+	}
+	if auditLog.After, err = toJSON(afterFields); err != nil {
+		return nil, err
+	}
+	return auditLog, nil
+}
+
+// Record writes the entry. Call it with the ctx of the transaction that makes the change,
+// so a change is never saved without its entry.
+func Record(ctx context.Context, repo IAuditRepo, action string, entityType string, entityId uint, before interface{}, after interface{}) error {
+	auditLog, err := NewAuditLog(ctx, action, entityType, entityId, before, after)
+	if err != nil {
+		return err
+	}
+	return repo.WithContext(ctx).CreateAuditLog(auditLog)
+}
+
+func toFields(value interface{}) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	if value == nil {
+		return fields, nil
+	}
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr && v.IsNil() {
+		return fields, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(b, &fields)
+	return fields, err
+}
+
+func toJSON(fields map[string]interface{}) (string, error) {
+	if len(fields) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(fields)
+	return string(b), err
+}
@@ -0,0 +1,83 @@
+package audit_model
+
+import (
+	"context"
+	pb "customer-voucher-service/protogen/audit"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/request_id"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func setupMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	dialector := postgres.New(postgres.Config{
+		Conn: db,
+		DSN:  "sqlmock_db_0",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open gorm DB: %v", err)
+	}
+	return gormDB, mock, func() { db.Close() }
+}
+
+type customer struct {
+	FullName string `json:"full_name"`
+	Points   int64  `json:"points"`
+}
+
+func TestNewAuditLog_KeepsChangedFields(t *testing.T) {
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{ID: "admin-1"})
+	ctx = request_id.WithRequestID(ctx, "req-1")
+
+	auditLog, err := NewAuditLog(ctx, "update", "customer", 7, &customer{FullName: "Jane", Points: 5000}, &customer{FullName: "Jane", Points: 50})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "admin-1", auditLog.Actor)
+	assert.Equal(t, "req-1", auditLog.RequestID)
+	assert.Equal(t, uint(7), auditLog.EntityID)
+	assert.JSONEq(t, `{"points":5000}`, auditLog.Before)
+	assert.JSONEq(t, `{"points":50}`, auditLog.After)
+}
+
+func TestNewAuditLog_Create(t *testing.T) {
+	t.Setenv("SYSTEM_IDENTITY", "gift-expiry-job")
+
+	auditLog, err := NewAuditLog(context.Background(), "create", "customer", 7, nil, &customer{FullName: "Jane"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "gift-expiry-job", auditLog.Actor)
+	assert.Empty(t, auditLog.Before)
+	assert.JSONEq(t, `{"full_name":"Jane","points":0}`, auditLog.After)
+}
+
+func TestListAuditLog_Filters(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewAuditRepo(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "audit_log" WHERE entity_type = $1 AND entity_id = $2 AND actor = $3`)).
+		WithArgs("customer", 7, "admin-1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "audit_log" WHERE entity_type = $1 AND entity_id = $2 AND actor = $3 AND id < $4 ORDER BY id DESC LIMIT $5`)).
+		WithArgs("customer", 7, "admin-1", 10, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "actor"}).AddRow(9, "admin-1").AddRow(8, "admin-1"))
+
+	req := &pb.ListAuditLogReq{EntityType: "customer", EntityId: 7, Actor: "admin-1"}
+	result, total, err := repo.ListAuditLog(req, &pagination.Page{Size: 2, Cursor: &pagination.Cursor{ID: 10}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Len(t, result, 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,21 @@
+package audit_model
+
+import "time"
+
+// AuditLog records one mutation. Before and After are JSON objects holding only the
+// fields that changed, Before is empty for creates.
+type AuditLog struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement" json:"id"`
+	Actor       string    `gorm:"type:varchar(255);not null;index" json:"actor"`
+	Action      string    `gorm:"type:varchar(20);not null" json:"action"`
+	EntityType  string    `gorm:"type:varchar(50);not null;index:idx_audit_log_entity" json:"entity_type"`
+	EntityID    uint      `gorm:"not null;index:idx_audit_log_entity" json:"entity_id"`
+	Before      string    `gorm:"type:text" json:"before"`
+	After       string    `gorm:"type:text" json:"after"`
+	RequestID   string    `gorm:"type:varchar(64)" json:"request_id"`
+	CreatedDate time.Time `gorm:"autoCreateTime" json:"created_date"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_log"
+}
@@ -0,0 +1,60 @@
+package audit_model
+
+import (
+	"context"
+	pb "customer-voucher-service/protogen/audit"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
+
+	"gorm.io/gorm"
+)
+
+type IAuditRepo interface {
+	WithContext(ctx context.Context) IAuditRepo
+	CreateAuditLog(auditLog *AuditLog) error
+	ListAuditLog(req *pb.ListAuditLogReq, page *pagination.Page) ([]*AuditLog, int64, error)
+}
+
+type AuditRepo struct {
+	db *gorm.DB
+}
+
+func NewAuditRepo(db *gorm.DB) *AuditRepo {
+	return &AuditRepo{
+		db: db,
+	}
+}
+
+func (r *AuditRepo) WithContext(ctx context.Context) IAuditRepo {
+	return &AuditRepo{db: transactor.DB(ctx, r.db)}
+}
+
+func (r *AuditRepo) CreateAuditLog(auditLog *AuditLog) error {
+	return r.db.Create(auditLog).Error
+}
+
+// ListAuditLog returns the newest entries first.
+func (r *AuditRepo) ListAuditLog(req *pb.ListAuditLogReq, page *pagination.Page) ([]*AuditLog, int64, error) {
+	var auditLogs []*AuditLog
+	var total int64
+	query := r.db.Model(&AuditLog{})
+	if req.EntityType != "" {
+		query = query.Where("entity_type = ?", req.EntityType)
+	}
+	if req.EntityId > 0 {
+		query = query.Where("entity_id = ?", req.EntityId)
+	}
+	if req.Actor != "" {
+		query = query.Where("actor = ?", req.Actor)
+	}
+
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page.Cursor != nil {
+		query = query.Where("id < ?", page.Cursor.ID)
+	}
+
+	err := query.Order("id DESC").Limit(page.Limit()).Find(&auditLogs).Error
+	return auditLogs, total, err
+}
@@ -0,0 +1,66 @@
+package brand_model
+
+import (
+	"customer-voucher-service/constants"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func setupMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	dialector := postgres.New(postgres.Config{
+		Conn: db,
+		DSN:  "sqlmock_db_0",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open gorm DB: %v", err)
+	}
+	return gormDB, mock, func() { db.Close() }
+}
+
+func TestDeleteBrand_CascadeVouchers(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewBrandRepo(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "brand" SET "is_deleted"=$1,"modified_by"=$2,"modified_date"=$3 WHERE id = $4 AND is_deleted = $5`)).
+		WithArgs(true, "admin", sqlmock.AnyArg(), 1, false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "voucher" SET "deleted_reason"=$1,"is_deleted"=$2,"modified_by"=$3,"modified_date"=$4 WHERE brand_id = $5 AND is_deleted = $6 RETURNING *`)).
+		WithArgs(constants.BrandDeletedReason, true, "admin", sqlmock.AnyArg(), 1, false).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "brand_id", "is_deleted"}).AddRow(3, 1, true).AddRow(4, 1, true))
+	mock.ExpectCommit()
+
+	vouchers, err := repo.DeleteBrand(1, "admin", true)
+	assert.NoError(t, err)
+	assert.Len(t, vouchers, 2)
+	assert.Equal(t, uint(4), vouchers[1].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteBrand_WithoutCascade(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewBrandRepo(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "brand" SET "is_deleted"=$1,"modified_by"=$2,"modified_date"=$3 WHERE id = $4 AND is_deleted = $5`)).
+		WithArgs(true, "admin", sqlmock.AnyArg(), 1, false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	vouchers, err := repo.DeleteBrand(1, "admin", false)
+	assert.NoError(t, err)
+	assert.Empty(t, vouchers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
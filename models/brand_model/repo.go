@@ -1,11 +1,26 @@
 package brand_model
 
-import "gorm.io/gorm"
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/models/voucher_model"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
 
 type IBrandRepo interface {
+	WithContext(ctx context.Context) IBrandRepo
 	CreateBrand(brand *Brand) error
-	ListBrand() ([]*Brand, error)
+	ListBrand(page *pagination.Page) ([]*Brand, int64, error)
 	FindBrandById(id uint) (*Brand, error)
+	FindDeletedBrandById(id uint) (*Brand, error)
+	UpdateBrand(brand *Brand) error
+	CountActiveVoucher(brandId uint) (int64, error)
+	DeleteBrand(id uint, modifiedBy string, cascadeVouchers bool) ([]*voucher_model.Voucher, error)
+	RestoreBrand(id uint, modifiedBy string) error
 }
 
 type BrandRepo struct {
@@ -18,14 +33,28 @@ func NewBrandRepo(db *gorm.DB) *BrandRepo {
 	}
 }
 
+func (r *BrandRepo) WithContext(ctx context.Context) IBrandRepo {
+	return &BrandRepo{db: transactor.DB(ctx, r.db)}
+}
+
 func (r *BrandRepo) CreateBrand(brand *Brand) error {
 	return r.db.Create(brand).Error
 }
 
-func (r *BrandRepo) ListBrand() ([]*Brand, error) {
+func (r *BrandRepo) ListBrand(page *pagination.Page) ([]*Brand, int64, error) {
 	var brand []*Brand
-	err := r.db.Find(&brand).Where("is_deleted = ?", false).Error
-	return brand, err
+	var total int64
+	query := r.db.Model(&Brand{}).Where("is_deleted = ?", false)
+
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page.Cursor != nil {
+		query = query.Where("id > ?", page.Cursor.ID)
+	}
+
+	err := query.Order("id").Limit(page.Limit()).Find(&brand).Error
+	return brand, total, err
 }
 
 func (r *BrandRepo) FindBrandById(id uint) (*Brand, error) {
@@ -36,3 +65,49 @@ func (r *BrandRepo) FindBrandById(id uint) (*Brand, error) {
 	}
 	return &brand, nil
 }
+
+func (r *BrandRepo) FindDeletedBrandById(id uint) (*Brand, error) {
+	var brand Brand
+	err := r.db.Where("id = ? AND is_deleted = ?", id, true).First(&brand).Error
+	if err != nil {
+		return nil, err
+	}
+	return &brand, nil
+}
+
+func (r *BrandRepo) UpdateBrand(brand *Brand) error {
+	return r.db.Model(&Brand{}).Where("id = ? AND is_deleted = ?", brand.ID, false).
+		Select("name", "description", "modified_by").
+		Updates(brand).Error
+}
+
+func (r *BrandRepo) CountActiveVoucher(brandId uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&voucher_model.Voucher{}).Where("brand_id = ? AND is_deleted = ?", brandId, false).Count(&count).Error
+	return count, err
+}
+
+// DeleteBrand soft-deletes the brand and, when cascadeVouchers is set, its
+// active vouchers in the same transaction. It returns the vouchers deleted along
+// with the brand, as they are after the delete.
+func (r *BrandRepo) DeleteBrand(id uint, modifiedBy string, cascadeVouchers bool) ([]*voucher_model.Voucher, error) {
+	deletedVouchers := []*voucher_model.Voucher{}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&Brand{}).Where("id = ? AND is_deleted = ?", id, false).
+			Updates(map[string]interface{}{"is_deleted": true, "modified_by": modifiedBy}).Error
+		if err != nil {
+			return err
+		}
+		if !cascadeVouchers {
+			return nil
+		}
+		return tx.Model(&deletedVouchers).Clauses(clause.Returning{}).Where("brand_id = ? AND is_deleted = ?", id, false).
+			Updates(map[string]interface{}{"is_deleted": true, "deleted_reason": constants.BrandDeletedReason, "modified_by": modifiedBy}).Error
+	})
+	return deletedVouchers, err
+}
+
+func (r *BrandRepo) RestoreBrand(id uint, modifiedBy string) error {
+	return r.db.Model(&Brand{}).Where("id = ? AND is_deleted = ?", id, true).
+		Updates(map[string]interface{}{"is_deleted": false, "modified_by": modifiedBy}).Error
+}
@@ -0,0 +1,19 @@
+package category_model
+
+import "time"
+
+type Category struct {
+	ID           uint      `gorm:"primaryKey;autoIncrement" json:"id"`
+	ParentID     *uint     `gorm:"index" json:"parent_id"`
+	Name         string    `gorm:"type:varchar(255);not null" json:"name"`
+	Description  string    `gorm:"type:text" json:"description"`
+	IsDeleted    bool      `gorm:"default:false;not null" json:"is_deleted"`
+	CreatedDate  time.Time `gorm:"autoCreateTime" json:"created_date"`
+	CreatedBy    string    `gorm:"type:varchar(255)" json:"created_by"`
+	ModifiedDate time.Time `gorm:"autoUpdateTime" json:"modified_date"`
+	ModifiedBy   string    `gorm:"type:varchar(255)" json:"modified_by"`
+}
+
+func (Category) TableName() string {
+	return "category"
+}
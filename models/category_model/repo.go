@@ -0,0 +1,116 @@
+package category_model
+
+import (
+	"context"
+	pb "customer-voucher-service/protogen/category"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
+
+	"gorm.io/gorm"
+)
+
+// DescendantIdQuery selects the id of a category and of every active category below it.
+const DescendantIdQuery = `WITH RECURSIVE category_tree AS (
+	SELECT id FROM category WHERE id = ? AND is_deleted = false
+	UNION ALL
+	SELECT c.id FROM category c JOIN category_tree t ON c.parent_id = t.id WHERE c.is_deleted = false
+) SELECT id FROM category_tree`
+
+type ICategoryRepo interface {
+	WithContext(ctx context.Context) ICategoryRepo
+	CreateCategory(category *Category) error
+	ListCategory(req *pb.ListCategoryReq, page *pagination.Page) ([]*Category, int64, error)
+	FindCategoryById(id uint) (*Category, error)
+	UpdateCategory(category *Category) error
+	DeleteCategory(id uint) ([]uint, error)
+	ListDescendantCategoryId(id uint) ([]uint, error)
+	CountChildCategory(id uint) (int64, error)
+	CountActiveVoucher(id uint) (int64, error)
+}
+
+type CategoryRepo struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepo(db *gorm.DB) *CategoryRepo {
+	return &CategoryRepo{
+		db: db,
+	}
+}
+
+func (r *CategoryRepo) WithContext(ctx context.Context) ICategoryRepo {
+	return &CategoryRepo{db: transactor.DB(ctx, r.db)}
+}
+
+func (r *CategoryRepo) CreateCategory(category *Category) error {
+	return r.db.Create(category).Error
+}
+
+func (r *CategoryRepo) ListCategory(req *pb.ListCategoryReq, page *pagination.Page) ([]*Category, int64, error) {
+	var categories []*Category
+	var total int64
+	query := r.db.Model(&Category{}).Where("is_deleted = ?", false)
+
+	if req.ParentId != nil {
+		query = query.Where("parent_id = ?", *req.ParentId)
+	}
+
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page.Cursor != nil {
+		query = query.Where("id > ?", page.Cursor.ID)
+	}
+
+	err := query.Order("id").Limit(page.Limit()).Find(&categories).Error
+	return categories, total, err
+}
+
+func (r *CategoryRepo) FindCategoryById(id uint) (*Category, error) {
+	var category Category
+	err := r.db.Where("id = ? AND is_deleted = ?", id, false).First(&category).Error
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (r *CategoryRepo) UpdateCategory(category *Category) error {
+	return r.db.Model(&Category{}).Where("id = ? AND is_deleted = ?", category.ID, false).
+		Select("parent_id", "name", "description").
+		Updates(category).Error
+}
+
+// DeleteCategory soft-deletes the category and takes it off the vouchers still pointing
+// at it in the same transaction, so no voucher is left in a deleted category. It returns
+// the IDs of those vouchers.
+func (r *CategoryRepo) DeleteCategory(id uint) ([]uint, error) {
+	voucherIds := []uint{}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&Category{}).Where("id = ? AND is_deleted = ?", id, false).Update("is_deleted", true).Error
+		if err != nil {
+			return err
+		}
+		return tx.Raw("UPDATE voucher SET category_id = NULL WHERE category_id = ? RETURNING id", id).Scan(&voucherIds).Error
+	})
+	return voucherIds, err
+}
+
+func (r *CategoryRepo) ListDescendantCategoryId(id uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.Raw(DescendantIdQuery, id).Scan(&ids).Error
+	return ids, err
+}
+
+func (r *CategoryRepo) CountChildCategory(id uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&Category{}).Where("parent_id = ? AND is_deleted = ?", id, false).Count(&count).Error
+	return count, err
+}
+
+// CountActiveVoucher reads the voucher table directly, voucher_model depends on this package.
+func (r *CategoryRepo) CountActiveVoucher(id uint) (int64, error) {
+	var count int64
+	err := r.db.Table("voucher").Where("category_id = ? AND is_deleted = ?", id, false).Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,97 @@
+package customer_model
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func setupMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	dialector := postgres.New(postgres.Config{
+		Conn: db,
+		DSN:  "sqlmock_db_0",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open gorm DB: %v", err)
+	}
+	return gormDB, mock, func() { db.Close() }
+}
+
+func TestAddPointsCustomer(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewCustomerRepo(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "customer" SET "points"=points + $1,"modified_date"=$2 WHERE id = $3 RETURNING "points"`)).
+		WithArgs(300, sqlmock.AnyArg(), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"points"}).AddRow(500))
+	mock.ExpectCommit()
+
+	balance, err := repo.AddPointsCustomer(1, 300)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500), balance)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeductPointsCustomer_InsufficientPoints(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewCustomerRepo(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "customer" SET "points"=points + $1,"modified_date"=$2 WHERE id = $3 AND is_deleted = $4 AND points > $5 RETURNING "points"`)).
+		WithArgs(-300, sqlmock.AnyArg(), 1, false, 300).
+		WillReturnRows(sqlmock.NewRows([]string{"points"}))
+	mock.ExpectCommit()
+
+	_, err := repo.DeductPointsCustomer(1, 300)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateCustomer_RetriesTakenReferralCode(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewCustomerRepo(db)
+
+	insert := `INSERT INTO "customer" .* ON CONFLICT \("referral_code"\) DO NOTHING RETURNING`
+	mock.ExpectBegin()
+	mock.ExpectQuery(insert).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(insert).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	customer := &Customer{FullName: "John Doe", Email: "john@example.com", ReferralCode: "TAKEN234"}
+	assert.NoError(t, repo.CreateCustomer(customer))
+	assert.Equal(t, uint(1), customer.ID)
+	assert.NotEqual(t, "TAKEN234", customer.ReferralCode)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBackfillReferralCode(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "customer" WHERE referral_code IS NULL OR referral_code = $1`)).
+		WithArgs("").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "customer" SET "referral_code"=$1 WHERE id = $2 AND (referral_code IS NULL OR referral_code = $3)`)).
+		WithArgs(sqlmock.AnyArg(), 1, "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, BackfillReferralCode(db))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
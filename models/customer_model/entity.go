@@ -3,17 +3,44 @@ package customer_model
 import "time"
 
 type Customer struct {
-	ID           uint      `gorm:"primaryKey;autoIncrement" json:"id"`
-	FullName     string    `gorm:"type:varchar(255);not null" json:"full_name"`
-	Email        string    `gorm:"type:varchar(255);uniqueIndex;not null" json:"email"`
-	Points       int64     `gorm:"default:0;not null" json:"points"`
-	IsDeleted    bool      `gorm:"default:false;not null" json:"is_deleted"`
-	CreatedDate  time.Time `gorm:"autoCreateTime" json:"created_date"`
-	CreatedBy    string    `gorm:"type:varchar(255)" json:"created_by"`
-	ModifiedDate time.Time `gorm:"autoUpdateTime" json:"modified_date"`
-	ModifiedBy   string    `gorm:"type:varchar(255)" json:"modified_by"`
+	ID                uint       `gorm:"primaryKey;autoIncrement" json:"id"`
+	FullName          string     `gorm:"type:varchar(255);not null" json:"full_name"`
+	Email             string     `gorm:"type:varchar(255);uniqueIndex;not null" json:"email"`
+	Points            int64      `gorm:"default:0;not null" json:"points"`
+	ReferralCode      string     `gorm:"type:varchar(20);uniqueIndex" json:"referral_code"`
+	ReferredByID      *uint      `gorm:"index" json:"referred_by_id"`
+	ReferralRewarded  bool       `gorm:"default:false;not null" json:"referral_rewarded"`
+	IsDeleted         bool       `gorm:"default:false;not null" json:"is_deleted"`
+	DeactivatedReason string     `gorm:"type:varchar(255)" json:"deactivated_reason"`
+	PointsSetDate     *time.Time `json:"points_set_date"`
+	CreatedDate       time.Time  `gorm:"autoCreateTime" json:"created_date"`
+	CreatedBy         string     `gorm:"type:varchar(255)" json:"created_by"`
+	ModifiedDate      time.Time  `gorm:"autoUpdateTime" json:"modified_date"`
+	ModifiedBy        string     `gorm:"type:varchar(255)" json:"modified_by"`
 }
 
 func (Customer) TableName() string {
 	return "customer"
 }
+
+type CustomerEmailHistory struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement" json:"id"`
+	CustomerID  uint      `gorm:"not null;index" json:"customer_id"`
+	OldEmail    string    `gorm:"type:varchar(255);not null" json:"old_email"`
+	NewEmail    string    `gorm:"type:varchar(255);not null" json:"new_email"`
+	CreatedDate time.Time `gorm:"autoCreateTime" json:"created_date"`
+	CreatedBy   string    `gorm:"type:varchar(255)" json:"created_by"`
+}
+
+func (CustomerEmailHistory) TableName() string {
+	return "customer_email_history"
+}
+
+type ReferralReport struct {
+	CustomerID    uint   `json:"customer_id"`
+	FullName      string `json:"full_name"`
+	Email         string `json:"email"`
+	ReferralCode  string `json:"referral_code"`
+	ReferralCount int64  `json:"referral_count"`
+	RewardedCount int64  `json:"rewarded_count"`
+}
@@ -0,0 +1,58 @@
+package customer_model
+
+import (
+	"crypto/rand"
+	"customer-voucher-service/constants"
+	"errors"
+	"math/big"
+
+	"gorm.io/gorm"
+)
+
+const referralCodeCharset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// maxReferralCodeAttempts bounds the retries when a generated code is already taken.
+const maxReferralCodeAttempts = 5
+
+func GenerateReferralCode() (string, error) {
+	code := make([]byte, constants.ReferralCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(referralCodeCharset))))
+		if err != nil {
+			return "", err
+		}
+		code[i] = referralCodeCharset[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// BackfillReferralCode gives a referral code to the customers created before the
+// referral program, deactivated ones included. It runs at startup after migration.
+func BackfillReferralCode(db *gorm.DB) error {
+	var ids []uint
+	err := db.Model(&Customer{}).Where("referral_code IS NULL OR referral_code = ?", "").Pluck("id", &ids).Error
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err = assignReferralCode(db, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func assignReferralCode(db *gorm.DB, id uint) error {
+	for attempt := 1; ; attempt++ {
+		code, err := GenerateReferralCode()
+		if err != nil {
+			return err
+		}
+		err = db.Model(&Customer{}).Where("id = ? AND (referral_code IS NULL OR referral_code = ?)", id, "").
+			UpdateColumn("referral_code", code).Error
+		if errors.Is(err, gorm.ErrDuplicatedKey) && attempt < maxReferralCodeAttempts {
+			continue
+		}
+		return err
+	}
+}
@@ -1,12 +1,33 @@
 package customer_model
 
-import "gorm.io/gorm"
+import (
+	"context"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
 
 type ICustomerRepo interface {
+	WithContext(ctx context.Context) ICustomerRepo
 	CreateCustomer(customer *Customer) error
-	ListCustomer() ([]*Customer, error)
+	ListCustomer(page *pagination.Page) ([]*Customer, int64, error)
 	FindCustomerById(id uint) (*Customer, error)
-	UpdatePointsCustomer(id uint, newPoints int64) error
+	FindCustomerByEmail(email string) (*Customer, error)
+	UpdateCustomer(customer *Customer, history *CustomerEmailHistory) error
+	AddPointsCustomer(id uint, points int64) (int64, error)
+	DeductPointsCustomer(id uint, points int64) (int64, error)
+	SetPointsCustomer(id uint, points int64) error
+	FindDeactivatedCustomerById(id uint) (*Customer, error)
+	DeactivateCustomer(id uint, reason string, modifiedBy string) error
+	ReactivateCustomer(id uint, modifiedBy string) error
+	FindCustomerByReferralCode(code string) (*Customer, error)
+	CountReferralCustomer(referrerId uint) (int64, error)
+	MarkReferralRewarded(id uint) error
+	ReferralReport() ([]*ReferralReport, error)
 }
 
 type CustomerRepo struct {
@@ -19,14 +40,44 @@ func NewCustomerRepo(db *gorm.DB) *CustomerRepo {
 	}
 }
 
+func (r *CustomerRepo) WithContext(ctx context.Context) ICustomerRepo {
+	return &CustomerRepo{db: transactor.DB(ctx, r.db)}
+}
+
+// CreateCustomer draws a new referral code while the customer's is already taken, so that
+// gorm.ErrDuplicatedKey only ever reports a taken email.
 func (r *CustomerRepo) CreateCustomer(customer *Customer) error {
-	return r.db.Create(customer).Error
+	for attempt := 1; ; attempt++ {
+		result := r.db.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "referral_code"}}, DoNothing: true}).
+			Create(customer)
+		if result.Error != nil || result.RowsAffected > 0 {
+			return result.Error
+		}
+		if attempt == maxReferralCodeAttempts {
+			return errors.New("no free referral code")
+		}
+		code, err := GenerateReferralCode()
+		if err != nil {
+			return err
+		}
+		customer.ReferralCode = code
+	}
 }
 
-func (r *CustomerRepo) ListCustomer() ([]*Customer, error) {
+func (r *CustomerRepo) ListCustomer(page *pagination.Page) ([]*Customer, int64, error) {
 	var customers []*Customer
-	err := r.db.Find(&customers).Where("is_deleted = ?", false).Error
-	return customers, err
+	var total int64
+	query := r.db.Model(&Customer{}).Where("is_deleted = ?", false)
+
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page.Cursor != nil {
+		query = query.Where("id > ?", page.Cursor.ID)
+	}
+
+	err := query.Order("id").Limit(page.Limit()).Find(&customers).Error
+	return customers, total, err
 }
 
 func (r *CustomerRepo) FindCustomerById(id uint) (*Customer, error) {
@@ -38,6 +89,122 @@ func (r *CustomerRepo) FindCustomerById(id uint) (*Customer, error) {
 	return &customer, nil
 }
 
-func (r *CustomerRepo) UpdatePointsCustomer(id uint, newPoints int64) error {
-	return r.db.Model(&Customer{}).Where("id = ? AND is_deleted = ?", id, false).Update("points", newPoints).Error
+func (r *CustomerRepo) FindCustomerByEmail(email string) (*Customer, error) {
+	var customer Customer
+	err := r.db.Where("email = ? AND is_deleted = ?", email, false).First(&customer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &customer, nil
+}
+
+// UpdateCustomer saves the name and email, and records the email change in
+// the same transaction when history is set.
+func (r *CustomerRepo) UpdateCustomer(customer *Customer, history *CustomerEmailHistory) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&Customer{}).Where("id = ? AND is_deleted = ?", customer.ID, false).
+			Select("full_name", "email", "modified_by").
+			Updates(customer).Error
+		if err != nil {
+			return err
+		}
+		if history == nil {
+			return nil
+		}
+		return tx.Create(history).Error
+	})
+}
+
+// AddPointsCustomer credits the customer in place and returns the new balance. Deactivated
+// customers are credited too, so refunds are never lost.
+func (r *CustomerRepo) AddPointsCustomer(id uint, points int64) (int64, error) {
+	return r.changePoints(r.db.Where("id = ?", id), points)
+}
+
+// DeductPointsCustomer debits an active customer in place and returns the new balance. Like
+// IsAbleToRedeem, it leaves the balance above zero, and returns gorm.ErrRecordNotFound
+// when the balance is too low.
+func (r *CustomerRepo) DeductPointsCustomer(id uint, points int64) (int64, error) {
+	return r.changePoints(r.db.Where("id = ? AND is_deleted = ? AND points > ?", id, false, points), -points)
+}
+
+func (r *CustomerRepo) changePoints(query *gorm.DB, points int64) (int64, error) {
+	var customer Customer
+	result := query.Model(&customer).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "points"}}}).
+		Update("points", gorm.Expr("points + ?", points))
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+	return customer.Points, nil
+}
+
+// SetPointsCustomer is for manual points changes, which the fraud rules look back on.
+func (r *CustomerRepo) SetPointsCustomer(id uint, points int64) error {
+	return r.db.Model(&Customer{}).Where("id = ? AND is_deleted = ?", id, false).
+		Updates(map[string]interface{}{"points": points, "points_set_date": time.Now()}).Error
+}
+
+func (r *CustomerRepo) FindDeactivatedCustomerById(id uint) (*Customer, error) {
+	var customer Customer
+	err := r.db.Where("id = ? AND is_deleted = ?", id, true).First(&customer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &customer, nil
+}
+
+func (r *CustomerRepo) DeactivateCustomer(id uint, reason string, modifiedBy string) error {
+	return r.db.Model(&Customer{}).Where("id = ? AND is_deleted = ?", id, false).
+		Updates(map[string]interface{}{"is_deleted": true, "deactivated_reason": reason, "modified_by": modifiedBy}).Error
+}
+
+func (r *CustomerRepo) ReactivateCustomer(id uint, modifiedBy string) error {
+	return r.db.Model(&Customer{}).Where("id = ? AND is_deleted = ?", id, true).
+		Updates(map[string]interface{}{"is_deleted": false, "deactivated_reason": "", "modified_by": modifiedBy}).Error
+}
+
+func (r *CustomerRepo) FindCustomerByReferralCode(code string) (*Customer, error) {
+	var customer Customer
+	err := r.db.Where("referral_code = ? AND is_deleted = ?", code, false).First(&customer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &customer, nil
+}
+
+func (r *CustomerRepo) CountReferralCustomer(referrerId uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&Customer{}).Where("referred_by_id = ?", referrerId).Count(&count).Error
+	return count, err
+}
+
+// MarkReferralRewarded only marks a customer not rewarded yet. It returns
+// gorm.ErrRecordNotFound when the referral was already rewarded.
+func (r *CustomerRepo) MarkReferralRewarded(id uint) error {
+	result := r.db.Model(&Customer{}).Where("id = ? AND referral_rewarded = ?", id, false).Update("referral_rewarded", true)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *CustomerRepo) ReferralReport() ([]*ReferralReport, error) {
+	var report []*ReferralReport
+	err := r.db.Table("customer AS referrer").
+		Select("referrer.id AS customer_id, referrer.full_name, referrer.email, referrer.referral_code, "+
+			"COUNT(referee.id) AS referral_count, "+
+			"COUNT(CASE WHEN referee.referral_rewarded THEN 1 END) AS rewarded_count").
+		Joins("JOIN customer AS referee ON referee.referred_by_id = referrer.id").
+		Where("referrer.is_deleted = ?", false).
+		Group("referrer.id, referrer.full_name, referrer.email, referrer.referral_code").
+		Order("referral_count DESC").
+		Scan(&report).Error
+	return report, err
 }
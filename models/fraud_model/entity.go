@@ -0,0 +1,30 @@
+package fraud_model
+
+import "time"
+
+// FraudFlag is one fraud rule that matched a redemption.
+type FraudFlag struct {
+	Rule     string `json:"rule"`
+	Decision string `json:"decision"`
+	Reason   string `json:"reason"`
+}
+
+// FraudReview queues a held redemption until a reviewer approves or rejects it.
+type FraudReview struct {
+	ID            uint        `gorm:"primaryKey;autoIncrement" json:"id"`
+	TransactionID uint        `gorm:"not null;uniqueIndex" json:"transaction_id"`
+	CustomerID    uint        `gorm:"not null;index" json:"customer_id"`
+	Flags         []FraudFlag `gorm:"serializer:json;type:text" json:"flags"`
+	Status        string      `gorm:"type:varchar(20);not null;index" json:"status"`
+	Note          string      `gorm:"type:text" json:"note"`
+	ReviewedBy    string      `gorm:"type:varchar(255)" json:"reviewed_by"`
+	ReviewedDate  *time.Time  `json:"reviewed_date"`
+	CreatedDate   time.Time   `gorm:"autoCreateTime" json:"created_date"`
+	CreatedBy     string      `gorm:"type:varchar(255)" json:"created_by"`
+	ModifiedDate  time.Time   `gorm:"autoUpdateTime" json:"modified_date"`
+	ModifiedBy    string      `gorm:"type:varchar(255)" json:"modified_by"`
+}
+
+func (FraudReview) TableName() string {
+	return "fraud_review"
+}
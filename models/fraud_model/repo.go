@@ -0,0 +1,86 @@
+package fraud_model
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type IFraudRepo interface {
+	WithContext(ctx context.Context) IFraudRepo
+	CreateFraudReview(review *FraudReview) error
+	ListFraudReview(status *string, page *pagination.Page) ([]*FraudReview, int64, error)
+	FindFraudReviewByTransactionId(transactionId uint) (*FraudReview, error)
+	CloseFraudReview(id uint, status string, note string, reviewedBy string) error
+}
+
+type FraudRepo struct {
+	db *gorm.DB
+}
+
+func NewFraudRepo(db *gorm.DB) *FraudRepo {
+	return &FraudRepo{
+		db: db,
+	}
+}
+
+func (r *FraudRepo) WithContext(ctx context.Context) IFraudRepo {
+	return &FraudRepo{db: transactor.DB(ctx, r.db)}
+}
+
+func (r *FraudRepo) CreateFraudReview(review *FraudReview) error {
+	return r.db.Create(review).Error
+}
+
+// ListFraudReview returns the oldest reviews first, so the queue is worked in order.
+func (r *FraudRepo) ListFraudReview(status *string, page *pagination.Page) ([]*FraudReview, int64, error) {
+	var reviews []*FraudReview
+	var total int64
+	query := r.db.Model(&FraudReview{})
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page.Cursor != nil {
+		query = query.Where("id > ?", page.Cursor.ID)
+	}
+
+	err := query.Order("id ASC").Limit(page.Limit()).Find(&reviews).Error
+	return reviews, total, err
+}
+
+func (r *FraudRepo) FindFraudReviewByTransactionId(transactionId uint) (*FraudReview, error) {
+	var review FraudReview
+	err := r.db.Where("transaction_id = ?", transactionId).First(&review).Error
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// CloseFraudReview only closes a pending review. It returns gorm.ErrRecordNotFound
+// when another reviewer closed it first.
+func (r *FraudRepo) CloseFraudReview(id uint, status string, note string, reviewedBy string) error {
+	result := r.db.Model(&FraudReview{}).
+		Where("id = ? AND status = ?", id, constants.FraudReviewStatusPending).
+		Updates(map[string]interface{}{
+			"status":        status,
+			"note":          note,
+			"reviewed_by":   reviewedBy,
+			"reviewed_date": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package search_model
+
+type SearchResult struct {
+	Type        string  `json:"type"`
+	ID          uint    `json:"id"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	Rank        float64 `json:"rank"`
+	Highlight   string  `json:"highlight"`
+}
@@ -0,0 +1,233 @@
+package search_model
+
+import (
+	"customer-voucher-service/constants"
+	"database/sql"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm"
+)
+
+// The document expressions must stay identical to the ones used by the GIN indexes,
+// otherwise PostgreSQL will not use the index.
+const (
+	voucherDocument = `setweight(to_tsvector('simple', coalesce(name, '')), 'A') || ` +
+		`setweight(to_tsvector('simple', coalesce(voucher_code, '')), 'A') || ` +
+		`setweight(to_tsvector('simple', coalesce(description, '')), 'B')`
+	brandDocument = `setweight(to_tsvector('simple', coalesce(name, '')), 'A') || ` +
+		`setweight(to_tsvector('simple', coalesce(description, '')), 'B')`
+	// ts_headline marks matches with control characters, so the text can be HTML-escaped
+	// before the markers are turned into <mark> tags.
+	headlineStart   = "\x02"
+	headlineStop    = "\x03"
+	headlineOptions = "StartSel=" + headlineStart + ", StopSel=" + headlineStop + ", MaxFragments=2"
+)
+
+type ISearchRepo interface {
+	Search(query string, resultType string, limit int) ([]*SearchResult, error)
+}
+
+type SearchRepo struct {
+	db *gorm.DB
+}
+
+func NewSearchRepo(db *gorm.DB) *SearchRepo {
+	return &SearchRepo{
+		db: db,
+	}
+}
+
+func CreateSearchIndex(db *gorm.DB) error {
+	if db.Dialector.Name() != "postgres" {
+		return nil
+	}
+	statements := []string{
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_voucher_search ON voucher USING GIN ((%s))", voucherDocument),
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_brand_search ON brand USING GIN ((%s))", brandDocument),
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *SearchRepo) Search(query string, resultType string, limit int) ([]*SearchResult, error) {
+	if r.db.Dialector.Name() == "postgres" {
+		return r.searchFullText(query, resultType, limit)
+	}
+	return r.searchLike(query, resultType, limit)
+}
+
+func (r *SearchRepo) searchFullText(query string, resultType string, limit int) ([]*SearchResult, error) {
+	tsQuery := BuildTsQuery(query)
+	if tsQuery == "" {
+		return []*SearchResult{}, nil
+	}
+
+	selects := []string{}
+	if resultType == "" || resultType == constants.SearchTypeVoucher {
+		selects = append(selects, fmt.Sprintf(
+			`SELECT '%s' AS type, id, name AS title, description, ts_rank(%s, q) AS rank, `+
+				`ts_headline('simple', coalesce(name, '') || ' ' || coalesce(description, ''), q, @headline) AS highlight `+
+				`FROM voucher, to_tsquery('simple', @query) q WHERE is_deleted = false AND (%s) @@ q`,
+			constants.SearchTypeVoucher, voucherDocument, voucherDocument))
+	}
+	if resultType == "" || resultType == constants.SearchTypeBrand {
+		selects = append(selects, fmt.Sprintf(
+			`SELECT '%s' AS type, id, name AS title, description, ts_rank(%s, q) AS rank, `+
+				`ts_headline('simple', coalesce(name, '') || ' ' || coalesce(description, ''), q, @headline) AS highlight `+
+				`FROM brand, to_tsquery('simple', @query) q WHERE is_deleted = false AND (%s) @@ q`,
+			constants.SearchTypeBrand, brandDocument, brandDocument))
+	}
+
+	var results []*SearchResult
+	statement := strings.Join(selects, " UNION ALL ") + " ORDER BY rank DESC, id LIMIT @limit"
+	err := r.db.Raw(statement, sql.Named("query", tsQuery), sql.Named("headline", headlineOptions), sql.Named("limit", limit)).
+		Scan(&results).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		result.Highlight = markHeadline(result.Highlight)
+	}
+	return results, nil
+}
+
+// markHeadline escapes a ts_headline result and turns its match markers into <mark> tags.
+func markHeadline(headline string) string {
+	return strings.NewReplacer(headlineStart, "<mark>", headlineStop, "</mark>").Replace(html.EscapeString(headline))
+}
+
+type searchRow struct {
+	ID          uint
+	Name        string
+	Description string
+	Rank        float64
+}
+
+// searchLike is the fallback for dialects without full-text search. Each table is ranked in SQL,
+// so merging the per-table top results gives the overall top results.
+func (r *SearchRepo) searchLike(query string, resultType string, limit int) ([]*SearchResult, error) {
+	terms := SearchTerms(query)
+	if len(terms) == 0 {
+		return []*SearchResult{}, nil
+	}
+
+	results := []*SearchResult{}
+	if resultType == "" || resultType == constants.SearchTypeVoucher {
+		rows, err := r.findLike("voucher", []string{"name", "voucher_code"}, terms, limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			results = append(results, toSearchResult(constants.SearchTypeVoucher, row, terms))
+		}
+	}
+	if resultType == "" || resultType == constants.SearchTypeBrand {
+		rows, err := r.findLike("brand", []string{"name"}, terms, limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			results = append(results, toSearchResult(constants.SearchTypeBrand, row, terms))
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank > results[j].Rank
+		}
+		return results[i].ID < results[j].ID
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// findLike returns the best matching rows of a table. Every term must appear in a title column or
+// the description; a term found in a title column scores 1 and a term found in the description 0.4.
+func (r *SearchRepo) findLike(table string, titleColumns []string, terms []string, limit int) ([]*searchRow, error) {
+	ranks := []string{}
+	rankArgs := []interface{}{}
+	query := r.db.Table(table).Where("is_deleted = ?", false)
+	for _, term := range terms {
+		pattern := "%" + term + "%"
+		titleConditions := []string{}
+		titleArgs := []interface{}{}
+		for _, column := range titleColumns {
+			titleConditions = append(titleConditions, fmt.Sprintf("LOWER(%s) LIKE ?", column))
+			titleArgs = append(titleArgs, pattern)
+		}
+		title := strings.Join(titleConditions, " OR ")
+		query = query.Where(title+" OR LOWER(description) LIKE ?", append(titleArgs, pattern)...)
+
+		ranks = append(ranks,
+			fmt.Sprintf("CASE WHEN %s THEN 1 ELSE 0 END", title),
+			"CASE WHEN LOWER(description) LIKE ? THEN 0.4 ELSE 0 END")
+		rankArgs = append(append(rankArgs, titleArgs...), pattern)
+	}
+
+	var rows []*searchRow
+	err := query.Select("id, name, description, ("+strings.Join(ranks, " + ")+") AS rank", rankArgs...).
+		Order("rank DESC, id").
+		Limit(limit).
+		Find(&rows).Error
+	return rows, err
+}
+
+func toSearchResult(resultType string, row *searchRow, terms []string) *SearchResult {
+	return &SearchResult{
+		Type:        resultType,
+		ID:          row.ID,
+		Title:       row.Name,
+		Description: row.Description,
+		Rank:        row.Rank,
+		Highlight:   Highlight(strings.TrimSpace(row.Name+" "+row.Description), terms),
+	}
+}
+
+// SearchTerms splits free text into lowercase words, dropping punctuation.
+func SearchTerms(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// BuildTsQuery turns free text into a prefix-matching tsquery, e.g. "kopi susu" -> "kopi:* & susu:*".
+func BuildTsQuery(query string) string {
+	terms := SearchTerms(query)
+	for i, term := range terms {
+		terms[i] = term + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
+
+// Highlight HTML-escapes text and wraps every occurrence of the terms in <mark> tags.
+func Highlight(text string, terms []string) string {
+	if len(terms) == 0 {
+		return html.EscapeString(text)
+	}
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = regexp.QuoteMeta(term)
+	}
+	re := regexp.MustCompile("(?i)(" + strings.Join(quoted, "|") + ")")
+
+	var highlighted strings.Builder
+	last := 0
+	for _, match := range re.FindAllStringIndex(text, -1) {
+		highlighted.WriteString(html.EscapeString(text[last:match[0]]))
+		highlighted.WriteString("<mark>" + html.EscapeString(text[match[0]:match[1]]) + "</mark>")
+		last = match[1]
+	}
+	highlighted.WriteString(html.EscapeString(text[last:]))
+	return highlighted.String()
+}
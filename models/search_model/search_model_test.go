@@ -0,0 +1,62 @@
+package search_model
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestBuildTsQuery(t *testing.T) {
+	assert.Equal(t, "kopi:* & susu:*", BuildTsQuery("Kopi, susu!"))
+	assert.Equal(t, "", BuildTsQuery("  &|! "))
+}
+
+func TestHighlight(t *testing.T) {
+	terms := SearchTerms("kopi")
+	assert.Equal(t, "<mark>Kopi</mark> Susu <mark>kopi</mark>", Highlight("Kopi Susu kopi", terms))
+	assert.Equal(t, "Kopi Susu", Highlight("Kopi Susu", nil))
+}
+
+func TestHighlight_EscapesHTML(t *testing.T) {
+	terms := SearchTerms("kopi amp")
+	assert.Equal(t, "&lt;b&gt;<mark>Kopi</mark>&lt;/b&gt; &amp; <mark>Amp</mark>", Highlight("<b>Kopi</b> & Amp", terms))
+	assert.Equal(t, "&lt;script&gt;", Highlight("<script>", nil))
+}
+
+func TestMarkHeadline(t *testing.T) {
+	headline := "<img src=x> " + headlineStart + "Kopi" + headlineStop + " & susu"
+	assert.Equal(t, "&lt;img src=x&gt; <mark>Kopi</mark> &amp; susu", markHeadline(headline))
+}
+
+func TestFindLike_RanksInSQL(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: db, DSN: "sqlmock_db_0"}), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open gorm DB: %v", err)
+	}
+	repo := NewSearchRepo(gormDB)
+
+	mock.ExpectQuery(`SELECT id, name, description, \(CASE WHEN LOWER\(name\) LIKE \$1 THEN 1 ELSE 0 END \+ `+
+		`CASE WHEN LOWER\(description\) LIKE \$2 THEN 0.4 ELSE 0 END\) AS rank FROM "brand" `+
+		`WHERE is_deleted = \$3 AND \(LOWER\(name\) LIKE \$4 OR LOWER\(description\) LIKE \$5\) `+
+		`ORDER BY rank DESC, id LIMIT \$6`).
+		WithArgs("%kopi%", "%kopi%", false, "%kopi%", "%kopi%", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "rank"}).
+			AddRow(2, "Kopi Kenangan", "", 1).
+			AddRow(1, "Janji Jiwa", "Kopi susu", 0.4))
+
+	rows, err := repo.findLike("brand", []string{"name"}, []string{"kopi"}, 5)
+
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, uint(2), rows[0].ID)
+	assert.Equal(t, 0.4, rows[1].Rank)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
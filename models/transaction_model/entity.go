@@ -3,21 +3,38 @@ package transaction_model
 import "time"
 
 type Transaction struct {
-	ID                 uint      `gorm:"primaryKey;autoIncrement" json:"id"`
-	CustomerID         uint      `gorm:"not null" json:"customer_id"`
-	VoucherID          uint      `gorm:"not null" json:"voucher_id"`
-	Quantity           int64     `gorm:"not null" json:"quantity"`
-	VoucherCostInPoint int64     `gorm:"not null" json:"voucher_cost_in_point"`
-	Total              int64     `gorm:"not null" json:"total"`
-	Status             int32     `gorm:"not null" json:"status"`
-	RedeemDate         time.Time `gorm:"not null" json:"redeem_date"`
-	IsDeleted          bool      `gorm:"default:false;not null" json:"is_deleted"`
-	CreatedDate        time.Time `gorm:"autoCreateTime" json:"created_date"`
-	CreatedBy          string    `gorm:"type:varchar(255)" json:"created_by"`
-	ModifiedDate       time.Time `gorm:"autoUpdateTime" json:"modified_date"`
-	ModifiedBy         string    `gorm:"type:varchar(255)" json:"modified_by"`
+	ID                 uint       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CustomerID         uint       `gorm:"not null" json:"customer_id"`
+	VoucherID          uint       `gorm:"not null" json:"voucher_id"`
+	Quantity           int64      `gorm:"not null" json:"quantity"`
+	VoucherCostInPoint int64      `gorm:"not null" json:"voucher_cost_in_point"`
+	Total              int64      `gorm:"not null" json:"total"`
+	Status             int32      `gorm:"not null" json:"status"`
+	RedeemDate         time.Time  `gorm:"not null" json:"redeem_date"`
+	RecipientID        *uint      `gorm:"index" json:"recipient_id"`
+	GiftMessage        string     `gorm:"type:text" json:"gift_message"`
+	GiftExpiredDate    *time.Time `json:"gift_expired_date"`
+	DeviceID           string     `gorm:"type:varchar(255);index" json:"device_id"`
+	IsDeleted          bool       `gorm:"default:false;not null" json:"is_deleted"`
+	CreatedDate        time.Time  `gorm:"autoCreateTime" json:"created_date"`
+	CreatedBy          string     `gorm:"type:varchar(255)" json:"created_by"`
+	ModifiedDate       time.Time  `gorm:"autoUpdateTime" json:"modified_date"`
+	ModifiedBy         string     `gorm:"type:varchar(255)" json:"modified_by"`
+
+	// Read-only, filled only when the query embeds related details.
+	VoucherName  string `gorm:"->;-:migration" json:"voucher_name,omitempty"`
+	VoucherCode  string `gorm:"->;-:migration" json:"voucher_code,omitempty"`
+	BrandName    string `gorm:"->;-:migration" json:"brand_name,omitempty"`
+	CustomerName string `gorm:"->;-:migration" json:"customer_name,omitempty"`
 }
 
 func (Transaction) TableName() string {
 	return "transaction"
 }
+
+// CustomerTransactionSummary leaves out expired gifts, their points went back to the customer.
+type CustomerTransactionSummary struct {
+	PointsSpent        int64      `json:"points_spent"`
+	RedemptionCount    int64      `json:"redemption_count"`
+	LastRedemptionDate *time.Time `json:"last_redemption_date"`
+}
@@ -0,0 +1,38 @@
+package transaction_model
+
+import (
+	"customer-voucher-service/constants"
+	pbTransaction "customer-voucher-service/protogen/transaction"
+)
+
+func ToPbTransaction(trans *Transaction) *pbTransaction.Transaction {
+	status := int32(trans.Status)
+	isDeleted := trans.IsDeleted
+	data := &pbTransaction.Transaction{
+		Id:                 int32(trans.ID),
+		CustomerId:         int32(trans.CustomerID),
+		VoucherId:          int32(trans.VoucherID),
+		Quantity:           trans.Quantity,
+		Total:              int64(trans.Total),
+		Status:             &status,
+		RedeemDate:         trans.RedeemDate.Format(constants.FormatDate),
+		CreatedDate:        trans.CreatedDate.Format(constants.FormatDate),
+		ModifiedDate:       trans.ModifiedDate.Format(constants.FormatDate),
+		IsDeleted:          &isDeleted,
+		VoucherCostInPoint: trans.VoucherCostInPoint,
+		GiftMessage:        trans.GiftMessage,
+		VoucherName:        trans.VoucherName,
+		VoucherCode:        trans.VoucherCode,
+		BrandName:          trans.BrandName,
+		CustomerName:       trans.CustomerName,
+		DeviceId:           trans.DeviceID,
+	}
+	if trans.RecipientID != nil {
+		recipientId := int32(*trans.RecipientID)
+		data.RecipientId = &recipientId
+	}
+	if trans.GiftExpiredDate != nil {
+		data.GiftExpiredDate = trans.GiftExpiredDate.Format(constants.FormatDate)
+	}
+	return data
+}
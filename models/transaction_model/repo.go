@@ -1,16 +1,30 @@
 package transaction_model
 
 import (
+	"context"
+	"customer-voucher-service/constants"
 	pb "customer-voucher-service/protogen/transaction"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
+	"strconv"
+	"time"
 
 	"gorm.io/gorm"
 )
 
 type ITransactionRepo interface {
+	WithContext(ctx context.Context) ITransactionRepo
 	CreateTransaction(transaction *Transaction) (*Transaction, error)
 	FindTransactionById(id uint) (*Transaction, error)
-	ListTransaction(req *pb.ListTransactionReq) ([]*Transaction, error)
+	ListTransaction(req *pb.ListTransactionReq, page *pagination.Page) ([]*Transaction, int64, error)
 	DetailTransaction(req *pb.DetailTransactionReq) (*Transaction, error)
+	UpdateTransactionStatusFrom(id uint, from int32, to int32) error
+	ListExpiredGiftTransaction(now time.Time) ([]*Transaction, error)
+	CountPendingTransactionByVoucher(voucherId uint) (int64, error)
+	SummarizeTransactionByCustomer(customerId uint) (*CustomerTransactionSummary, error)
+	ListRecentTransactionByCustomer(customerId uint, limit int) ([]*Transaction, error)
+	CountHighCostRedemptionByCustomer(customerId uint, minCostInPoint int64, since time.Time) (int64, error)
+	CountOtherCustomerByDevice(deviceId string, voucherId uint, customerId uint, since time.Time) (int64, error)
 }
 
 type TransactionRepo struct {
@@ -23,6 +37,10 @@ func NewTransactionRepo(db *gorm.DB) *TransactionRepo {
 	}
 }
 
+func (r *TransactionRepo) WithContext(ctx context.Context) ITransactionRepo {
+	return &TransactionRepo{db: transactor.DB(ctx, r.db)}
+}
+
 func (r *TransactionRepo) CreateTransaction(transaction *Transaction) (*Transaction, error) {
 	err := r.db.Create(transaction).Error
 	if err != nil {
@@ -40,18 +58,206 @@ func (r *TransactionRepo) FindTransactionById(id uint) (*Transaction, error) {
 	return &transaction, nil
 }
 
-func (r *TransactionRepo) ListTransaction(req *pb.ListTransactionReq) ([]*Transaction, error) {
+func (r *TransactionRepo) ListTransaction(req *pb.ListTransactionReq, page *pagination.Page) ([]*Transaction, int64, error) {
 	var transactions []*Transaction
-	query := r.db.Model(&Transaction{}).Where("is_deleted = ?", false)
+	var total int64
+	query := r.db.Model(&Transaction{}).Where(`"transaction".is_deleted = ?`, false)
 
 	if req.CustomerId != nil {
-		query = query.Where("customer_id = ?", *req.CustomerId)
+		query = query.Where(`"transaction".customer_id = ?`, *req.CustomerId)
+	}
+	if req.VoucherId != nil {
+		query = query.Where(`"transaction".voucher_id = ?`, *req.VoucherId)
+	}
+	if req.BrandId != nil {
+		query = query.Joins(`JOIN voucher ON voucher.id = "transaction".voucher_id`).Where("voucher.brand_id = ?", *req.BrandId)
+	}
+	if req.Status != nil {
+		query = query.Where(`"transaction".status = ?`, *req.Status)
+	}
+	if req.StartDate != nil {
+		startDate, err := time.ParseInLocation(constants.FormatDate, *req.StartDate, time.Local)
+		if err != nil {
+			return nil, 0, err
+		}
+		query = query.Where(`"transaction".redeem_date >= ?`, startDate)
+	}
+	if req.EndDate != nil {
+		endDate, err := time.ParseInLocation(constants.FormatDate, *req.EndDate, time.Local)
+		if err != nil {
+			return nil, 0, err
+		}
+		// FormatDate has second precision, so the end date covers its whole second.
+		query = query.Where(`"transaction".redeem_date < ?`, endDate.Add(time.Second))
+	}
+	if req.MinTotal != nil {
+		query = query.Where(`"transaction".total >= ?`, *req.MinTotal)
+	}
+	if req.MaxTotal != nil {
+		query = query.Where(`"transaction".total <= ?`, *req.MaxTotal)
 	}
 
-	err := query.Find(&transactions).Error
-	return transactions, err
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	column := sortColumn(req.SortBy)
+	direction := "ASC"
+	operator := ">"
+	if req.SortOrder == constants.SortOrderDesc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if page.Cursor != nil {
+		if column == `"transaction".id` {
+			query = query.Where(`"transaction".id `+operator+" ?", page.Cursor.ID)
+		} else {
+			value, err := ParseSortValue(req.SortBy, page.Cursor.Value)
+			if err != nil {
+				return nil, 0, err
+			}
+			query = query.Where("("+column+`, "transaction".id) `+operator+" (?, ?)", value, page.Cursor.ID)
+		}
+	}
+
+	order := column + " " + direction
+	if column != `"transaction".id` {
+		order += `, "transaction".id ` + direction
+	}
+	if req.Embed {
+		query = embedDetails(query)
+	} else {
+		query = query.Select(`"transaction".*`)
+	}
+	err := query.Order(order).Limit(page.Limit()).Find(&transactions).Error
+	return transactions, total, err
+}
+
+// embedDetails loads the voucher, brand and customer names in the same query. The
+// joins are aliased so they do not clash with the brand filter join, and they skip
+// the is_deleted check so history still shows deleted vouchers and brands.
+func embedDetails(query *gorm.DB) *gorm.DB {
+	return query.
+		Joins(`LEFT JOIN voucher ev ON ev.id = "transaction".voucher_id`).
+		Joins(`LEFT JOIN brand eb ON eb.id = ev.brand_id`).
+		Joins(`LEFT JOIN customer ec ON ec.id = "transaction".customer_id`).
+		Select(`"transaction".*, ev.name AS voucher_name, ev.voucher_code AS voucher_code, eb.name AS brand_name, ec.full_name AS customer_name`)
+}
+
+func sortColumn(sortBy string) string {
+	switch sortBy {
+	case constants.TransactionSortByRedeemDate:
+		return `"transaction".redeem_date`
+	case constants.TransactionSortByTotal:
+		return `"transaction".total`
+	}
+	return `"transaction".id`
+}
+
+// SortValue is the cursor value of a transaction for the given sort, the
+// counterpart of ParseSortValue.
+func SortValue(transaction *Transaction, sortBy string) string {
+	switch sortBy {
+	case constants.TransactionSortByRedeemDate:
+		return transaction.RedeemDate.Format(time.RFC3339Nano)
+	case constants.TransactionSortByTotal:
+		return strconv.FormatInt(transaction.Total, 10)
+	}
+	return ""
+}
+
+func ParseSortValue(sortBy string, value string) (interface{}, error) {
+	switch sortBy {
+	case constants.TransactionSortByRedeemDate:
+		return time.Parse(time.RFC3339Nano, value)
+	case constants.TransactionSortByTotal:
+		return strconv.ParseInt(value, 10, 64)
+	}
+	return nil, nil
 }
 
 func (r *TransactionRepo) DetailTransaction(req *pb.DetailTransactionReq) (*Transaction, error) {
-	return r.FindTransactionById(uint(req.Id))
+	if !req.Embed {
+		return r.FindTransactionById(uint(req.Id))
+	}
+	var transaction Transaction
+	err := embedDetails(r.db.Model(&Transaction{})).
+		Where(`"transaction".id = ? AND "transaction".is_deleted = ?`, req.Id, false).
+		Take(&transaction).Error
+	if err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+// UpdateTransactionStatusFrom only moves a transaction that is still in status from. It
+// returns gorm.ErrRecordNotFound when another request moved it first.
+func (r *TransactionRepo) UpdateTransactionStatusFrom(id uint, from int32, to int32) error {
+	result := r.db.Model(&Transaction{}).Where("id = ? AND status = ? AND is_deleted = ?", id, from, false).Update("status", to)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *TransactionRepo) ListExpiredGiftTransaction(now time.Time) ([]*Transaction, error) {
+	var transactions []*Transaction
+	err := r.db.Where("status = ? AND gift_expired_date <= ? AND is_deleted = ?", constants.TransactionStatusGiftPending, now, false).
+		Find(&transactions).Error
+	return transactions, err
+}
+
+func (r *TransactionRepo) CountPendingTransactionByVoucher(voucherId uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&Transaction{}).
+		Where("voucher_id = ? AND status = ? AND is_deleted = ?", voucherId, constants.TransactionStatusGiftPending, false).
+		Count(&count).Error
+	return count, err
+}
+
+// SummarizeTransactionByCustomer leaves out transactions whose points are returned, expired
+// gifts and rejected redemptions, and held redemptions until they are approved.
+func (r *TransactionRepo) SummarizeTransactionByCustomer(customerId uint) (*CustomerTransactionSummary, error) {
+	var summary CustomerTransactionSummary
+	excluded := []int32{constants.TransactionStatusGiftExpired, constants.TransactionStatusHeld, constants.TransactionStatusRejected}
+	err := r.db.Model(&Transaction{}).
+		Select("COALESCE(SUM(total), 0) AS points_spent, COUNT(*) AS redemption_count, MAX(redeem_date) AS last_redemption_date").
+		Where("customer_id = ? AND status NOT IN ? AND is_deleted = ?", customerId, excluded, false).
+		Scan(&summary).Error
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+func (r *TransactionRepo) ListRecentTransactionByCustomer(customerId uint, limit int) ([]*Transaction, error) {
+	var transactions []*Transaction
+	err := r.db.Where("customer_id = ? AND is_deleted = ?", customerId, false).
+		Order("redeem_date DESC, id DESC").Limit(limit).Find(&transactions).Error
+	return transactions, err
+}
+
+// CountHighCostRedemptionByCustomer counts redemptions, held ones included, of vouchers
+// costing at least minCostInPoint.
+func (r *TransactionRepo) CountHighCostRedemptionByCustomer(customerId uint, minCostInPoint int64, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&Transaction{}).
+		Where("customer_id = ? AND voucher_cost_in_point >= ? AND redeem_date >= ? AND is_deleted = ?", customerId, minCostInPoint, since, false).
+		Where("status IN ?", []int32{constants.TransactionStatusSuccess, constants.TransactionStatusHeld}).
+		Count(&count).Error
+	return count, err
+}
+
+// CountOtherCustomerByDevice counts the customers other than customerId that redeemed
+// the voucher from the device.
+func (r *TransactionRepo) CountOtherCustomerByDevice(deviceId string, voucherId uint, customerId uint, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&Transaction{}).
+		Where("device_id = ? AND voucher_id = ? AND customer_id <> ? AND redeem_date >= ? AND is_deleted = ?", deviceId, voucherId, customerId, since, false).
+		Distinct("customer_id").
+		Count(&count).Error
+	return count, err
 }
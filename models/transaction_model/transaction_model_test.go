@@ -0,0 +1,204 @@
+package transaction_model
+
+import (
+	"customer-voucher-service/constants"
+	pb "customer-voucher-service/protogen/transaction"
+	"customer-voucher-service/utils/pagination"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func setupMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	dialector := postgres.New(postgres.Config{
+		Conn: db,
+		DSN:  "sqlmock_db_0",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open gorm DB: %v", err)
+	}
+	return gormDB, mock, func() { db.Close() }
+}
+
+func TestListTransaction(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewTransactionRepo(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "transaction" WHERE "transaction".is_deleted = $1`)).
+		WithArgs(false).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "transaction".* FROM "transaction" WHERE "transaction".is_deleted = $1 ORDER BY "transaction".id ASC LIMIT $2`)).
+		WithArgs(false, 21).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "customer_id", "voucher_id", "total"}).AddRow(1, 1, 1, 100))
+
+	transactions, total, err := repo.ListTransaction(&pb.ListTransactionReq{}, &pagination.Page{Size: 20})
+	assert.NoError(t, err)
+	assert.Len(t, transactions, 1)
+	assert.Equal(t, int64(1), total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListTransaction_WithFiltersAndSort(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewTransactionRepo(db)
+
+	customerId := int32(1)
+	voucherId := int32(2)
+	brandId := int32(3)
+	status := constants.TransactionStatusSuccess
+	startDate := "2025-01-01 00:00:00"
+	endDate := "2025-01-31 23:59:59"
+	minTotal := int64(100)
+	maxTotal := int64(1000)
+	req := &pb.ListTransactionReq{
+		CustomerId: &customerId,
+		VoucherId:  &voucherId,
+		BrandId:    &brandId,
+		Status:     &status,
+		StartDate:  &startDate,
+		EndDate:    &endDate,
+		MinTotal:   &minTotal,
+		MaxTotal:   &maxTotal,
+		SortBy:     constants.TransactionSortByTotal,
+		SortOrder:  constants.SortOrderDesc,
+	}
+	start, _ := time.ParseInLocation(constants.FormatDate, startDate, time.Local)
+	end, _ := time.ParseInLocation(constants.FormatDate, endDate, time.Local)
+
+	where := `FROM "transaction" JOIN voucher ON voucher.id = "transaction".voucher_id WHERE "transaction".is_deleted = $1 AND "transaction".customer_id = $2 AND "transaction".voucher_id = $3 AND voucher.brand_id = $4 AND "transaction".status = $5 AND "transaction".redeem_date >= $6 AND "transaction".redeem_date < $7 AND "transaction".total >= $8 AND "transaction".total <= $9`
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) `+where)).
+		WithArgs(false, customerId, voucherId, brandId, status, start, end.Add(time.Second), minTotal, maxTotal).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "transaction".* `+where+` AND ("transaction".total, "transaction".id) < ($10, $11) ORDER BY "transaction".total DESC, "transaction".id DESC LIMIT $12`)).
+		WithArgs(false, customerId, voucherId, brandId, status, start, end.Add(time.Second), minTotal, maxTotal, int64(500), 9, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "customer_id", "voucher_id", "total"}).AddRow(8, 1, 2, 400))
+
+	transactions, total, err := repo.ListTransaction(req, &pagination.Page{Size: 2, Cursor: &pagination.Cursor{ID: 9, Value: "500"}})
+	assert.NoError(t, err)
+	assert.Len(t, transactions, 1)
+	assert.Equal(t, int64(5), total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSortValue(t *testing.T) {
+	redeemDate := time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC)
+	transaction := &Transaction{ID: 1, Total: 250, RedeemDate: redeemDate}
+
+	assert.Equal(t, "250", SortValue(transaction, constants.TransactionSortByTotal))
+	assert.Equal(t, "", SortValue(transaction, ""))
+
+	value, err := ParseSortValue(constants.TransactionSortByRedeemDate, SortValue(transaction, constants.TransactionSortByRedeemDate))
+	assert.NoError(t, err)
+	assert.True(t, redeemDate.Equal(value.(time.Time)))
+}
+
+func TestSummarizeTransactionByCustomer(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewTransactionRepo(db)
+
+	lastRedeem := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(total), 0) AS points_spent, COUNT(*) AS redemption_count, MAX(redeem_date) AS last_redemption_date FROM "transaction" WHERE customer_id = $1 AND status NOT IN ($2,$3,$4) AND is_deleted = $5`)).
+		WithArgs(1, constants.TransactionStatusGiftExpired, constants.TransactionStatusHeld, constants.TransactionStatusRejected, false).
+		WillReturnRows(sqlmock.NewRows([]string{"points_spent", "redemption_count", "last_redemption_date"}).AddRow(1500, 3, lastRedeem))
+
+	summary, err := repo.SummarizeTransactionByCustomer(1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1500), summary.PointsSpent)
+	assert.Equal(t, int64(3), summary.RedemptionCount)
+	assert.True(t, lastRedeem.Equal(*summary.LastRedemptionDate))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListRecentTransactionByCustomer(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewTransactionRepo(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "transaction" WHERE customer_id = $1 AND is_deleted = $2 ORDER BY redeem_date DESC, id DESC LIMIT $3`)).
+		WithArgs(1, false, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "customer_id"}).AddRow(2, 1).AddRow(1, 1))
+
+	transactions, err := repo.ListRecentTransactionByCustomer(1, 5)
+	assert.NoError(t, err)
+	assert.Len(t, transactions, 2)
+	assert.Equal(t, uint(2), transactions[0].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListTransaction_Embed(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewTransactionRepo(db)
+
+	brandId := int32(3)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "transaction" JOIN voucher ON voucher.id = "transaction".voucher_id WHERE "transaction".is_deleted = $1 AND voucher.brand_id = $2`)).
+		WithArgs(false, brandId).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "transaction".*, ev.name AS voucher_name, ev.voucher_code AS voucher_code, eb.name AS brand_name, ec.full_name AS customer_name FROM "transaction" JOIN voucher ON voucher.id = "transaction".voucher_id LEFT JOIN voucher ev ON ev.id = "transaction".voucher_id LEFT JOIN brand eb ON eb.id = ev.brand_id LEFT JOIN customer ec ON ec.id = "transaction".customer_id WHERE "transaction".is_deleted = $1 AND voucher.brand_id = $2 ORDER BY "transaction".id ASC LIMIT $3`)).
+		WithArgs(false, brandId, 21).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "voucher_id", "voucher_name", "voucher_code", "brand_name", "customer_name"}).
+			AddRow(1, 2, "Coffee", "COF-1", "Kopi Co", "John Doe"))
+
+	transactions, total, err := repo.ListTransaction(&pb.ListTransactionReq{BrandId: &brandId, Embed: true}, &pagination.Page{Size: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, transactions, 1)
+	assert.Equal(t, "Coffee", transactions[0].VoucherName)
+	assert.Equal(t, "COF-1", transactions[0].VoucherCode)
+	assert.Equal(t, "Kopi Co", transactions[0].BrandName)
+	assert.Equal(t, "John Doe", transactions[0].CustomerName)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDetailTransaction_Embed(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewTransactionRepo(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "transaction".*, ev.name AS voucher_name, ev.voucher_code AS voucher_code, eb.name AS brand_name, ec.full_name AS customer_name FROM "transaction" LEFT JOIN voucher ev ON ev.id = "transaction".voucher_id LEFT JOIN brand eb ON eb.id = ev.brand_id LEFT JOIN customer ec ON ec.id = "transaction".customer_id WHERE "transaction".id = $1 AND "transaction".is_deleted = $2 LIMIT $3`)).
+		WithArgs(1, false, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "voucher_name", "brand_name"}).AddRow(1, "Coffee", "Kopi Co"))
+
+	transaction, err := repo.DetailTransaction(&pb.DetailTransactionReq{Id: 1, Embed: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "Coffee", transaction.VoucherName)
+	assert.Equal(t, "Kopi Co", transaction.BrandName)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateTransaction_SkipsEmbeddedColumns(t *testing.T) {
+	db, _, closeFn := setupMockDB(t)
+	defer closeFn()
+
+	stmt := db.Session(&gorm.Session{DryRun: true}).Create(&Transaction{CustomerID: 1, VoucherID: 1, VoucherName: "Coffee"}).Statement
+	assert.NotContains(t, stmt.SQL.String(), "voucher_name")
+}
+
+func TestUpdateTransactionStatusFrom_AlreadyMoved(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewTransactionRepo(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "transaction" SET "status"=$1,"modified_date"=$2 WHERE id = $3 AND status = $4 AND is_deleted = $5`)).
+		WithArgs(constants.TransactionStatusGiftExpired, sqlmock.AnyArg(), 1, constants.TransactionStatusGiftPending, false).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := repo.UpdateTransactionStatusFrom(1, constants.TransactionStatusGiftPending, constants.TransactionStatusGiftExpired)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,19 +3,32 @@ package voucher_model
 import "time"
 
 type Voucher struct {
-	ID           uint      `gorm:"primaryKey;autoIncrement" json:"id"`
-	BrandID      uint      `gorm:"not null" json:"brand_id"`
-	Name         string    `gorm:"type:varchar(255);not null" json:"name"`
-	Description  string    `gorm:"type:text" json:"description"`
-	VoucherCode  string    `gorm:"type:varchar(255);not null" json:"voucher_code"`
-	CostInPoint  int64     `gorm:"not null" json:"cost_in_point"`
-	IsDeleted    bool      `gorm:"default:false;not null" json:"is_deleted"`
-	CreatedDate  time.Time `gorm:"autoCreateTime" json:"created_date"`
-	CreatedBy    string    `gorm:"type:varchar(255)" json:"created_by"`
-	ModifiedDate time.Time `gorm:"autoUpdateTime" json:"modified_date"`
-	ModifiedBy   string    `gorm:"type:varchar(255)" json:"modified_by"`
+	ID            uint      `gorm:"primaryKey;autoIncrement" json:"id"`
+	BrandID       uint      `gorm:"not null" json:"brand_id"`
+	Name          string    `gorm:"type:varchar(255);not null" json:"name"`
+	Description   string    `gorm:"type:text" json:"description"`
+	VoucherCode   string    `gorm:"type:varchar(255);not null" json:"voucher_code"`
+	CostInPoint   int64     `gorm:"not null" json:"cost_in_point"`
+	CategoryID    *uint     `gorm:"index" json:"category_id"`
+	Tags          []Tag     `gorm:"many2many:voucher_tag" json:"tags"`
+	IsDeleted     bool      `gorm:"default:false;not null" json:"is_deleted"`
+	DeletedReason string    `gorm:"type:varchar(255)" json:"deleted_reason"`
+	CreatedDate   time.Time `gorm:"autoCreateTime" json:"created_date"`
+	CreatedBy     string    `gorm:"type:varchar(255)" json:"created_by"`
+	ModifiedDate  time.Time `gorm:"autoUpdateTime" json:"modified_date"`
+	ModifiedBy    string    `gorm:"type:varchar(255)" json:"modified_by"`
 }
 
 func (Voucher) TableName() string {
 	return "voucher"
 }
+
+type Tag struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement" json:"id"`
+	Name        string    `gorm:"type:varchar(100);uniqueIndex;not null" json:"name"`
+	CreatedDate time.Time `gorm:"autoCreateTime" json:"created_date"`
+}
+
+func (Tag) TableName() string {
+	return "tag"
+}
@@ -1,15 +1,31 @@
 package voucher_model
 
 import (
+	"context"
+	"customer-voucher-service/models/category_model"
 	pb "customer-voucher-service/protogen/voucher"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type IVoucherRepo interface {
+	WithContext(ctx context.Context) IVoucherRepo
 	CreateVoucher(voucher *Voucher) error
-	ListVoucher(*pb.ListVoucherReq) ([]*Voucher, error)
+	ListVoucher(req *pb.ListVoucherReq, page *pagination.Page) ([]*Voucher, int64, error)
 	FindVoucherById(id uint) (*Voucher, error)
+	FindVoucherByIdForUpdate(id uint) (*Voucher, error)
+	FindVoucherByIdForShare(id uint) (*Voucher, error)
+	UpdateVoucher(voucher *Voucher) error
+	FindVoucherByIdWithDeleted(id uint) (*Voucher, error)
+	DeleteVoucher(id uint, reason string, modifiedBy string) error
+	RestoreVoucher(id uint, modifiedBy string) error
+	FindOrCreateTags(names []string) ([]Tag, error)
+	ReplaceVoucherTags(voucher *Voucher, tags []Tag) error
+	ListTag(page *pagination.Page) ([]*Tag, int64, error)
+	DeleteTag(id uint) (*Tag, []*Voucher, error)
 }
 
 type VoucherRepo struct {
@@ -22,27 +38,161 @@ func NewVoucherRepo(db *gorm.DB) *VoucherRepo {
 	}
 }
 
+func (r *VoucherRepo) WithContext(ctx context.Context) IVoucherRepo {
+	return &VoucherRepo{db: transactor.DB(ctx, r.db)}
+}
+
 func (r *VoucherRepo) CreateVoucher(voucher *Voucher) error {
 	return r.db.Create(voucher).Error
 }
 
-func (r *VoucherRepo) ListVoucher(req *pb.ListVoucherReq) ([]*Voucher, error) {
+func (r *VoucherRepo) ListVoucher(req *pb.ListVoucherReq, page *pagination.Page) ([]*Voucher, int64, error) {
 	var vouchers []*Voucher
+	var total int64
 	query := r.db.Model(&Voucher{}).Where("is_deleted = ?", false)
 
 	if req.BrandId != nil {
 		query = query.Where("brand_id = ?", *req.BrandId)
 	}
+	if req.CategoryId != nil {
+		query = query.Where("category_id IN (?)", gorm.Expr(category_model.DescendantIdQuery, *req.CategoryId))
+	}
+	if req.Tag != nil {
+		query = query.Where("EXISTS (SELECT 1 FROM voucher_tag JOIN tag ON tag.id = voucher_tag.tag_id WHERE voucher_tag.voucher_id = voucher.id AND tag.name = ?)", *req.Tag)
+	}
+	if req.MinCostInPoint != nil {
+		query = query.Where("cost_in_point >= ?", *req.MinCostInPoint)
+	}
+	if req.MaxCostInPoint != nil {
+		query = query.Where("cost_in_point <= ?", *req.MaxCostInPoint)
+	}
 
-	err := query.Find(&vouchers).Error
-	return vouchers, err
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page.Cursor != nil {
+		query = query.Where("id > ?", page.Cursor.ID)
+	}
+
+	err := query.Preload("Tags").Order("id").Limit(page.Limit()).Find(&vouchers).Error
+	return vouchers, total, err
 }
 
 func (r *VoucherRepo) FindVoucherById(id uint) (*Voucher, error) {
 	var voucher Voucher
-	err := r.db.Where("id = ? AND is_deleted = ?", id, false).First(&voucher).Error
+	err := r.db.Preload("Tags").Where("id = ? AND is_deleted = ?", id, false).First(&voucher).Error
+	if err != nil {
+		return nil, err
+	}
+	return &voucher, nil
+}
+
+// FindVoucherByIdForUpdate locks the voucher row until the surrounding transaction ends,
+// so no gift can be priced at its cost while the cost is being changed.
+func (r *VoucherRepo) FindVoucherByIdForUpdate(id uint) (*Voucher, error) {
+	return r.findLocked(id, clause.LockingStrengthUpdate)
+}
+
+// FindVoucherByIdForShare locks the voucher row against updates until the surrounding
+// transaction ends, while still letting other readers lock it for share.
+func (r *VoucherRepo) FindVoucherByIdForShare(id uint) (*Voucher, error) {
+	return r.findLocked(id, clause.LockingStrengthShare)
+}
+
+func (r *VoucherRepo) findLocked(id uint, strength string) (*Voucher, error) {
+	var voucher Voucher
+	err := r.db.Clauses(clause.Locking{Strength: strength}).Where("id = ? AND is_deleted = ?", id, false).First(&voucher).Error
+	if err != nil {
+		return nil, err
+	}
+	return &voucher, nil
+}
+
+func (r *VoucherRepo) UpdateVoucher(voucher *Voucher) error {
+	return r.db.Model(&Voucher{}).Where("id = ? AND is_deleted = ?", voucher.ID, false).
+		Select("name", "description", "cost_in_point", "category_id", "modified_by").
+		Updates(voucher).Error
+}
+
+// FindVoucherByIdWithDeleted also returns soft-deleted vouchers, for history
+// that must keep resolving after a voucher is removed from the catalog.
+func (r *VoucherRepo) FindVoucherByIdWithDeleted(id uint) (*Voucher, error) {
+	var voucher Voucher
+	err := r.db.Preload("Tags").Where("id = ?", id).First(&voucher).Error
 	if err != nil {
 		return nil, err
 	}
 	return &voucher, nil
 }
+
+func (r *VoucherRepo) DeleteVoucher(id uint, reason string, modifiedBy string) error {
+	return r.db.Model(&Voucher{}).Where("id = ? AND is_deleted = ?", id, false).
+		Updates(map[string]interface{}{"is_deleted": true, "deleted_reason": reason, "modified_by": modifiedBy}).Error
+}
+
+func (r *VoucherRepo) RestoreVoucher(id uint, modifiedBy string) error {
+	return r.db.Model(&Voucher{}).Where("id = ? AND is_deleted = ?", id, true).
+		Updates(map[string]interface{}{"is_deleted": false, "deleted_reason": "", "modified_by": modifiedBy}).Error
+}
+
+func (r *VoucherRepo) FindOrCreateTags(names []string) ([]Tag, error) {
+	tags := []Tag{}
+	for _, name := range names {
+		tag := Tag{Name: name}
+		err := r.db.Where(Tag{Name: name}).FirstOrCreate(&tag).Error
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+func (r *VoucherRepo) ReplaceVoucherTags(voucher *Voucher, tags []Tag) error {
+	return r.db.Model(voucher).Association("Tags").Replace(tags)
+}
+
+// ListTag lists tags by name. The page cursor holds the name of the last tag of the previous page.
+func (r *VoucherRepo) ListTag(page *pagination.Page) ([]*Tag, int64, error) {
+	var tags []*Tag
+	var total int64
+	query := r.db.Model(&Tag{})
+
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page.Cursor != nil {
+		query = query.Where("(name, id) > (?, ?)", page.Cursor.Value, page.Cursor.ID)
+	}
+
+	err := query.Order("name, id").Limit(page.Limit()).Find(&tags).Error
+	return tags, total, err
+}
+
+// DeleteTag removes the tag from every voucher and deletes it in one transaction. It
+// returns the deleted tag and the vouchers that carried it, with their tags as they were
+// before the delete, or gorm.ErrRecordNotFound when there is no such tag.
+func (r *VoucherRepo) DeleteTag(id uint) (*Tag, []*Voucher, error) {
+	tag := &Tag{}
+	vouchers := []*Voucher{}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		tagged := tx.Table("voucher_tag").Select("voucher_id").Where("tag_id = ?", id)
+		err := tx.Preload("Tags").Where("id IN (?)", tagged).Order("id").Find(&vouchers).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Table("voucher_tag").Where("tag_id = ?", id).Delete(nil).Error
+		if err != nil {
+			return err
+		}
+		result := tx.Clauses(clause.Returning{}).Delete(tag, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+	return tag, vouchers, err
+}
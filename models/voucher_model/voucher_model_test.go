@@ -1,6 +1,8 @@
 package voucher_model
 
 import (
+	pb "customer-voucher-service/protogen/voucher"
+	"customer-voucher-service/utils/pagination"
 	"regexp"
 	"testing"
 	"time"
@@ -60,13 +62,79 @@ func TestListVoucher(t *testing.T) {
 		AddRow(1, 1, "Voucher 1", "Desc 1", 100, "CODE1", time.Now(), time.Now(), false).
 		AddRow(2, 2, "Voucher 2", "Desc 2", 200, "CODE2", time.Now(), time.Now(), false)
 
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "voucher" WHERE is_deleted = $1`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "voucher" WHERE is_deleted = $1`)).
 		WithArgs(false).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "voucher" WHERE is_deleted = $1 ORDER BY id LIMIT $2`)).
+		WithArgs(false, 21).
 		WillReturnRows(mockRows)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "voucher_tag" WHERE "voucher_tag"."voucher_id" IN ($1,$2)`)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"voucher_id", "tag_id"}))
 
-	vouchers, err := repo.ListVoucher(&struct{ BrandId *int32 }{})
+	vouchers, total, err := repo.ListVoucher(&pb.ListVoucherReq{}, &pagination.Page{Size: 20})
 	assert.NoError(t, err)
 	assert.Len(t, vouchers, 2)
+	assert.Equal(t, int64(2), total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListVoucher_WithFilters(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewVoucherRepo(db)
+
+	brandId := int32(1)
+	categoryId := int32(2)
+	tag := "coffee"
+	minCost := int64(100)
+	maxCost := int64(500)
+
+	mockRows := sqlmock.NewRows([]string{"id", "brand_id", "name", "description", "cost_in_point", "voucher_code", "category_id", "created_date", "modified_date", "is_deleted"}).
+		AddRow(1, 1, "Voucher 1", "Desc 1", 200, "CODE1", 3, time.Now(), time.Now(), false)
+
+	expectedSql := regexp.QuoteMeta(`SELECT * FROM "voucher" WHERE is_deleted = $1 AND brand_id = $2 AND category_id IN (WITH RECURSIVE category_tree AS (`) +
+		`.*` +
+		regexp.QuoteMeta(`AND (EXISTS (SELECT 1 FROM voucher_tag JOIN tag ON tag.id = voucher_tag.tag_id WHERE voucher_tag.voucher_id = voucher.id AND tag.name = $4)) AND cost_in_point >= $5 AND cost_in_point <= $6 AND id > $7 ORDER BY id LIMIT $8`)
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "voucher" WHERE .*cost_in_point <= \$6`).
+		WithArgs(false, brandId, categoryId, tag, minCost, maxCost).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(expectedSql).
+		WithArgs(false, brandId, categoryId, tag, minCost, maxCost, 10, 11).
+		WillReturnRows(mockRows)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "voucher_tag" WHERE "voucher_tag"."voucher_id" = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"voucher_id", "tag_id"}))
+
+	vouchers, total, err := repo.ListVoucher(&pb.ListVoucherReq{
+		BrandId:        &brandId,
+		CategoryId:     &categoryId,
+		Tag:            &tag,
+		MinCostInPoint: &minCost,
+		MaxCostInPoint: &maxCost,
+	}, &pagination.Page{Size: 10, Cursor: &pagination.Cursor{ID: 10}})
+	assert.NoError(t, err)
+	assert.Len(t, vouchers, 1)
+	assert.Equal(t, int64(1), total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListTag_AfterCursor(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewVoucherRepo(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "tag"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tag" WHERE (name, id) > ($1, $2) ORDER BY name, id LIMIT $3`)).
+		WithArgs("coffee", 4, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_date"}).
+			AddRow(2, "promo", time.Now()))
+
+	tags, total, err := repo.ListTag(&pagination.Page{Size: 2, Cursor: &pagination.Cursor{ID: 4, Value: "coffee"}})
+	assert.NoError(t, err)
+	assert.Len(t, tags, 1)
+	assert.Equal(t, int64(3), total)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -79,9 +147,12 @@ func TestFindVoucherById(t *testing.T) {
 	mockRows := sqlmock.NewRows([]string{"id", "brand_id", "name", "description", "cost_in_point", "voucher_code", "created_date", "modified_date", "is_deleted"}).
 		AddRow(1, 1, "Voucher 1", "Desc 1", 100, "CODE1", now, now, false)
 
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "voucher" WHERE id = $1 AND is_deleted = $2 ORDER BY "voucher"."id" LIMIT 1`)).
-		WithArgs(1, false).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "voucher" WHERE id = $1 AND is_deleted = $2 ORDER BY "voucher"."id" LIMIT $3`)).
+		WithArgs(1, false, 1).
 		WillReturnRows(mockRows)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "voucher_tag" WHERE "voucher_tag"."voucher_id" = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"voucher_id", "tag_id"}))
 
 	voucher, err := repo.FindVoucherById(1)
 	assert.NoError(t, err)
@@ -89,3 +160,65 @@ func TestFindVoucherById(t *testing.T) {
 	assert.Equal(t, uint(1), voucher.ID)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestFindVoucherByIdForUpdate(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewVoucherRepo(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "voucher" WHERE id = $1 AND is_deleted = $2 ORDER BY "voucher"."id" LIMIT $3 FOR UPDATE`)).
+		WithArgs(1, false, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "cost_in_point"}).AddRow(1, 100))
+
+	voucher, err := repo.FindVoucherByIdForUpdate(1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), voucher.CostInPoint)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateVoucher(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewVoucherRepo(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "voucher" SET "name"=$1,"description"=$2,"cost_in_point"=$3,"category_id"=$4,"modified_date"=$5,"modified_by"=$6 WHERE id = $7 AND is_deleted = $8`)).
+		WithArgs("Voucher 1", "Desc 1", 150, nil, sqlmock.AnyArg(), "admin", 1, false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.UpdateVoucher(&Voucher{ID: 1, Name: "Voucher 1", Description: "Desc 1", CostInPoint: 150, ModifiedBy: "admin"})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteTag(t *testing.T) {
+	db, mock, closeFn := setupMockDB(t)
+	defer closeFn()
+	repo := NewVoucherRepo(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "voucher" WHERE id IN (SELECT voucher_id FROM "voucher_tag" WHERE tag_id = $1) ORDER BY id`)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "Voucher 3"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "voucher_tag" WHERE "voucher_tag"."voucher_id" = $1`)).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"voucher_id", "tag_id"}).AddRow(3, 7))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tag" WHERE "tag"."id" = $1`)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(7, "coffee"))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "voucher_tag" WHERE tag_id = $1`)).
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`DELETE FROM "tag" WHERE "tag"."id" = $1 RETURNING *`)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(7, "coffee"))
+	mock.ExpectCommit()
+
+	tag, vouchers, err := repo.DeleteTag(7)
+	assert.NoError(t, err)
+	assert.Equal(t, "coffee", tag.Name)
+	assert.Len(t, vouchers, 1)
+	assert.Equal(t, "coffee", vouchers[0].Tags[0].Name)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
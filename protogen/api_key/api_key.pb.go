@@ -0,0 +1,777 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: api_key/api_key.proto
+
+package apiKey
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BrandId       int32                  `protobuf:"varint,2,opt,name=brandId,proto3" json:"brandId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Scopes        []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	IsRevoked     bool                   `protobuf:"varint,6,opt,name=isRevoked,proto3" json:"isRevoked,omitempty"`
+	CreatedDate   string                 `protobuf:"bytes,7,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileApiKeyApiKeyProtoMsgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &fileApiKeyApiKeyProtoMsgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return fileApiKeyApiKeyProtoRawDescGZIP(), []int{0}
+}
+
+func (x *ApiKey) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApiKey) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKey) GetIsRevoked() bool {
+	if x != nil {
+		return x.IsRevoked
+	}
+	return false
+}
+
+func (x *ApiKey) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+func (x *ApiKey) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateApiKeyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BrandId       int32                  `protobuf:"varint,1,opt,name=brandId,proto3" json:"brandId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyReq) Reset() {
+	*x = CreateApiKeyReq{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileApiKeyApiKeyProtoMsgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyReq) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *CreateApiKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &fileApiKeyApiKeyProtoMsgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyReq.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyReq) Descriptor() ([]byte, []int) {
+	return fileApiKeyApiKeyProtoRawDescGZIP(), []int{1}
+}
+
+func (x *CreateApiKeyReq) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *CreateApiKeyReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyReq) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// key is the only time the plain key is returned, only its hash is stored.
+type CreateApiKeyRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess     bool                   `protobuf:"varint,1,opt,name=isSuccess,proto3" json:"isSuccess,omitempty"`
+	Data          *ApiKey                `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRes) Reset() {
+	*x = CreateApiKeyRes{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileApiKeyApiKeyProtoMsgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRes) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *CreateApiKeyRes) ProtoReflect() protoreflect.Message {
+	mi := &fileApiKeyApiKeyProtoMsgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRes.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRes) Descriptor() ([]byte, []int) {
+	return fileApiKeyApiKeyProtoRawDescGZIP(), []int{2}
+}
+
+func (x *CreateApiKeyRes) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
+func (x *CreateApiKeyRes) GetData() *ApiKey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateApiKeyRes) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListApiKeyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BrandId       *int32                 `protobuf:"varint,1,opt,name=brandId,proto3,oneof" json:"brandId,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeyReq) Reset() {
+	*x = ListApiKeyReq{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileApiKeyApiKeyProtoMsgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeyReq) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *ListApiKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &fileApiKeyApiKeyProtoMsgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeyReq.ProtoReflect.Descriptor instead.
+func (*ListApiKeyReq) Descriptor() ([]byte, []int) {
+	return fileApiKeyApiKeyProtoRawDescGZIP(), []int{3}
+}
+
+func (x *ListApiKeyReq) GetBrandId() int32 {
+	if x != nil && x.BrandId != nil {
+		return *x.BrandId
+	}
+	return 0
+}
+
+func (x *ListApiKeyReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListApiKeyReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListApiKeyRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ApiKey              `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeyRes) Reset() {
+	*x = ListApiKeyRes{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileApiKeyApiKeyProtoMsgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeyRes) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *ListApiKeyRes) ProtoReflect() protoreflect.Message {
+	mi := &fileApiKeyApiKeyProtoMsgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeyRes.ProtoReflect.Descriptor instead.
+func (*ListApiKeyRes) Descriptor() ([]byte, []int) {
+	return fileApiKeyApiKeyProtoRawDescGZIP(), []int{4}
+}
+
+func (x *ListApiKeyRes) GetData() []*ApiKey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListApiKeyRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListApiKeyRes) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type RevokeApiKeyReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: ignored for authenticated callers, whose identity is recorded instead.
+	//
+	// Deprecated: Marked as deprecated in apiKey/apiKey.proto.
+	ModifiedBy    string `protobuf:"bytes,2,opt,name=modifiedBy,proto3" json:"modifiedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyReq) Reset() {
+	*x = RevokeApiKeyReq{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileApiKeyApiKeyProtoMsgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyReq) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *RevokeApiKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &fileApiKeyApiKeyProtoMsgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyReq.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyReq) Descriptor() ([]byte, []int) {
+	return fileApiKeyApiKeyProtoRawDescGZIP(), []int{5}
+}
+
+func (x *RevokeApiKeyReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in apiKey/apiKey.proto.
+func (x *RevokeApiKeyReq) GetModifiedBy() string {
+	if x != nil {
+		return x.ModifiedBy
+	}
+	return ""
+}
+
+type RevokeApiKeyRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess     bool                   `protobuf:"varint,1,opt,name=isSuccess,proto3" json:"isSuccess,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRes) Reset() {
+	*x = RevokeApiKeyRes{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileApiKeyApiKeyProtoMsgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRes) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *RevokeApiKeyRes) ProtoReflect() protoreflect.Message {
+	mi := &fileApiKeyApiKeyProtoMsgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRes.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRes) Descriptor() ([]byte, []int) {
+	return fileApiKeyApiKeyProtoRawDescGZIP(), []int{6}
+}
+
+func (x *RevokeApiKeyRes) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
+type RotateApiKeyReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: ignored for authenticated callers, whose identity is recorded instead.
+	//
+	// Deprecated: Marked as deprecated in apiKey/apiKey.proto.
+	ModifiedBy    string `protobuf:"bytes,2,opt,name=modifiedBy,proto3" json:"modifiedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateApiKeyReq) Reset() {
+	*x = RotateApiKeyReq{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileApiKeyApiKeyProtoMsgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateApiKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateApiKeyReq) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *RotateApiKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &fileApiKeyApiKeyProtoMsgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateApiKeyReq.ProtoReflect.Descriptor instead.
+func (*RotateApiKeyReq) Descriptor() ([]byte, []int) {
+	return fileApiKeyApiKeyProtoRawDescGZIP(), []int{7}
+}
+
+func (x *RotateApiKeyReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in apiKey/apiKey.proto.
+func (x *RotateApiKeyReq) GetModifiedBy() string {
+	if x != nil {
+		return x.ModifiedBy
+	}
+	return ""
+}
+
+type RotateApiKeyRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess     bool                   `protobuf:"varint,1,opt,name=isSuccess,proto3" json:"isSuccess,omitempty"`
+	Data          *ApiKey                `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateApiKeyRes) Reset() {
+	*x = RotateApiKeyRes{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileApiKeyApiKeyProtoMsgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateApiKeyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateApiKeyRes) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *RotateApiKeyRes) ProtoReflect() protoreflect.Message {
+	mi := &fileApiKeyApiKeyProtoMsgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateApiKeyRes.ProtoReflect.Descriptor instead.
+func (*RotateApiKeyRes) Descriptor() ([]byte, []int) {
+	return fileApiKeyApiKeyProtoRawDescGZIP(), []int{8}
+}
+
+func (x *RotateApiKeyRes) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
+func (x *RotateApiKeyRes) GetData() *ApiKey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RotateApiKeyRes) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+var FileApiKeyApiKeyProto protoreflect.FileDescriptor
+
+var fileApiKeyApiKeyProtoRawDesc = string([]byte{
+	0x0a, 0x15, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79,
+	0x22, 0xd4, 0x01, 0x0a, 0x06, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x72, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x72,
+	0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x57, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x72,
+	0x61, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x72, 0x61,
+	0x6e, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x22, 0x66, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x74, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x07, 0x62, 0x72, 0x61,
+	0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x62, 0x72,
+	0x61, 0x6e, 0x64, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x7a,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x0f, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a,
+	0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42,
+	0x79, 0x22, 0x2f, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x22, 0x45, 0x0a, 0x0f, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x42, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x66, 0x0a, 0x0f, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6b,
+	0x65, 0x79, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x32, 0x99, 0x02, 0x0a, 0x0d, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x6b, 0x65, 0x79, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x42, 0x2b, 0x5a,
+	0x29, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2d, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67,
+	0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+})
+
+var (
+	fileApiKeyApiKeyProtoRawDescOnce sync.Once
+	fileApiKeyApiKeyProtoRawDescData []byte
+)
+
+func fileApiKeyApiKeyProtoRawDescGZIP() []byte {
+	fileApiKeyApiKeyProtoRawDescOnce.Do(func() {
+		fileApiKeyApiKeyProtoRawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(fileApiKeyApiKeyProtoRawDesc), len(fileApiKeyApiKeyProtoRawDesc)))
+	})
+	return fileApiKeyApiKeyProtoRawDescData
+}
+
+var fileApiKeyApiKeyProtoMsgTypes = make([]protoimpl.MessageInfo, 9)
+var fileApiKeyApiKeyProtoGoTypes = []any{
+	(*ApiKey)(nil),          // 0: apiKey.ApiKey
+	(*CreateApiKeyReq)(nil), // 1: apiKey.CreateApiKeyReq
+	(*CreateApiKeyRes)(nil), // 2: apiKey.CreateApiKeyRes
+	(*ListApiKeyReq)(nil),   // 3: apiKey.ListApiKeyReq
+	(*ListApiKeyRes)(nil),   // 4: apiKey.ListApiKeyRes
+	(*RevokeApiKeyReq)(nil), // 5: apiKey.RevokeApiKeyReq
+	(*RevokeApiKeyRes)(nil), // 6: apiKey.RevokeApiKeyRes
+	(*RotateApiKeyReq)(nil), // 7: apiKey.RotateApiKeyReq
+	(*RotateApiKeyRes)(nil), // 8: apiKey.RotateApiKeyRes
+}
+var fileApiKeyApiKeyProtoDepIdxs = []int32{
+	0, // 0: apiKey.CreateApiKeyRes.data:typeName -> apiKey.ApiKey
+	0, // 1: apiKey.ListApiKeyRes.data:typeName -> apiKey.ApiKey
+	0, // 2: apiKey.RotateApiKeyRes.data:typeName -> apiKey.ApiKey
+	1, // 3: apiKey.ApiKeyService.CreateApiKey:inputType -> apiKey.CreateApiKeyReq
+	3, // 4: apiKey.ApiKeyService.ListApiKey:inputType -> apiKey.ListApiKeyReq
+	5, // 5: apiKey.ApiKeyService.RevokeApiKey:inputType -> apiKey.RevokeApiKeyReq
+	7, // 6: apiKey.ApiKeyService.RotateApiKey:inputType -> apiKey.RotateApiKeyReq
+	2, // 7: apiKey.ApiKeyService.CreateApiKey:outputType -> apiKey.CreateApiKeyRes
+	4, // 8: apiKey.ApiKeyService.ListApiKey:outputType -> apiKey.ListApiKeyRes
+	6, // 9: apiKey.ApiKeyService.RevokeApiKey:outputType -> apiKey.RevokeApiKeyRes
+	8, // 10: apiKey.ApiKeyService.RotateApiKey:outputType -> apiKey.RotateApiKeyRes
+	7, // [7:11] is the sub-list for method outputType
+	3, // [3:7] is the sub-list for method inputType
+	3, // [3:3] is the sub-list for extension typeName
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field typeName
+}
+
+func init() { fileApiKeyApiKeyProtoInit() }
+func fileApiKeyApiKeyProtoInit() {
+	if FileApiKeyApiKeyProto != nil {
+		return
+	}
+	fileApiKeyApiKeyProtoMsgTypes[3].OneofWrappers = []any{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	type x struct {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{
+				// NOSONAR : Auto-generated function, intentionally left blank
+			}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(fileApiKeyApiKeyProtoRawDesc), len(fileApiKeyApiKeyProtoRawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           fileApiKeyApiKeyProtoGoTypes,
+		DependencyIndexes: fileApiKeyApiKeyProtoDepIdxs,
+		MessageInfos:      fileApiKeyApiKeyProtoMsgTypes,
+	}.Build()
+	FileApiKeyApiKeyProto = out.File
+	fileApiKeyApiKeyProtoGoTypes = nil
+	fileApiKeyApiKeyProtoDepIdxs = nil
+}
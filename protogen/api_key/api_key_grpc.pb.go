@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: api_key/api_key.proto
+
+package apiKey
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ApiKeyServiceCreateApiKeyFullMethodName = "/api_key.ApiKeyService/CreateApiKey"
+	ApiKeyServiceListApiKeyFullMethodName   = "/api_key.ApiKeyService/ListApiKey"
+	ApiKeyServiceRevokeApiKeyFullMethodName = "/api_key.ApiKeyService/RevokeApiKey"
+	ApiKeyServiceRotateApiKeyFullMethodName = "/api_key.ApiKeyService/RotateApiKey"
+)
+
+// ApiKeyServiceClient is the client API for ApiKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ApiKeyServiceClient interface {
+	CreateApiKey(ctx context.Context, in *CreateApiKeyReq, opts ...grpc.CallOption) (*CreateApiKeyRes, error)
+	ListApiKey(ctx context.Context, in *ListApiKeyReq, opts ...grpc.CallOption) (*ListApiKeyRes, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyReq, opts ...grpc.CallOption) (*RevokeApiKeyRes, error)
+	RotateApiKey(ctx context.Context, in *RotateApiKeyReq, opts ...grpc.CallOption) (*RotateApiKeyRes, error)
+}
+
+type apiKeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApiKeyServiceClient(cc grpc.ClientConnInterface) ApiKeyServiceClient {
+	return &apiKeyServiceClient{cc}
+}
+
+func (c *apiKeyServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyReq, opts ...grpc.CallOption) (*CreateApiKeyRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyRes)
+	err := c.cc.Invoke(ctx, ApiKeyServiceCreateApiKeyFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) ListApiKey(ctx context.Context, in *ListApiKeyReq, opts ...grpc.CallOption) (*ListApiKeyRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeyRes)
+	err := c.cc.Invoke(ctx, ApiKeyServiceListApiKeyFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyReq, opts ...grpc.CallOption) (*RevokeApiKeyRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiKeyRes)
+	err := c.cc.Invoke(ctx, ApiKeyServiceRevokeApiKeyFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) RotateApiKey(ctx context.Context, in *RotateApiKeyReq, opts ...grpc.CallOption) (*RotateApiKeyRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateApiKeyRes)
+	err := c.cc.Invoke(ctx, ApiKeyServiceRotateApiKeyFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApiKeyServiceServer is the server API for ApiKeyService service.
+// All implementations must embed UnimplementedApiKeyServiceServer
+// for forward compatibility.
+type ApiKeyServiceServer interface {
+	CreateApiKey(context.Context, *CreateApiKeyReq) (*CreateApiKeyRes, error)
+	ListApiKey(context.Context, *ListApiKeyReq) (*ListApiKeyRes, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyReq) (*RevokeApiKeyRes, error)
+	RotateApiKey(context.Context, *RotateApiKeyReq) (*RotateApiKeyRes, error)
+	mustEmbedUnimplementedApiKeyServiceServer()
+}
+
+// UnimplementedApiKeyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedApiKeyServiceServer struct {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (UnimplementedApiKeyServiceServer) CreateApiKey(context.Context, *CreateApiKeyReq) (*CreateApiKeyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedApiKeyServiceServer) ListApiKey(context.Context, *ListApiKeyReq) (*ListApiKeyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKey not implemented")
+}
+func (UnimplementedApiKeyServiceServer) RevokeApiKey(context.Context, *RevokeApiKeyReq) (*RevokeApiKeyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedApiKeyServiceServer) RotateApiKey(context.Context, *RotateApiKeyReq) (*RotateApiKeyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateApiKey not implemented")
+}
+func (UnimplementedApiKeyServiceServer) mustEmbedUnimplementedApiKeyServiceServer() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+func (UnimplementedApiKeyServiceServer) testEmbeddedByValue() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+// UnsafeApiKeyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ApiKeyServiceServer will
+// result in compilation errors.
+type UnsafeApiKeyServiceServer interface {
+	mustEmbedUnimplementedApiKeyServiceServer()
+}
+
+func RegisterApiKeyServiceServer(s grpc.ServiceRegistrar, srv ApiKeyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedApiKeyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ApiKeyServiceServiceDesc, srv)
+}
+
+func ApiKeyServiceCreateApiKeyHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(CreateApiKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyServiceCreateApiKeyFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(ApiKeyServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func ApiKeyServiceListApiKeyHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(ListApiKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).ListApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyServiceListApiKeyFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(ApiKeyServiceServer).ListApiKey(ctx, req.(*ListApiKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func ApiKeyServiceRevokeApiKeyHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(RevokeApiKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyServiceRevokeApiKeyFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(ApiKeyServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func ApiKeyServiceRotateApiKeyHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(RotateApiKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).RotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyServiceRotateApiKeyFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(ApiKeyServiceServer).RotateApiKey(ctx, req.(*RotateApiKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ApiKeyServiceServiceDesc is the grpc.ServiceDesc for ApiKeyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ApiKeyServiceServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api_key.ApiKeyService",
+	HandlerType: (*ApiKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateApiKey",
+			Handler:    ApiKeyServiceCreateApiKeyHandler,
+		},
+		{
+			MethodName: "ListApiKey",
+			Handler:    ApiKeyServiceListApiKeyHandler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    ApiKeyServiceRevokeApiKeyHandler,
+		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    ApiKeyServiceRotateApiKeyHandler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	},
+	Metadata: "api_key/api_key.proto",
+}
@@ -0,0 +1,139 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: audit/audit.proto
+
+package audit
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuditServiceListAuditLogFullMethodName = "/audit.AuditService/ListAuditLog"
+)
+
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuditServiceClient interface {
+	ListAuditLog(ctx context.Context, in *ListAuditLogReq, opts ...grpc.CallOption) (*ListAuditLogRes, error)
+}
+
+type auditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogReq, opts ...grpc.CallOption) (*ListAuditLogRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogRes)
+	err := c.cc.Invoke(ctx, AuditServiceListAuditLogFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+// All implementations must embed UnimplementedAuditServiceServer
+// for forward compatibility.
+type AuditServiceServer interface {
+	ListAuditLog(context.Context, *ListAuditLogReq) (*ListAuditLogRes, error)
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+// UnimplementedAuditServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditServiceServer struct {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (UnimplementedAuditServiceServer) ListAuditLog(context.Context, *ListAuditLogReq) (*ListAuditLogRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (UnimplementedAuditServiceServer) mustEmbedUnimplementedAuditServiceServer() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+func (UnimplementedAuditServiceServer) testEmbeddedByValue() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+// UnsafeAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServiceServer will
+// result in compilation errors.
+type UnsafeAuditServiceServer interface {
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+func RegisterAuditServiceServer(s grpc.ServiceRegistrar, srv AuditServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditServiceServiceDesc, srv)
+}
+
+func AuditServiceListAuditLogHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(ListAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditServiceListAuditLogFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(AuditServiceServer).ListAuditLog(ctx, req.(*ListAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditServiceServiceDesc is the grpc.ServiceDesc for AuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditServiceServiceDesc = grpc.ServiceDesc{
+	ServiceName: "audit.AuditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditLog",
+			Handler:    AuditServiceListAuditLogHandler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	},
+	Metadata: "audit/audit.proto",
+}
@@ -215,6 +215,8 @@ func (x *Brand) GetIsDeleted() bool {
 
 type ListBrandReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -253,9 +255,25 @@ func (*ListBrandReq) Descriptor() ([]byte, []int) {
 	return fileBrandBrandProtoRawDescGZIP(), []int{3}
 }
 
+func (x *ListBrandReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBrandReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListBrandRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          []*Brand               `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -301,6 +319,472 @@ func (x *ListBrandRes) GetData() []*Brand {
 	return nil
 }
 
+func (x *ListBrandRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListBrandRes) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type DetailBrandReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailBrandReq) Reset() {
+	*x = DetailBrandReq{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileBrandBrandProtoMsgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailBrandReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailBrandReq) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *DetailBrandReq) ProtoReflect() protoreflect.Message {
+	mi := &fileBrandBrandProtoMsgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailBrandReq.ProtoReflect.Descriptor instead.
+func (*DetailBrandReq) Descriptor() ([]byte, []int) {
+	return fileBrandBrandProtoRawDescGZIP(), []int{5}
+}
+
+func (x *DetailBrandReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DetailBrandRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Brand                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailBrandRes) Reset() {
+	*x = DetailBrandRes{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileBrandBrandProtoMsgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailBrandRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailBrandRes) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *DetailBrandRes) ProtoReflect() protoreflect.Message {
+	mi := &fileBrandBrandProtoMsgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailBrandRes.ProtoReflect.Descriptor instead.
+func (*DetailBrandRes) Descriptor() ([]byte, []int) {
+	return fileBrandBrandProtoRawDescGZIP(), []int{6}
+}
+
+func (x *DetailBrandRes) GetData() *Brand {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateBrandReq struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: ignored for authenticated callers, whose identity is recorded instead.
+	//
+	// Deprecated: Marked as deprecated in brand/brand.proto.
+	ModifiedBy    string `protobuf:"bytes,4,opt,name=modifiedBy,proto3" json:"modifiedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBrandReq) Reset() {
+	*x = UpdateBrandReq{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileBrandBrandProtoMsgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBrandReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBrandReq) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *UpdateBrandReq) ProtoReflect() protoreflect.Message {
+	mi := &fileBrandBrandProtoMsgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBrandReq.ProtoReflect.Descriptor instead.
+func (*UpdateBrandReq) Descriptor() ([]byte, []int) {
+	return fileBrandBrandProtoRawDescGZIP(), []int{7}
+}
+
+func (x *UpdateBrandReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateBrandReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBrandReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in brand/brand.proto.
+func (x *UpdateBrandReq) GetModifiedBy() string {
+	if x != nil {
+		return x.ModifiedBy
+	}
+	return ""
+}
+
+type UpdateBrandRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess     bool                   `protobuf:"varint,1,opt,name=isSuccess,proto3" json:"isSuccess,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBrandRes) Reset() {
+	*x = UpdateBrandRes{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileBrandBrandProtoMsgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBrandRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBrandRes) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *UpdateBrandRes) ProtoReflect() protoreflect.Message {
+	mi := &fileBrandBrandProtoMsgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBrandRes.ProtoReflect.Descriptor instead.
+func (*UpdateBrandRes) Descriptor() ([]byte, []int) {
+	return fileBrandBrandProtoRawDescGZIP(), []int{8}
+}
+
+func (x *UpdateBrandRes) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
+type DeleteBrandReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CascadeVouchers bool                   `protobuf:"varint,2,opt,name=cascadeVouchers,proto3" json:"cascadeVouchers,omitempty"`
+	// Deprecated: ignored for authenticated callers, whose identity is recorded instead.
+	//
+	// Deprecated: Marked as deprecated in brand/brand.proto.
+	ModifiedBy    string `protobuf:"bytes,3,opt,name=modifiedBy,proto3" json:"modifiedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBrandReq) Reset() {
+	*x = DeleteBrandReq{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileBrandBrandProtoMsgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBrandReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBrandReq) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *DeleteBrandReq) ProtoReflect() protoreflect.Message {
+	mi := &fileBrandBrandProtoMsgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBrandReq.ProtoReflect.Descriptor instead.
+func (*DeleteBrandReq) Descriptor() ([]byte, []int) {
+	return fileBrandBrandProtoRawDescGZIP(), []int{9}
+}
+
+func (x *DeleteBrandReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteBrandReq) GetCascadeVouchers() bool {
+	if x != nil {
+		return x.CascadeVouchers
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in brand/brand.proto.
+func (x *DeleteBrandReq) GetModifiedBy() string {
+	if x != nil {
+		return x.ModifiedBy
+	}
+	return ""
+}
+
+type DeleteBrandRes struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess           bool                   `protobuf:"varint,1,opt,name=isSuccess,proto3" json:"isSuccess,omitempty"`
+	DeletedVoucherCount int64                  `protobuf:"varint,2,opt,name=deletedVoucherCount,proto3" json:"deletedVoucherCount,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DeleteBrandRes) Reset() {
+	*x = DeleteBrandRes{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileBrandBrandProtoMsgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBrandRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBrandRes) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *DeleteBrandRes) ProtoReflect() protoreflect.Message {
+	mi := &fileBrandBrandProtoMsgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBrandRes.ProtoReflect.Descriptor instead.
+func (*DeleteBrandRes) Descriptor() ([]byte, []int) {
+	return fileBrandBrandProtoRawDescGZIP(), []int{10}
+}
+
+func (x *DeleteBrandRes) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
+func (x *DeleteBrandRes) GetDeletedVoucherCount() int64 {
+	if x != nil {
+		return x.DeletedVoucherCount
+	}
+	return 0
+}
+
+type RestoreBrandReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: ignored for authenticated callers, whose identity is recorded instead.
+	//
+	// Deprecated: Marked as deprecated in brand/brand.proto.
+	ModifiedBy    string `protobuf:"bytes,2,opt,name=modifiedBy,proto3" json:"modifiedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreBrandReq) Reset() {
+	*x = RestoreBrandReq{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileBrandBrandProtoMsgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBrandReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBrandReq) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *RestoreBrandReq) ProtoReflect() protoreflect.Message {
+	mi := &fileBrandBrandProtoMsgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBrandReq.ProtoReflect.Descriptor instead.
+func (*RestoreBrandReq) Descriptor() ([]byte, []int) {
+	return fileBrandBrandProtoRawDescGZIP(), []int{11}
+}
+
+func (x *RestoreBrandReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in brand/brand.proto.
+func (x *RestoreBrandReq) GetModifiedBy() string {
+	if x != nil {
+		return x.ModifiedBy
+	}
+	return ""
+}
+
+type RestoreBrandRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess     bool                   `protobuf:"varint,1,opt,name=isSuccess,proto3" json:"isSuccess,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreBrandRes) Reset() {
+	*x = RestoreBrandRes{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileBrandBrandProtoMsgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBrandRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBrandRes) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *RestoreBrandRes) ProtoReflect() protoreflect.Message {
+	mi := &fileBrandBrandProtoMsgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBrandRes.ProtoReflect.Descriptor instead.
+func (*RestoreBrandRes) Descriptor() ([]byte, []int) {
+	return fileBrandBrandProtoRawDescGZIP(), []int{12}
+}
+
+func (x *RestoreBrandRes) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
 var FileBrandBrandProto protoreflect.FileDescriptor
 
 var fileBrandBrandProtoRawDesc = string([]byte{
@@ -325,23 +809,83 @@ var fileBrandBrandProtoRawDesc = string([]byte{
 	0x66, 0x69, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x69, 0x73, 0x44, 0x65,
 	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x69,
 	0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x22, 0x30, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e,
-	0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x82, 0x01, 0x0a, 0x0c,
-	0x42, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x62, 0x72,
-	0x61, 0x6e, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52,
-	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x62, 0x72,
-	0x61, 0x6e, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73,
-	0x42, 0x29, 0x5a, 0x27, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2d, 0x76, 0x6f, 0x75,
-	0x63, 0x68, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x48, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x76, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x20, 0x0a, 0x0e, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x32, 0x0a,
+	0x0e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x7a, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x2e, 0x0a,
+	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x6e, 0x0a,
+	0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x28, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64,
+	0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x60, 0x0a,
+	0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a,
+	0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x45, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x2f, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x32, 0xf9, 0x02, 0x0a, 0x0c, 0x42, 0x72, 0x61, 0x6e,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x15,
+	0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61,
+	0x6e, 0x64, 0x12, 0x13, 0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x62, 0x72,
+	0x61, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a,
+	0x15, 0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x62,
+	0x72, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x72,
+	0x61, 0x6e, 0x64, 0x12, 0x16, 0x2e, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x62, 0x72,
+	0x61, 0x6e, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x42, 0x29, 0x5a, 0x27, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2d,
+	0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -356,25 +900,42 @@ func fileBrandBrandProtoRawDescGZIP() []byte {
 	return fileBrandBrandProtoRawDescData
 }
 
-var fileBrandBrandProtoMsgTypes = make([]protoimpl.MessageInfo, 5)
+var fileBrandBrandProtoMsgTypes = make([]protoimpl.MessageInfo, 13)
 var fileBrandBrandProtoGoTypes = []any{
-	(*CreateBrandReq)(nil), // 0: brand.CreateBrandReq
-	(*CreateBrandRes)(nil), // 1: brand.CreateBrandRes
-	(*Brand)(nil),          // 2: brand.Brand
-	(*ListBrandReq)(nil),   // 3: brand.ListBrandReq
-	(*ListBrandRes)(nil),   // 4: brand.ListBrandRes
+	(*CreateBrandReq)(nil),  // 0: brand.CreateBrandReq
+	(*CreateBrandRes)(nil),  // 1: brand.CreateBrandRes
+	(*Brand)(nil),           // 2: brand.Brand
+	(*ListBrandReq)(nil),    // 3: brand.ListBrandReq
+	(*ListBrandRes)(nil),    // 4: brand.ListBrandRes
+	(*DetailBrandReq)(nil),  // 5: brand.DetailBrandReq
+	(*DetailBrandRes)(nil),  // 6: brand.DetailBrandRes
+	(*UpdateBrandReq)(nil),  // 7: brand.UpdateBrandReq
+	(*UpdateBrandRes)(nil),  // 8: brand.UpdateBrandRes
+	(*DeleteBrandReq)(nil),  // 9: brand.DeleteBrandReq
+	(*DeleteBrandRes)(nil),  // 10: brand.DeleteBrandRes
+	(*RestoreBrandReq)(nil), // 11: brand.RestoreBrandReq
+	(*RestoreBrandRes)(nil), // 12: brand.RestoreBrandRes
 }
 var fileBrandBrandProtoDepIdxs = []int32{
-	2, // 0: brand.ListBrandRes.data:typeName -> brand.Brand
-	0, // 1: brand.BrandService.CreateBrand:inputType -> brand.CreateBrandReq
-	3, // 2: brand.BrandService.ListBrand:inputType -> brand.ListBrandReq
-	1, // 3: brand.BrandService.CreateBrand:outputType -> brand.CreateBrandRes
-	4, // 4: brand.BrandService.ListBrand:outputType -> brand.ListBrandRes
-	3, // [3:5] is the sub-list for method outputType
-	1, // [1:3] is the sub-list for method inputType
-	1, // [1:1] is the sub-list for extension typeName
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field typeName
+	2,  // 0: brand.ListBrandRes.data:typeName -> brand.Brand
+	2,  // 1: brand.DetailBrandRes.data:typeName -> brand.Brand
+	0,  // 2: brand.BrandService.CreateBrand:inputType -> brand.CreateBrandReq
+	3,  // 3: brand.BrandService.ListBrand:inputType -> brand.ListBrandReq
+	5,  // 4: brand.BrandService.DetailBrand:inputType -> brand.DetailBrandReq
+	7,  // 5: brand.BrandService.UpdateBrand:inputType -> brand.UpdateBrandReq
+	9,  // 6: brand.BrandService.DeleteBrand:inputType -> brand.DeleteBrandReq
+	11, // 7: brand.BrandService.RestoreBrand:inputType -> brand.RestoreBrandReq
+	1,  // 8: brand.BrandService.CreateBrand:outputType -> brand.CreateBrandRes
+	4,  // 9: brand.BrandService.ListBrand:outputType -> brand.ListBrandRes
+	6,  // 10: brand.BrandService.DetailBrand:outputType -> brand.DetailBrandRes
+	8,  // 11: brand.BrandService.UpdateBrand:outputType -> brand.UpdateBrandRes
+	10, // 12: brand.BrandService.DeleteBrand:outputType -> brand.DeleteBrandRes
+	12, // 13: brand.BrandService.RestoreBrand:outputType -> brand.RestoreBrandRes
+	8,  // [8:14] is the sub-list for method outputType
+	2,  // [2:8] is the sub-list for method inputType
+	2,  // [2:2] is the sub-list for extension typeName
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field typeName
 }
 
 func init() { fileBrandBrandProtoInit() }
@@ -395,7 +956,7 @@ func fileBrandBrandProtoInit() {
 			}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(fileBrandBrandProtoRawDesc), len(fileBrandBrandProtoRawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BrandServiceCreateBrandFullMethodName = "/brand.BrandService/CreateBrand"
-	BrandServiceListBrandFullMethodName   = "/brand.BrandService/ListBrand"
+	BrandServiceCreateBrandFullMethodName  = "/brand.BrandService/CreateBrand"
+	BrandServiceListBrandFullMethodName    = "/brand.BrandService/ListBrand"
+	BrandServiceDetailBrandFullMethodName  = "/brand.BrandService/DetailBrand"
+	BrandServiceUpdateBrandFullMethodName  = "/brand.BrandService/UpdateBrand"
+	BrandServiceDeleteBrandFullMethodName  = "/brand.BrandService/DeleteBrand"
+	BrandServiceRestoreBrandFullMethodName = "/brand.BrandService/RestoreBrand"
 )
 
 // BrandServiceClient is the client API for BrandService service.
@@ -29,6 +33,10 @@ const (
 type BrandServiceClient interface {
 	CreateBrand(ctx context.Context, in *CreateBrandReq, opts ...grpc.CallOption) (*CreateBrandRes, error)
 	ListBrand(ctx context.Context, in *ListBrandReq, opts ...grpc.CallOption) (*ListBrandRes, error)
+	DetailBrand(ctx context.Context, in *DetailBrandReq, opts ...grpc.CallOption) (*DetailBrandRes, error)
+	UpdateBrand(ctx context.Context, in *UpdateBrandReq, opts ...grpc.CallOption) (*UpdateBrandRes, error)
+	DeleteBrand(ctx context.Context, in *DeleteBrandReq, opts ...grpc.CallOption) (*DeleteBrandRes, error)
+	RestoreBrand(ctx context.Context, in *RestoreBrandReq, opts ...grpc.CallOption) (*RestoreBrandRes, error)
 }
 
 type brandServiceClient struct {
@@ -59,12 +67,56 @@ func (c *brandServiceClient) ListBrand(ctx context.Context, in *ListBrandReq, op
 	return out, nil
 }
 
+func (c *brandServiceClient) DetailBrand(ctx context.Context, in *DetailBrandReq, opts ...grpc.CallOption) (*DetailBrandRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetailBrandRes)
+	err := c.cc.Invoke(ctx, BrandServiceDetailBrandFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brandServiceClient) UpdateBrand(ctx context.Context, in *UpdateBrandReq, opts ...grpc.CallOption) (*UpdateBrandRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBrandRes)
+	err := c.cc.Invoke(ctx, BrandServiceUpdateBrandFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brandServiceClient) DeleteBrand(ctx context.Context, in *DeleteBrandReq, opts ...grpc.CallOption) (*DeleteBrandRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBrandRes)
+	err := c.cc.Invoke(ctx, BrandServiceDeleteBrandFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brandServiceClient) RestoreBrand(ctx context.Context, in *RestoreBrandReq, opts ...grpc.CallOption) (*RestoreBrandRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreBrandRes)
+	err := c.cc.Invoke(ctx, BrandServiceRestoreBrandFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BrandServiceServer is the server API for BrandService service.
 // All implementations must embed UnimplementedBrandServiceServer
 // for forward compatibility.
 type BrandServiceServer interface {
 	CreateBrand(context.Context, *CreateBrandReq) (*CreateBrandRes, error)
 	ListBrand(context.Context, *ListBrandReq) (*ListBrandRes, error)
+	DetailBrand(context.Context, *DetailBrandReq) (*DetailBrandRes, error)
+	UpdateBrand(context.Context, *UpdateBrandReq) (*UpdateBrandRes, error)
+	DeleteBrand(context.Context, *DeleteBrandReq) (*DeleteBrandRes, error)
+	RestoreBrand(context.Context, *RestoreBrandReq) (*RestoreBrandRes, error)
 	mustEmbedUnimplementedBrandServiceServer()
 }
 
@@ -83,6 +135,18 @@ func (UnimplementedBrandServiceServer) CreateBrand(context.Context, *CreateBrand
 func (UnimplementedBrandServiceServer) ListBrand(context.Context, *ListBrandReq) (*ListBrandRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBrand not implemented")
 }
+func (UnimplementedBrandServiceServer) DetailBrand(context.Context, *DetailBrandReq) (*DetailBrandRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetailBrand not implemented")
+}
+func (UnimplementedBrandServiceServer) UpdateBrand(context.Context, *UpdateBrandReq) (*UpdateBrandRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBrand not implemented")
+}
+func (UnimplementedBrandServiceServer) DeleteBrand(context.Context, *DeleteBrandReq) (*DeleteBrandRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBrand not implemented")
+}
+func (UnimplementedBrandServiceServer) RestoreBrand(context.Context, *RestoreBrandReq) (*RestoreBrandRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBrand not implemented")
+}
 func (UnimplementedBrandServiceServer) mustEmbedUnimplementedBrandServiceServer() {
 	// NOSONAR : Auto-generated function, intentionally left blank
 }
@@ -164,6 +228,118 @@ func BrandServiceListBrandHandler(srv interface {
 	return interceptor(ctx, in, info, handler)
 }
 
+func BrandServiceDetailBrandHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(DetailBrandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrandServiceServer).DetailBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrandServiceDetailBrandFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(BrandServiceServer).DetailBrand(ctx, req.(*DetailBrandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func BrandServiceUpdateBrandHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(UpdateBrandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrandServiceServer).UpdateBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrandServiceUpdateBrandFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(BrandServiceServer).UpdateBrand(ctx, req.(*UpdateBrandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func BrandServiceDeleteBrandHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(DeleteBrandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrandServiceServer).DeleteBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrandServiceDeleteBrandFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(BrandServiceServer).DeleteBrand(ctx, req.(*DeleteBrandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func BrandServiceRestoreBrandHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(RestoreBrandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrandServiceServer).RestoreBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrandServiceRestoreBrandFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(BrandServiceServer).RestoreBrand(ctx, req.(*RestoreBrandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BrandServiceServiceDesc is the grpc.ServiceDesc for BrandService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -179,6 +355,22 @@ var BrandServiceServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBrand",
 			Handler:    BrandServiceListBrandHandler,
 		},
+		{
+			MethodName: "DetailBrand",
+			Handler:    BrandServiceDetailBrandHandler,
+		},
+		{
+			MethodName: "UpdateBrand",
+			Handler:    BrandServiceUpdateBrandHandler,
+		},
+		{
+			MethodName: "DeleteBrand",
+			Handler:    BrandServiceDeleteBrandHandler,
+		},
+		{
+			MethodName: "RestoreBrand",
+			Handler:    BrandServiceRestoreBrandHandler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		// NOSONAR : Auto-generated function, intentionally left blank
@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: category/category.proto
+
+package category
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CategoryServiceCreateCategoryFullMethodName = "/category.CategoryService/CreateCategory"
+	CategoryServiceListCategoryFullMethodName   = "/category.CategoryService/ListCategory"
+	CategoryServiceUpdateCategoryFullMethodName = "/category.CategoryService/UpdateCategory"
+	CategoryServiceDeleteCategoryFullMethodName = "/category.CategoryService/DeleteCategory"
+)
+
+// CategoryServiceClient is the client API for CategoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CategoryServiceClient interface {
+	CreateCategory(ctx context.Context, in *CreateCategoryReq, opts ...grpc.CallOption) (*CreateCategoryRes, error)
+	ListCategory(ctx context.Context, in *ListCategoryReq, opts ...grpc.CallOption) (*ListCategoryRes, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryReq, opts ...grpc.CallOption) (*UpdateCategoryRes, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryReq, opts ...grpc.CallOption) (*DeleteCategoryRes, error)
+}
+
+type categoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCategoryServiceClient(cc grpc.ClientConnInterface) CategoryServiceClient {
+	return &categoryServiceClient{cc}
+}
+
+func (c *categoryServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryReq, opts ...grpc.CallOption) (*CreateCategoryRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryRes)
+	err := c.cc.Invoke(ctx, CategoryServiceCreateCategoryFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) ListCategory(ctx context.Context, in *ListCategoryReq, opts ...grpc.CallOption) (*ListCategoryRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoryRes)
+	err := c.cc.Invoke(ctx, CategoryServiceListCategoryFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryReq, opts ...grpc.CallOption) (*UpdateCategoryRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCategoryRes)
+	err := c.cc.Invoke(ctx, CategoryServiceUpdateCategoryFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryReq, opts ...grpc.CallOption) (*DeleteCategoryRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryRes)
+	err := c.cc.Invoke(ctx, CategoryServiceDeleteCategoryFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CategoryServiceServer is the server API for CategoryService service.
+// All implementations must embed UnimplementedCategoryServiceServer
+// for forward compatibility.
+type CategoryServiceServer interface {
+	CreateCategory(context.Context, *CreateCategoryReq) (*CreateCategoryRes, error)
+	ListCategory(context.Context, *ListCategoryReq) (*ListCategoryRes, error)
+	UpdateCategory(context.Context, *UpdateCategoryReq) (*UpdateCategoryRes, error)
+	DeleteCategory(context.Context, *DeleteCategoryReq) (*DeleteCategoryRes, error)
+	mustEmbedUnimplementedCategoryServiceServer()
+}
+
+// UnimplementedCategoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCategoryServiceServer struct {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (UnimplementedCategoryServiceServer) CreateCategory(context.Context, *CreateCategoryReq) (*CreateCategoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedCategoryServiceServer) ListCategory(context.Context, *ListCategoryReq) (*ListCategoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategory not implemented")
+}
+func (UnimplementedCategoryServiceServer) UpdateCategory(context.Context, *UpdateCategoryReq) (*UpdateCategoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedCategoryServiceServer) DeleteCategory(context.Context, *DeleteCategoryReq) (*DeleteCategoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedCategoryServiceServer) mustEmbedUnimplementedCategoryServiceServer() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+func (UnimplementedCategoryServiceServer) testEmbeddedByValue() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+// UnsafeCategoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CategoryServiceServer will
+// result in compilation errors.
+type UnsafeCategoryServiceServer interface {
+	mustEmbedUnimplementedCategoryServiceServer()
+}
+
+func RegisterCategoryServiceServer(s grpc.ServiceRegistrar, srv CategoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCategoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CategoryServiceServiceDesc, srv)
+}
+
+func CategoryServiceCreateCategoryHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(CreateCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryServiceCreateCategoryFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(CategoryServiceServer).CreateCategory(ctx, req.(*CreateCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func CategoryServiceListCategoryHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(ListCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).ListCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryServiceListCategoryFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(CategoryServiceServer).ListCategory(ctx, req.(*ListCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func CategoryServiceUpdateCategoryHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(UpdateCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryServiceUpdateCategoryFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(CategoryServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func CategoryServiceDeleteCategoryHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(DeleteCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryServiceDeleteCategoryFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(CategoryServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CategoryServiceServiceDesc is the grpc.ServiceDesc for CategoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CategoryServiceServiceDesc = grpc.ServiceDesc{
+	ServiceName: "category.CategoryService",
+	HandlerType: (*CategoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCategory",
+			Handler:    CategoryServiceCreateCategoryHandler,
+		},
+		{
+			MethodName: "ListCategory",
+			Handler:    CategoryServiceListCategoryHandler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    CategoryServiceUpdateCategoryHandler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    CategoryServiceDeleteCategoryHandler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	},
+	Metadata: "category/category.proto",
+}
@@ -7,6 +7,7 @@
 package customer
 
 import (
+	transaction "customer-voucher-service/protogen/transaction"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -26,6 +27,7 @@ type CreateCustomerReq struct {
 	FullName      string                 `protobuf:"bytes,1,opt,name=fullName,proto3" json:"fullName,omitempty"`
 	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Points        int64                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	ReferralCode  *string                `protobuf:"bytes,4,opt,name=referralCode,proto3,oneof" json:"referralCode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -85,6 +87,13 @@ func (x *CreateCustomerReq) GetPoints() int64 {
 	return 0
 }
 
+func (x *CreateCustomerReq) GetReferralCode() string {
+	if x != nil && x.ReferralCode != nil {
+		return *x.ReferralCode
+	}
+	return ""
+}
+
 type CreateCustomerRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IsSuccess     bool                   `protobuf:"varint,1,opt,name=isSuccess,proto3" json:"isSuccess,omitempty"`
@@ -134,16 +143,19 @@ func (x *CreateCustomerRes) GetIsSuccess() bool {
 }
 
 type Customer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	FullName      string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Points        *int64                 `protobuf:"varint,4,opt,name=points,proto3,oneof" json:"points,omitempty"`
-	CreatedDate   string                 `protobuf:"bytes,5,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
-	ModifiedDate  string                 `protobuf:"bytes,6,opt,name=modifiedDate,proto3" json:"modifiedDate,omitempty"`
-	IsDeleted     *bool                  `protobuf:"varint,7,opt,name=isDeleted,proto3,oneof" json:"isDeleted,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FullName          string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	Email             string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Points            *int64                 `protobuf:"varint,4,opt,name=points,proto3,oneof" json:"points,omitempty"`
+	CreatedDate       string                 `protobuf:"bytes,5,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	ModifiedDate      string                 `protobuf:"bytes,6,opt,name=modifiedDate,proto3" json:"modifiedDate,omitempty"`
+	IsDeleted         *bool                  `protobuf:"varint,7,opt,name=isDeleted,proto3,oneof" json:"isDeleted,omitempty"`
+	ReferralCode      string                 `protobuf:"bytes,8,opt,name=referralCode,proto3" json:"referralCode,omitempty"`
+	ReferredById      *int32                 `protobuf:"varint,9,opt,name=referredById,proto3,oneof" json:"referredById,omitempty"`
+	DeactivatedReason string                 `protobuf:"bytes,10,opt,name=deactivatedReason,proto3" json:"deactivatedReason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Customer) Reset() {
@@ -229,8 +241,31 @@ func (x *Customer) GetIsDeleted() bool {
 	return false
 }
 
+func (x *Customer) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+func (x *Customer) GetReferredById() int32 {
+	if x != nil && x.ReferredById != nil {
+		return *x.ReferredById
+	}
+	return 0
+}
+
+func (x *Customer) GetDeactivatedReason() string {
+	if x != nil {
+		return x.DeactivatedReason
+	}
+	return ""
+}
+
 type ListCustomerReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -269,9 +304,25 @@ func (*ListCustomerReq) Descriptor() ([]byte, []int) {
 	return fileCustomerCustomerProtoRawDescGZIP(), []int{3}
 }
 
+func (x *ListCustomerReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCustomerReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListCustomerRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          []*Customer            `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -317,12 +368,166 @@ func (x *ListCustomerRes) GetData() []*Customer {
 	return nil
 }
 
-type UpdateCustomerReq struct {
+func (x *ListCustomerRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCustomerRes) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type DetailCustomerReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	FullName      string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Points        int64                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	RecentLimit   int32                  `protobuf:"varint,2,opt,name=recentLimit,proto3" json:"recentLimit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailCustomerReq) Reset() {
+	*x = DetailCustomerReq{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileCustomerCustomerProtoMsgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailCustomerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailCustomerReq) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *DetailCustomerReq) ProtoReflect() protoreflect.Message {
+	mi := &fileCustomerCustomerProtoMsgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailCustomerReq.ProtoReflect.Descriptor instead.
+func (*DetailCustomerReq) Descriptor() ([]byte, []int) {
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{5}
+}
+
+func (x *DetailCustomerReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DetailCustomerReq) GetRecentLimit() int32 {
+	if x != nil {
+		return x.RecentLimit
+	}
+	return 0
+}
+
+type DetailCustomerRes struct {
+	state              protoimpl.MessageState     `protogen:"open.v1"`
+	Data               *Customer                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	PointsSpent        int64                      `protobuf:"varint,2,opt,name=pointsSpent,proto3" json:"pointsSpent,omitempty"`
+	RedemptionCount    int64                      `protobuf:"varint,3,opt,name=redemptionCount,proto3" json:"redemptionCount,omitempty"`
+	LastRedemptionDate string                     `protobuf:"bytes,4,opt,name=lastRedemptionDate,proto3" json:"lastRedemptionDate,omitempty"`
+	RecentTransactions []*transaction.Transaction `protobuf:"bytes,5,rep,name=recentTransactions,proto3" json:"recentTransactions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DetailCustomerRes) Reset() {
+	*x = DetailCustomerRes{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileCustomerCustomerProtoMsgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailCustomerRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailCustomerRes) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *DetailCustomerRes) ProtoReflect() protoreflect.Message {
+	mi := &fileCustomerCustomerProtoMsgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailCustomerRes.ProtoReflect.Descriptor instead.
+func (*DetailCustomerRes) Descriptor() ([]byte, []int) {
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{6}
+}
+
+func (x *DetailCustomerRes) GetData() *Customer {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DetailCustomerRes) GetPointsSpent() int64 {
+	if x != nil {
+		return x.PointsSpent
+	}
+	return 0
+}
+
+func (x *DetailCustomerRes) GetRedemptionCount() int64 {
+	if x != nil {
+		return x.RedemptionCount
+	}
+	return 0
+}
+
+func (x *DetailCustomerRes) GetLastRedemptionDate() string {
+	if x != nil {
+		return x.LastRedemptionDate
+	}
+	return ""
+}
+
+func (x *DetailCustomerRes) GetRecentTransactions() []*transaction.Transaction {
+	if x != nil {
+		return x.RecentTransactions
+	}
+	return nil
+}
+
+type UpdateCustomerReq struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FullName string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	Email    string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Points   int64                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	// Deprecated: ignored for authenticated callers, whose identity is recorded instead.
+	//
+	// Deprecated: Marked as deprecated in customer/customer.proto.
+	ModifiedBy    string `protobuf:"bytes,5,opt,name=modifiedBy,proto3" json:"modifiedBy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -331,7 +536,7 @@ func (x *UpdateCustomerReq) Reset() {
 	*x = UpdateCustomerReq{
 		// NOSONAR : Auto-generated function, intentionally left blank
 	}
-	mi := &fileCustomerCustomerProtoMsgTypes[5]
+	mi := &fileCustomerCustomerProtoMsgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -345,7 +550,7 @@ func (*UpdateCustomerReq) ProtoMessage() {
 }
 
 func (x *UpdateCustomerReq) ProtoReflect() protoreflect.Message {
-	mi := &fileCustomerCustomerProtoMsgTypes[5]
+	mi := &fileCustomerCustomerProtoMsgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +563,7 @@ func (x *UpdateCustomerReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCustomerReq.ProtoReflect.Descriptor instead.
 func (*UpdateCustomerReq) Descriptor() ([]byte, []int) {
-	return fileCustomerCustomerProtoRawDescGZIP(), []int{5}
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{7}
 }
 
 func (x *UpdateCustomerReq) GetId() int32 {
@@ -389,6 +594,14 @@ func (x *UpdateCustomerReq) GetPoints() int64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in customer/customer.proto.
+func (x *UpdateCustomerReq) GetModifiedBy() string {
+	if x != nil {
+		return x.ModifiedBy
+	}
+	return ""
+}
+
 type UpdateCustomerRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IsSuccess     bool                   `protobuf:"varint,1,opt,name=isSuccess,proto3" json:"isSuccess,omitempty"`
@@ -400,7 +613,7 @@ func (x *UpdateCustomerRes) Reset() {
 	*x = UpdateCustomerRes{
 		// NOSONAR : Auto-generated function, intentionally left blank
 	}
-	mi := &fileCustomerCustomerProtoMsgTypes[6]
+	mi := &fileCustomerCustomerProtoMsgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -414,7 +627,7 @@ func (*UpdateCustomerRes) ProtoMessage() {
 }
 
 func (x *UpdateCustomerRes) ProtoReflect() protoreflect.Message {
-	mi := &fileCustomerCustomerProtoMsgTypes[6]
+	mi := &fileCustomerCustomerProtoMsgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +640,7 @@ func (x *UpdateCustomerRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCustomerRes.ProtoReflect.Descriptor instead.
 func (*UpdateCustomerRes) Descriptor() ([]byte, []int) {
-	return fileCustomerCustomerProtoRawDescGZIP(), []int{6}
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{8}
 }
 
 func (x *UpdateCustomerRes) GetIsSuccess() bool {
@@ -449,7 +662,7 @@ func (x *UpdateCustomerPointsReq) Reset() {
 	*x = UpdateCustomerPointsReq{
 		// NOSONAR : Auto-generated function, intentionally left blank
 	}
-	mi := &fileCustomerCustomerProtoMsgTypes[7]
+	mi := &fileCustomerCustomerProtoMsgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -463,7 +676,7 @@ func (*UpdateCustomerPointsReq) ProtoMessage() {
 }
 
 func (x *UpdateCustomerPointsReq) ProtoReflect() protoreflect.Message {
-	mi := &fileCustomerCustomerProtoMsgTypes[7]
+	mi := &fileCustomerCustomerProtoMsgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -476,7 +689,7 @@ func (x *UpdateCustomerPointsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCustomerPointsReq.ProtoReflect.Descriptor instead.
 func (*UpdateCustomerPointsReq) Descriptor() ([]byte, []int) {
-	return fileCustomerCustomerProtoRawDescGZIP(), []int{7}
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{9}
 }
 
 func (x *UpdateCustomerPointsReq) GetId() int32 {
@@ -504,7 +717,7 @@ func (x *UpdateCustomerPointsRes) Reset() {
 	*x = UpdateCustomerPointsRes{
 		// NOSONAR : Auto-generated function, intentionally left blank
 	}
-	mi := &fileCustomerCustomerProtoMsgTypes[8]
+	mi := &fileCustomerCustomerProtoMsgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -518,7 +731,7 @@ func (*UpdateCustomerPointsRes) ProtoMessage() {
 }
 
 func (x *UpdateCustomerPointsRes) ProtoReflect() protoreflect.Message {
-	mi := &fileCustomerCustomerProtoMsgTypes[8]
+	mi := &fileCustomerCustomerProtoMsgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +744,7 @@ func (x *UpdateCustomerPointsRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCustomerPointsRes.ProtoReflect.Descriptor instead.
 func (*UpdateCustomerPointsRes) Descriptor() ([]byte, []int) {
-	return fileCustomerCustomerProtoRawDescGZIP(), []int{8}
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{10}
 }
 
 func (x *UpdateCustomerPointsRes) GetIsSuccess() bool {
@@ -541,83 +754,585 @@ func (x *UpdateCustomerPointsRes) GetIsSuccess() bool {
 	return false
 }
 
+type ReferralReportReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferralReportReq) Reset() {
+	*x = ReferralReportReq{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileCustomerCustomerProtoMsgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferralReportReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferralReportReq) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *ReferralReportReq) ProtoReflect() protoreflect.Message {
+	mi := &fileCustomerCustomerProtoMsgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferralReportReq.ProtoReflect.Descriptor instead.
+func (*ReferralReportReq) Descriptor() ([]byte, []int) {
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{11}
+}
+
+type ReferralReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int32                  `protobuf:"varint,1,opt,name=customerId,proto3" json:"customerId,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	ReferralCode  string                 `protobuf:"bytes,4,opt,name=referralCode,proto3" json:"referralCode,omitempty"`
+	ReferralCount int64                  `protobuf:"varint,5,opt,name=referralCount,proto3" json:"referralCount,omitempty"`
+	RewardedCount int64                  `protobuf:"varint,6,opt,name=rewardedCount,proto3" json:"rewardedCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferralReport) Reset() {
+	*x = ReferralReport{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileCustomerCustomerProtoMsgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferralReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferralReport) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *ReferralReport) ProtoReflect() protoreflect.Message {
+	mi := &fileCustomerCustomerProtoMsgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferralReport.ProtoReflect.Descriptor instead.
+func (*ReferralReport) Descriptor() ([]byte, []int) {
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{12}
+}
+
+func (x *ReferralReport) GetCustomerId() int32 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *ReferralReport) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *ReferralReport) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ReferralReport) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+func (x *ReferralReport) GetReferralCount() int64 {
+	if x != nil {
+		return x.ReferralCount
+	}
+	return 0
+}
+
+func (x *ReferralReport) GetRewardedCount() int64 {
+	if x != nil {
+		return x.RewardedCount
+	}
+	return 0
+}
+
+type ReferralReportRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ReferralReport      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferralReportRes) Reset() {
+	*x = ReferralReportRes{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileCustomerCustomerProtoMsgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferralReportRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferralReportRes) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *ReferralReportRes) ProtoReflect() protoreflect.Message {
+	mi := &fileCustomerCustomerProtoMsgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferralReportRes.ProtoReflect.Descriptor instead.
+func (*ReferralReportRes) Descriptor() ([]byte, []int) {
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{13}
+}
+
+func (x *ReferralReportRes) GetData() []*ReferralReport {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeactivateCustomerReq struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Deprecated: ignored for authenticated callers, whose identity is recorded instead.
+	//
+	// Deprecated: Marked as deprecated in customer/customer.proto.
+	ModifiedBy    string `protobuf:"bytes,3,opt,name=modifiedBy,proto3" json:"modifiedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateCustomerReq) Reset() {
+	*x = DeactivateCustomerReq{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileCustomerCustomerProtoMsgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateCustomerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateCustomerReq) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *DeactivateCustomerReq) ProtoReflect() protoreflect.Message {
+	mi := &fileCustomerCustomerProtoMsgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateCustomerReq.ProtoReflect.Descriptor instead.
+func (*DeactivateCustomerReq) Descriptor() ([]byte, []int) {
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{14}
+}
+
+func (x *DeactivateCustomerReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeactivateCustomerReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in customer/customer.proto.
+func (x *DeactivateCustomerReq) GetModifiedBy() string {
+	if x != nil {
+		return x.ModifiedBy
+	}
+	return ""
+}
+
+type DeactivateCustomerRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess     bool                   `protobuf:"varint,1,opt,name=isSuccess,proto3" json:"isSuccess,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateCustomerRes) Reset() {
+	*x = DeactivateCustomerRes{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileCustomerCustomerProtoMsgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateCustomerRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateCustomerRes) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *DeactivateCustomerRes) ProtoReflect() protoreflect.Message {
+	mi := &fileCustomerCustomerProtoMsgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateCustomerRes.ProtoReflect.Descriptor instead.
+func (*DeactivateCustomerRes) Descriptor() ([]byte, []int) {
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{15}
+}
+
+func (x *DeactivateCustomerRes) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
+type ReactivateCustomerReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: ignored for authenticated callers, whose identity is recorded instead.
+	//
+	// Deprecated: Marked as deprecated in customer/customer.proto.
+	ModifiedBy    string `protobuf:"bytes,2,opt,name=modifiedBy,proto3" json:"modifiedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateCustomerReq) Reset() {
+	*x = ReactivateCustomerReq{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileCustomerCustomerProtoMsgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateCustomerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateCustomerReq) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *ReactivateCustomerReq) ProtoReflect() protoreflect.Message {
+	mi := &fileCustomerCustomerProtoMsgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateCustomerReq.ProtoReflect.Descriptor instead.
+func (*ReactivateCustomerReq) Descriptor() ([]byte, []int) {
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{16}
+}
+
+func (x *ReactivateCustomerReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in customer/customer.proto.
+func (x *ReactivateCustomerReq) GetModifiedBy() string {
+	if x != nil {
+		return x.ModifiedBy
+	}
+	return ""
+}
+
+type ReactivateCustomerRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess     bool                   `protobuf:"varint,1,opt,name=isSuccess,proto3" json:"isSuccess,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateCustomerRes) Reset() {
+	*x = ReactivateCustomerRes{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
+	mi := &fileCustomerCustomerProtoMsgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateCustomerRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateCustomerRes) ProtoMessage() {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}
+
+func (x *ReactivateCustomerRes) ProtoReflect() protoreflect.Message {
+	mi := &fileCustomerCustomerProtoMsgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateCustomerRes.ProtoReflect.Descriptor instead.
+func (*ReactivateCustomerRes) Descriptor() ([]byte, []int) {
+	return fileCustomerCustomerProtoRawDescGZIP(), []int{17}
+}
+
+func (x *ReactivateCustomerRes) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
 var FileCustomerCustomerProto protoreflect.FileDescriptor
 
 var fileCustomerCustomerProtoRawDesc = string([]byte{
 	0x0a, 0x17, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f,
 	0x6d, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
+	0x6d, 0x65, 0x72, 0x1a, 0x1d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
 	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c,
 	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c,
 	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
 	0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x22, 0x31, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x08, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x44,
-	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x09, 0x69, 0x73,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x22, 0x39, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75,
+	0x74, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0xf7, 0x02, 0x0a, 0x08, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b,
+	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x21, 0x0a, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x42, 0x79, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02,
+	0x52, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x42, 0x79, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69,
+	0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x42, 0x79, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7f, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75,
 	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74,
 	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
 	0x65, 0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x6d, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x22, 0x31, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x22, 0x41, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x32,
-	0xcd, 0x02, 0x0a, 0x0f, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x61, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x11, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x81,
+	0x02, 0x0a, 0x11, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x28,
+	0x0a, 0x0f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x65,
+	0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12,
+	0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x31, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x41, 0x0a, 0x17, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x17,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61,
+	0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x22, 0xd2, 0x01, 0x0a, 0x0e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x41, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x63, 0x0a, 0x15, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x35, 0x0a, 0x15, 0x44, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x4b,
+	0x0a, 0x15, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x42, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x35, 0x0a, 0x15, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x32, 0x95, 0x05, 0x0a, 0x0f, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
 	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12,
-	0x44, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12,
-	0x19, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x12, 0x5c, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x42,
-	0x2c, 0x5a, 0x2a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2d, 0x76, 0x6f, 0x75, 0x63,
-	0x68, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x12, 0x44, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x12, 0x19, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x12, 0x5c, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x56,
+	0x0a, 0x12, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e,
+	0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x4a,
+	0x0a, 0x0e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x1b, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61,
+	0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x42, 0x2c, 0x5a, 0x2a, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2d, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -632,33 +1347,54 @@ func fileCustomerCustomerProtoRawDescGZIP() []byte {
 	return fileCustomerCustomerProtoRawDescData
 }
 
-var fileCustomerCustomerProtoMsgTypes = make([]protoimpl.MessageInfo, 9)
+var fileCustomerCustomerProtoMsgTypes = make([]protoimpl.MessageInfo, 18)
 var fileCustomerCustomerProtoGoTypes = []any{
 	(*CreateCustomerReq)(nil),       // 0: customer.CreateCustomerReq
 	(*CreateCustomerRes)(nil),       // 1: customer.CreateCustomerRes
 	(*Customer)(nil),                // 2: customer.Customer
 	(*ListCustomerReq)(nil),         // 3: customer.ListCustomerReq
 	(*ListCustomerRes)(nil),         // 4: customer.ListCustomerRes
-	(*UpdateCustomerReq)(nil),       // 5: customer.UpdateCustomerReq
-	(*UpdateCustomerRes)(nil),       // 6: customer.UpdateCustomerRes
-	(*UpdateCustomerPointsReq)(nil), // 7: customer.UpdateCustomerPointsReq
-	(*UpdateCustomerPointsRes)(nil), // 8: customer.UpdateCustomerPointsRes
+	(*DetailCustomerReq)(nil),       // 5: customer.DetailCustomerReq
+	(*DetailCustomerRes)(nil),       // 6: customer.DetailCustomerRes
+	(*UpdateCustomerReq)(nil),       // 7: customer.UpdateCustomerReq
+	(*UpdateCustomerRes)(nil),       // 8: customer.UpdateCustomerRes
+	(*UpdateCustomerPointsReq)(nil), // 9: customer.UpdateCustomerPointsReq
+	(*UpdateCustomerPointsRes)(nil), // 10: customer.UpdateCustomerPointsRes
+	(*ReferralReportReq)(nil),       // 11: customer.ReferralReportReq
+	(*ReferralReport)(nil),          // 12: customer.ReferralReport
+	(*ReferralReportRes)(nil),       // 13: customer.ReferralReportRes
+	(*DeactivateCustomerReq)(nil),   // 14: customer.DeactivateCustomerReq
+	(*DeactivateCustomerRes)(nil),   // 15: customer.DeactivateCustomerRes
+	(*ReactivateCustomerReq)(nil),   // 16: customer.ReactivateCustomerReq
+	(*ReactivateCustomerRes)(nil),   // 17: customer.ReactivateCustomerRes
+	(*transaction.Transaction)(nil), // 18: transaction.Transaction
 }
 var fileCustomerCustomerProtoDepIdxs = []int32{
-	2, // 0: customer.ListCustomerRes.data:typeName -> customer.Customer
-	0, // 1: customer.CustomerService.CreateCustomer:inputType -> customer.CreateCustomerReq
-	3, // 2: customer.CustomerService.ListCustomer:inputType -> customer.ListCustomerReq
-	5, // 3: customer.CustomerService.UpdateCustomer:inputType -> customer.UpdateCustomerReq
-	7, // 4: customer.CustomerService.UpdateCustomerPoints:inputType -> customer.UpdateCustomerPointsReq
-	1, // 5: customer.CustomerService.CreateCustomer:outputType -> customer.CreateCustomerRes
-	4, // 6: customer.CustomerService.ListCustomer:outputType -> customer.ListCustomerRes
-	6, // 7: customer.CustomerService.UpdateCustomer:outputType -> customer.UpdateCustomerRes
-	8, // 8: customer.CustomerService.UpdateCustomerPoints:outputType -> customer.UpdateCustomerPointsRes
-	5, // [5:9] is the sub-list for method outputType
-	1, // [1:5] is the sub-list for method inputType
-	1, // [1:1] is the sub-list for extension typeName
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field typeName
+	2,  // 0: customer.ListCustomerRes.data:typeName -> customer.Customer
+	2,  // 1: customer.DetailCustomerRes.data:typeName -> customer.Customer
+	18, // 2: customer.DetailCustomerRes.recentTransactions:typeName -> transaction.Transaction
+	12, // 3: customer.ReferralReportRes.data:typeName -> customer.ReferralReport
+	0,  // 4: customer.CustomerService.CreateCustomer:inputType -> customer.CreateCustomerReq
+	3,  // 5: customer.CustomerService.ListCustomer:inputType -> customer.ListCustomerReq
+	5,  // 6: customer.CustomerService.DetailCustomer:inputType -> customer.DetailCustomerReq
+	7,  // 7: customer.CustomerService.UpdateCustomer:inputType -> customer.UpdateCustomerReq
+	9,  // 8: customer.CustomerService.UpdateCustomerPoints:inputType -> customer.UpdateCustomerPointsReq
+	14, // 9: customer.CustomerService.DeactivateCustomer:inputType -> customer.DeactivateCustomerReq
+	16, // 10: customer.CustomerService.ReactivateCustomer:inputType -> customer.ReactivateCustomerReq
+	11, // 11: customer.CustomerService.ReferralReport:inputType -> customer.ReferralReportReq
+	1,  // 12: customer.CustomerService.CreateCustomer:outputType -> customer.CreateCustomerRes
+	4,  // 13: customer.CustomerService.ListCustomer:outputType -> customer.ListCustomerRes
+	6,  // 14: customer.CustomerService.DetailCustomer:outputType -> customer.DetailCustomerRes
+	8,  // 15: customer.CustomerService.UpdateCustomer:outputType -> customer.UpdateCustomerRes
+	10, // 16: customer.CustomerService.UpdateCustomerPoints:outputType -> customer.UpdateCustomerPointsRes
+	15, // 17: customer.CustomerService.DeactivateCustomer:outputType -> customer.DeactivateCustomerRes
+	17, // 18: customer.CustomerService.ReactivateCustomer:outputType -> customer.ReactivateCustomerRes
+	13, // 19: customer.CustomerService.ReferralReport:outputType -> customer.ReferralReportRes
+	12, // [12:20] is the sub-list for method outputType
+	4,  // [4:12] is the sub-list for method inputType
+	4,  // [4:4] is the sub-list for extension typeName
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field typeName
 }
 
 func init() { fileCustomerCustomerProtoInit() }
@@ -666,6 +1402,9 @@ func fileCustomerCustomerProtoInit() {
 	if FileCustomerCustomerProto != nil {
 		return
 	}
+	fileCustomerCustomerProtoMsgTypes[0].OneofWrappers = []any{
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}
 	fileCustomerCustomerProtoMsgTypes[2].OneofWrappers = []any{
 		// NOSONAR : Auto-generated function, intentionally left blank
 	}
@@ -679,7 +1418,7 @@ func fileCustomerCustomerProtoInit() {
 			}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(fileCustomerCustomerProtoRawDesc), len(fileCustomerCustomerProtoRawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,8 +21,12 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	CustomerServiceCreateCustomerFullMethodName       = "/customer.CustomerService/CreateCustomer"
 	CustomerServiceListCustomerFullMethodName         = "/customer.CustomerService/ListCustomer"
+	CustomerServiceDetailCustomerFullMethodName       = "/customer.CustomerService/DetailCustomer"
 	CustomerServiceUpdateCustomerFullMethodName       = "/customer.CustomerService/UpdateCustomer"
 	CustomerServiceUpdateCustomerPointsFullMethodName = "/customer.CustomerService/UpdateCustomerPoints"
+	CustomerServiceDeactivateCustomerFullMethodName   = "/customer.CustomerService/DeactivateCustomer"
+	CustomerServiceReactivateCustomerFullMethodName   = "/customer.CustomerService/ReactivateCustomer"
+	CustomerServiceReferralReportFullMethodName       = "/customer.CustomerService/ReferralReport"
 )
 
 // CustomerServiceClient is the client API for CustomerService service.
@@ -31,8 +35,12 @@ const (
 type CustomerServiceClient interface {
 	CreateCustomer(ctx context.Context, in *CreateCustomerReq, opts ...grpc.CallOption) (*CreateCustomerRes, error)
 	ListCustomer(ctx context.Context, in *ListCustomerReq, opts ...grpc.CallOption) (*ListCustomerRes, error)
+	DetailCustomer(ctx context.Context, in *DetailCustomerReq, opts ...grpc.CallOption) (*DetailCustomerRes, error)
 	UpdateCustomer(ctx context.Context, in *UpdateCustomerReq, opts ...grpc.CallOption) (*UpdateCustomerRes, error)
 	UpdateCustomerPoints(ctx context.Context, in *UpdateCustomerPointsReq, opts ...grpc.CallOption) (*UpdateCustomerPointsRes, error)
+	DeactivateCustomer(ctx context.Context, in *DeactivateCustomerReq, opts ...grpc.CallOption) (*DeactivateCustomerRes, error)
+	ReactivateCustomer(ctx context.Context, in *ReactivateCustomerReq, opts ...grpc.CallOption) (*ReactivateCustomerRes, error)
+	ReferralReport(ctx context.Context, in *ReferralReportReq, opts ...grpc.CallOption) (*ReferralReportRes, error)
 }
 
 type customerServiceClient struct {
@@ -63,6 +71,16 @@ func (c *customerServiceClient) ListCustomer(ctx context.Context, in *ListCustom
 	return out, nil
 }
 
+func (c *customerServiceClient) DetailCustomer(ctx context.Context, in *DetailCustomerReq, opts ...grpc.CallOption) (*DetailCustomerRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetailCustomerRes)
+	err := c.cc.Invoke(ctx, CustomerServiceDetailCustomerFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *customerServiceClient) UpdateCustomer(ctx context.Context, in *UpdateCustomerReq, opts ...grpc.CallOption) (*UpdateCustomerRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateCustomerRes)
@@ -83,14 +101,48 @@ func (c *customerServiceClient) UpdateCustomerPoints(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *customerServiceClient) DeactivateCustomer(ctx context.Context, in *DeactivateCustomerReq, opts ...grpc.CallOption) (*DeactivateCustomerRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateCustomerRes)
+	err := c.cc.Invoke(ctx, CustomerServiceDeactivateCustomerFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ReactivateCustomer(ctx context.Context, in *ReactivateCustomerReq, opts ...grpc.CallOption) (*ReactivateCustomerRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateCustomerRes)
+	err := c.cc.Invoke(ctx, CustomerServiceReactivateCustomerFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ReferralReport(ctx context.Context, in *ReferralReportReq, opts ...grpc.CallOption) (*ReferralReportRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReferralReportRes)
+	err := c.cc.Invoke(ctx, CustomerServiceReferralReportFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CustomerServiceServer is the server API for CustomerService service.
 // All implementations must embed UnimplementedCustomerServiceServer
 // for forward compatibility.
 type CustomerServiceServer interface {
 	CreateCustomer(context.Context, *CreateCustomerReq) (*CreateCustomerRes, error)
 	ListCustomer(context.Context, *ListCustomerReq) (*ListCustomerRes, error)
+	DetailCustomer(context.Context, *DetailCustomerReq) (*DetailCustomerRes, error)
 	UpdateCustomer(context.Context, *UpdateCustomerReq) (*UpdateCustomerRes, error)
 	UpdateCustomerPoints(context.Context, *UpdateCustomerPointsReq) (*UpdateCustomerPointsRes, error)
+	DeactivateCustomer(context.Context, *DeactivateCustomerReq) (*DeactivateCustomerRes, error)
+	ReactivateCustomer(context.Context, *ReactivateCustomerReq) (*ReactivateCustomerRes, error)
+	ReferralReport(context.Context, *ReferralReportReq) (*ReferralReportRes, error)
 	mustEmbedUnimplementedCustomerServiceServer()
 }
 
@@ -109,12 +161,24 @@ func (UnimplementedCustomerServiceServer) CreateCustomer(context.Context, *Creat
 func (UnimplementedCustomerServiceServer) ListCustomer(context.Context, *ListCustomerReq) (*ListCustomerRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCustomer not implemented")
 }
+func (UnimplementedCustomerServiceServer) DetailCustomer(context.Context, *DetailCustomerReq) (*DetailCustomerRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetailCustomer not implemented")
+}
 func (UnimplementedCustomerServiceServer) UpdateCustomer(context.Context, *UpdateCustomerReq) (*UpdateCustomerRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomer not implemented")
 }
 func (UnimplementedCustomerServiceServer) UpdateCustomerPoints(context.Context, *UpdateCustomerPointsReq) (*UpdateCustomerPointsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomerPoints not implemented")
 }
+func (UnimplementedCustomerServiceServer) DeactivateCustomer(context.Context, *DeactivateCustomerReq) (*DeactivateCustomerRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) ReactivateCustomer(context.Context, *ReactivateCustomerReq) (*ReactivateCustomerRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) ReferralReport(context.Context, *ReferralReportReq) (*ReferralReportRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferralReport not implemented")
+}
 func (UnimplementedCustomerServiceServer) mustEmbedUnimplementedCustomerServiceServer() {
 	// NOSONAR : Auto-generated function, intentionally left blank
 }
@@ -196,6 +260,34 @@ func CustomerServiceListCustomerHandler(srv interface {
 	return interceptor(ctx, in, info, handler)
 }
 
+func CustomerServiceDetailCustomerHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(DetailCustomerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).DetailCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerServiceDetailCustomerFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(CustomerServiceServer).DetailCustomer(ctx, req.(*DetailCustomerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func CustomerServiceUpdateCustomerHandler(srv interface {
 	// NOSONAR : Auto-generated function, intentionally left blank
 }, ctx context.Context, dec func(interface {
@@ -252,6 +344,90 @@ func CustomerServiceUpdateCustomerPointsHandler(srv interface {
 	return interceptor(ctx, in, info, handler)
 }
 
+func CustomerServiceDeactivateCustomerHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(DeactivateCustomerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).DeactivateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerServiceDeactivateCustomerFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(CustomerServiceServer).DeactivateCustomer(ctx, req.(*DeactivateCustomerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func CustomerServiceReactivateCustomerHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(ReactivateCustomerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ReactivateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerServiceReactivateCustomerFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(CustomerServiceServer).ReactivateCustomer(ctx, req.(*ReactivateCustomerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func CustomerServiceReferralReportHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(ReferralReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ReferralReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerServiceReferralReportFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(CustomerServiceServer).ReferralReport(ctx, req.(*ReferralReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CustomerServiceServiceDesc is the grpc.ServiceDesc for CustomerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +443,10 @@ var CustomerServiceServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCustomer",
 			Handler:    CustomerServiceListCustomerHandler,
 		},
+		{
+			MethodName: "DetailCustomer",
+			Handler:    CustomerServiceDetailCustomerHandler,
+		},
 		{
 			MethodName: "UpdateCustomer",
 			Handler:    CustomerServiceUpdateCustomerHandler,
@@ -275,6 +455,18 @@ var CustomerServiceServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCustomerPoints",
 			Handler:    CustomerServiceUpdateCustomerPointsHandler,
 		},
+		{
+			MethodName: "DeactivateCustomer",
+			Handler:    CustomerServiceDeactivateCustomerHandler,
+		},
+		{
+			MethodName: "ReactivateCustomer",
+			Handler:    CustomerServiceReactivateCustomerHandler,
+		},
+		{
+			MethodName: "ReferralReport",
+			Handler:    CustomerServiceReferralReportHandler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		// NOSONAR : Auto-generated function, intentionally left blank
@@ -22,6 +22,10 @@ const (
 	TransactionServiceTransactionRedeemPointFullMethodName = "/transaction.TransactionService/TransactionRedeemPoint"
 	TransactionServiceListTransactionFullMethodName        = "/transaction.TransactionService/ListTransaction"
 	TransactionServiceDetailTransactionFullMethodName      = "/transaction.TransactionService/DetailTransaction"
+	TransactionServiceGiftVoucherFullMethodName            = "/transaction.TransactionService/GiftVoucher"
+	TransactionServiceClaimGiftVoucherFullMethodName       = "/transaction.TransactionService/ClaimGiftVoucher"
+	TransactionServiceListFraudReviewFullMethodName        = "/transaction.TransactionService/ListFraudReview"
+	TransactionServiceReviewTransactionFullMethodName      = "/transaction.TransactionService/ReviewTransaction"
 )
 
 // TransactionServiceClient is the client API for TransactionService service.
@@ -31,6 +35,10 @@ type TransactionServiceClient interface {
 	TransactionRedeemPoint(ctx context.Context, in *TransactionRedeemPointReq, opts ...grpc.CallOption) (*TransactionRedeemPointRes, error)
 	ListTransaction(ctx context.Context, in *ListTransactionReq, opts ...grpc.CallOption) (*ListTransactionRes, error)
 	DetailTransaction(ctx context.Context, in *DetailTransactionReq, opts ...grpc.CallOption) (*DetailTransactionRes, error)
+	GiftVoucher(ctx context.Context, in *GiftVoucherReq, opts ...grpc.CallOption) (*GiftVoucherRes, error)
+	ClaimGiftVoucher(ctx context.Context, in *ClaimGiftVoucherReq, opts ...grpc.CallOption) (*ClaimGiftVoucherRes, error)
+	ListFraudReview(ctx context.Context, in *ListFraudReviewReq, opts ...grpc.CallOption) (*ListFraudReviewRes, error)
+	ReviewTransaction(ctx context.Context, in *ReviewTransactionReq, opts ...grpc.CallOption) (*ReviewTransactionRes, error)
 }
 
 type transactionServiceClient struct {
@@ -71,6 +79,46 @@ func (c *transactionServiceClient) DetailTransaction(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *transactionServiceClient) GiftVoucher(ctx context.Context, in *GiftVoucherReq, opts ...grpc.CallOption) (*GiftVoucherRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GiftVoucherRes)
+	err := c.cc.Invoke(ctx, TransactionServiceGiftVoucherFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ClaimGiftVoucher(ctx context.Context, in *ClaimGiftVoucherReq, opts ...grpc.CallOption) (*ClaimGiftVoucherRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimGiftVoucherRes)
+	err := c.cc.Invoke(ctx, TransactionServiceClaimGiftVoucherFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListFraudReview(ctx context.Context, in *ListFraudReviewReq, opts ...grpc.CallOption) (*ListFraudReviewRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFraudReviewRes)
+	err := c.cc.Invoke(ctx, TransactionServiceListFraudReviewFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ReviewTransaction(ctx context.Context, in *ReviewTransactionReq, opts ...grpc.CallOption) (*ReviewTransactionRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewTransactionRes)
+	err := c.cc.Invoke(ctx, TransactionServiceReviewTransactionFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServiceServer is the server API for TransactionService service.
 // All implementations must embed UnimplementedTransactionServiceServer
 // for forward compatibility.
@@ -78,6 +126,10 @@ type TransactionServiceServer interface {
 	TransactionRedeemPoint(context.Context, *TransactionRedeemPointReq) (*TransactionRedeemPointRes, error)
 	ListTransaction(context.Context, *ListTransactionReq) (*ListTransactionRes, error)
 	DetailTransaction(context.Context, *DetailTransactionReq) (*DetailTransactionRes, error)
+	GiftVoucher(context.Context, *GiftVoucherReq) (*GiftVoucherRes, error)
+	ClaimGiftVoucher(context.Context, *ClaimGiftVoucherReq) (*ClaimGiftVoucherRes, error)
+	ListFraudReview(context.Context, *ListFraudReviewReq) (*ListFraudReviewRes, error)
+	ReviewTransaction(context.Context, *ReviewTransactionReq) (*ReviewTransactionRes, error)
 	mustEmbedUnimplementedTransactionServiceServer()
 }
 
@@ -99,6 +151,18 @@ func (UnimplementedTransactionServiceServer) ListTransaction(context.Context, *L
 func (UnimplementedTransactionServiceServer) DetailTransaction(context.Context, *DetailTransactionReq) (*DetailTransactionRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DetailTransaction not implemented")
 }
+func (UnimplementedTransactionServiceServer) GiftVoucher(context.Context, *GiftVoucherReq) (*GiftVoucherRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GiftVoucher not implemented")
+}
+func (UnimplementedTransactionServiceServer) ClaimGiftVoucher(context.Context, *ClaimGiftVoucherReq) (*ClaimGiftVoucherRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimGiftVoucher not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListFraudReview(context.Context, *ListFraudReviewReq) (*ListFraudReviewRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFraudReview not implemented")
+}
+func (UnimplementedTransactionServiceServer) ReviewTransaction(context.Context, *ReviewTransactionReq) (*ReviewTransactionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewTransaction not implemented")
+}
 func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {
 	// NOSONAR : Auto-generated function, intentionally left blank
 }
@@ -208,6 +272,118 @@ func TransactionServiceDetailTransactionHandler(srv interface {
 	return interceptor(ctx, in, info, handler)
 }
 
+func TransactionServiceGiftVoucherHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(GiftVoucherReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GiftVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionServiceGiftVoucherFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(TransactionServiceServer).GiftVoucher(ctx, req.(*GiftVoucherReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func TransactionServiceClaimGiftVoucherHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(ClaimGiftVoucherReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ClaimGiftVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionServiceClaimGiftVoucherFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(TransactionServiceServer).ClaimGiftVoucher(ctx, req.(*ClaimGiftVoucherReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func TransactionServiceListFraudReviewHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(ListFraudReviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListFraudReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionServiceListFraudReviewFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(TransactionServiceServer).ListFraudReview(ctx, req.(*ListFraudReviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func TransactionServiceReviewTransactionHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(ReviewTransactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ReviewTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionServiceReviewTransactionFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(TransactionServiceServer).ReviewTransaction(ctx, req.(*ReviewTransactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransactionServiceServiceDesc is the grpc.ServiceDesc for TransactionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -227,6 +403,22 @@ var TransactionServiceServiceDesc = grpc.ServiceDesc{
 			MethodName: "DetailTransaction",
 			Handler:    TransactionServiceDetailTransactionHandler,
 		},
+		{
+			MethodName: "GiftVoucher",
+			Handler:    TransactionServiceGiftVoucherHandler,
+		},
+		{
+			MethodName: "ClaimGiftVoucher",
+			Handler:    TransactionServiceClaimGiftVoucherHandler,
+		},
+		{
+			MethodName: "ListFraudReview",
+			Handler:    TransactionServiceListFraudReviewHandler,
+		},
+		{
+			MethodName: "ReviewTransaction",
+			Handler:    TransactionServiceReviewTransactionHandler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		// NOSONAR : Auto-generated function, intentionally left blank
@@ -19,10 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VoucherServiceCreateVoucherFullMethodName = "/voucher.VoucherService/CreateVoucher"
-	VoucherServiceListVoucherFullMethodName   = "/voucher.VoucherService/ListVoucher"
-	VoucherServiceDetailVoucherFullMethodName = "/voucher.VoucherService/DetailVoucher"
-	VoucherServiceUpdateVoucherFullMethodName = "/voucher.VoucherService/UpdateVoucher"
+	VoucherServiceCreateVoucherFullMethodName  = "/voucher.VoucherService/CreateVoucher"
+	VoucherServiceListVoucherFullMethodName    = "/voucher.VoucherService/ListVoucher"
+	VoucherServiceDetailVoucherFullMethodName  = "/voucher.VoucherService/DetailVoucher"
+	VoucherServiceUpdateVoucherFullMethodName  = "/voucher.VoucherService/UpdateVoucher"
+	VoucherServiceDeleteVoucherFullMethodName  = "/voucher.VoucherService/DeleteVoucher"
+	VoucherServiceRestoreVoucherFullMethodName = "/voucher.VoucherService/RestoreVoucher"
+	VoucherServiceSetVoucherTagsFullMethodName = "/voucher.VoucherService/SetVoucherTags"
+	VoucherServiceListTagFullMethodName        = "/voucher.VoucherService/ListTag"
+	VoucherServiceDeleteTagFullMethodName      = "/voucher.VoucherService/DeleteTag"
 )
 
 // VoucherServiceClient is the client API for VoucherService service.
@@ -33,6 +38,11 @@ type VoucherServiceClient interface {
 	ListVoucher(ctx context.Context, in *ListVoucherReq, opts ...grpc.CallOption) (*ListVoucherRes, error)
 	DetailVoucher(ctx context.Context, in *DetailVoucherReq, opts ...grpc.CallOption) (*DetailVoucherRes, error)
 	UpdateVoucher(ctx context.Context, in *UpdateVoucherReq, opts ...grpc.CallOption) (*UpdateVoucherRes, error)
+	DeleteVoucher(ctx context.Context, in *DeleteVoucherReq, opts ...grpc.CallOption) (*DeleteVoucherRes, error)
+	RestoreVoucher(ctx context.Context, in *RestoreVoucherReq, opts ...grpc.CallOption) (*RestoreVoucherRes, error)
+	SetVoucherTags(ctx context.Context, in *SetVoucherTagsReq, opts ...grpc.CallOption) (*SetVoucherTagsRes, error)
+	ListTag(ctx context.Context, in *ListTagReq, opts ...grpc.CallOption) (*ListTagRes, error)
+	DeleteTag(ctx context.Context, in *DeleteTagReq, opts ...grpc.CallOption) (*DeleteTagRes, error)
 }
 
 type voucherServiceClient struct {
@@ -83,6 +93,56 @@ func (c *voucherServiceClient) UpdateVoucher(ctx context.Context, in *UpdateVouc
 	return out, nil
 }
 
+func (c *voucherServiceClient) DeleteVoucher(ctx context.Context, in *DeleteVoucherReq, opts ...grpc.CallOption) (*DeleteVoucherRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVoucherRes)
+	err := c.cc.Invoke(ctx, VoucherServiceDeleteVoucherFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voucherServiceClient) RestoreVoucher(ctx context.Context, in *RestoreVoucherReq, opts ...grpc.CallOption) (*RestoreVoucherRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreVoucherRes)
+	err := c.cc.Invoke(ctx, VoucherServiceRestoreVoucherFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voucherServiceClient) SetVoucherTags(ctx context.Context, in *SetVoucherTagsReq, opts ...grpc.CallOption) (*SetVoucherTagsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVoucherTagsRes)
+	err := c.cc.Invoke(ctx, VoucherServiceSetVoucherTagsFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voucherServiceClient) ListTag(ctx context.Context, in *ListTagReq, opts ...grpc.CallOption) (*ListTagRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagRes)
+	err := c.cc.Invoke(ctx, VoucherServiceListTagFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voucherServiceClient) DeleteTag(ctx context.Context, in *DeleteTagReq, opts ...grpc.CallOption) (*DeleteTagRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTagRes)
+	err := c.cc.Invoke(ctx, VoucherServiceDeleteTagFullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VoucherServiceServer is the server API for VoucherService service.
 // All implementations must embed UnimplementedVoucherServiceServer
 // for forward compatibility.
@@ -91,6 +151,11 @@ type VoucherServiceServer interface {
 	ListVoucher(context.Context, *ListVoucherReq) (*ListVoucherRes, error)
 	DetailVoucher(context.Context, *DetailVoucherReq) (*DetailVoucherRes, error)
 	UpdateVoucher(context.Context, *UpdateVoucherReq) (*UpdateVoucherRes, error)
+	DeleteVoucher(context.Context, *DeleteVoucherReq) (*DeleteVoucherRes, error)
+	RestoreVoucher(context.Context, *RestoreVoucherReq) (*RestoreVoucherRes, error)
+	SetVoucherTags(context.Context, *SetVoucherTagsReq) (*SetVoucherTagsRes, error)
+	ListTag(context.Context, *ListTagReq) (*ListTagRes, error)
+	DeleteTag(context.Context, *DeleteTagReq) (*DeleteTagRes, error)
 	mustEmbedUnimplementedVoucherServiceServer()
 }
 
@@ -115,6 +180,21 @@ func (UnimplementedVoucherServiceServer) DetailVoucher(context.Context, *DetailV
 func (UnimplementedVoucherServiceServer) UpdateVoucher(context.Context, *UpdateVoucherReq) (*UpdateVoucherRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateVoucher not implemented")
 }
+func (UnimplementedVoucherServiceServer) DeleteVoucher(context.Context, *DeleteVoucherReq) (*DeleteVoucherRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVoucher not implemented")
+}
+func (UnimplementedVoucherServiceServer) RestoreVoucher(context.Context, *RestoreVoucherReq) (*RestoreVoucherRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVoucher not implemented")
+}
+func (UnimplementedVoucherServiceServer) SetVoucherTags(context.Context, *SetVoucherTagsReq) (*SetVoucherTagsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVoucherTags not implemented")
+}
+func (UnimplementedVoucherServiceServer) ListTag(context.Context, *ListTagReq) (*ListTagRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTag not implemented")
+}
+func (UnimplementedVoucherServiceServer) DeleteTag(context.Context, *DeleteTagReq) (*DeleteTagRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
 func (UnimplementedVoucherServiceServer) mustEmbedUnimplementedVoucherServiceServer() {
 	// NOSONAR : Auto-generated function, intentionally left blank
 }
@@ -252,6 +332,146 @@ func VoucherServiceUpdateVoucherHandler(srv interface {
 	return interceptor(ctx, in, info, handler)
 }
 
+func VoucherServiceDeleteVoucherHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(DeleteVoucherReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoucherServiceServer).DeleteVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VoucherServiceDeleteVoucherFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(VoucherServiceServer).DeleteVoucher(ctx, req.(*DeleteVoucherReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func VoucherServiceRestoreVoucherHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(RestoreVoucherReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoucherServiceServer).RestoreVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VoucherServiceRestoreVoucherFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(VoucherServiceServer).RestoreVoucher(ctx, req.(*RestoreVoucherReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func VoucherServiceSetVoucherTagsHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(SetVoucherTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoucherServiceServer).SetVoucherTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VoucherServiceSetVoucherTagsFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(VoucherServiceServer).SetVoucherTags(ctx, req.(*SetVoucherTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func VoucherServiceListTagHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(ListTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoucherServiceServer).ListTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VoucherServiceListTagFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(VoucherServiceServer).ListTag(ctx, req.(*ListTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func VoucherServiceDeleteTagHandler(srv interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, ctx context.Context, dec func(interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}) error, interceptor grpc.UnaryServerInterceptor) (interface {
+	// NOSONAR : Auto-generated function, intentionally left blank
+}, error) {
+	in := new(DeleteTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoucherServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VoucherServiceDeleteTagFullMethodName,
+	}
+	handler := func(ctx context.Context, req interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}) (interface {
+		// NOSONAR : Auto-generated function, intentionally left blank
+	}, error) {
+		return srv.(VoucherServiceServer).DeleteTag(ctx, req.(*DeleteTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VoucherServiceServiceDesc is the grpc.ServiceDesc for VoucherService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -275,6 +495,26 @@ var VoucherServiceServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateVoucher",
 			Handler:    VoucherServiceUpdateVoucherHandler,
 		},
+		{
+			MethodName: "DeleteVoucher",
+			Handler:    VoucherServiceDeleteVoucherHandler,
+		},
+		{
+			MethodName: "RestoreVoucher",
+			Handler:    VoucherServiceRestoreVoucherHandler,
+		},
+		{
+			MethodName: "SetVoucherTags",
+			Handler:    VoucherServiceSetVoucherTagsHandler,
+		},
+		{
+			MethodName: "ListTag",
+			Handler:    VoucherServiceListTagHandler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    VoucherServiceDeleteTagHandler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		// NOSONAR : Auto-generated function, intentionally left blank
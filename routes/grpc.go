@@ -0,0 +1,153 @@
+package routes
+
+import (
+	"customer-voucher-service/constants"
+	"customer-voucher-service/middleware"
+	pbApiKey "customer-voucher-service/protogen/api_key"
+	pbAudit "customer-voucher-service/protogen/audit"
+	pbBrand "customer-voucher-service/protogen/brand"
+	pbCategory "customer-voucher-service/protogen/category"
+	pbCustomer "customer-voucher-service/protogen/customer"
+	pbSearch "customer-voucher-service/protogen/search"
+	pbTransaction "customer-voucher-service/protogen/transaction"
+	pbVoucher "customer-voucher-service/protogen/voucher"
+	"customer-voucher-service/services/api_key_service"
+	"customer-voucher-service/services/audit_service"
+	"customer-voucher-service/services/brand_service"
+	"customer-voucher-service/services/category_service"
+	"customer-voucher-service/services/customer_service"
+	"customer-voucher-service/services/search_service"
+	"customer-voucher-service/services/transaction_service"
+	"customer-voucher-service/services/voucher_service"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/env"
+	"customer-voucher-service/utils/rate_limit"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// GrpcConfig picks the interceptors, in the order they run, and their settings.
+type GrpcConfig struct {
+	Interceptors   []string
+	DefaultTimeout time.Duration
+	MaxTimeout     time.Duration
+	Logger         *slog.Logger
+	Metrics        *middleware.GrpcMetrics
+	// Health is served as grpc.health.v1.Health. Shutdown on it reports NOT_SERVING
+	// so that load balancers drain the instance before it stops.
+	Health *health.Server
+}
+
+// GrpcConfigFromEnv reads GRPC_INTERCEPTORS, a comma separated list of
+// constants.Interceptor* names, and the GRPC_*_TIMEOUT_SECONDS variables.
+func GrpcConfigFromEnv() GrpcConfig {
+	var interceptors []string
+	for _, name := range strings.Split(env.GetString("GRPC_INTERCEPTORS", constants.DefaultGrpcInterceptors), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			interceptors = append(interceptors, name)
+		}
+	}
+	return GrpcConfig{
+		Interceptors:   interceptors,
+		DefaultTimeout: time.Duration(env.GetInt64("GRPC_DEFAULT_TIMEOUT_SECONDS", constants.DefaultGrpcTimeoutSeconds)) * time.Second,
+		MaxTimeout:     time.Duration(env.GetInt64("GRPC_MAX_TIMEOUT_SECONDS", constants.DefaultGrpcMaxTimeoutSeconds)) * time.Second,
+		Logger:         slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+		Metrics:        middleware.NewGrpcMetrics(),
+		Health:         health.NewServer(),
+	}
+}
+
+// GrpcServer registers every service behind the same checks as ApiRoutes. Health and
+// reflection are registered too and need no credentials. The auth interceptor cannot
+// be left out, it covers API keys, tokens, permissions and rate limits.
+func GrpcServer(cfg GrpcConfig, verifier *auth.Verifier, apiKeys auth.ApiKeyAuthenticator, limiter *rate_limit.Limiter) (*grpc.Server, error) {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	if cfg.Metrics == nil {
+		cfg.Metrics = middleware.NewGrpcMetrics()
+	}
+	if cfg.Health == nil {
+		cfg.Health = health.NewServer()
+	}
+	available := map[string][]middleware.Interceptor{
+		constants.InterceptorRecovery: {middleware.RecoveryInterceptor()},
+		constants.InterceptorRequestID: {{
+			Unary:  middleware.UnaryRequestIDInterceptor(),
+			Stream: middleware.StreamRequestIDInterceptor(),
+		}},
+		constants.InterceptorLogging:  {middleware.LoggingInterceptor(cfg.Logger)},
+		constants.InterceptorMetrics:  {middleware.MetricsInterceptor(cfg.Metrics)},
+		constants.InterceptorErrors:   {middleware.ErrorInterceptor()},
+		constants.InterceptorDeadline: {middleware.DeadlineInterceptor(cfg.DefaultTimeout, cfg.MaxTimeout)},
+		constants.InterceptorAuth: {
+			{Unary: middleware.UnaryAuthFailureInterceptor(limiter), Stream: middleware.StreamAuthFailureInterceptor(limiter)},
+			{Unary: middleware.UnaryApiKeyInterceptor(apiKeys), Stream: middleware.StreamApiKeyInterceptor(apiKeys)},
+			{Unary: middleware.UnaryAuthInterceptor(verifier), Stream: middleware.StreamAuthInterceptor(verifier)},
+			{Unary: middleware.UnaryPermissionInterceptor(), Stream: middleware.StreamPermissionInterceptor()},
+			{Unary: middleware.UnaryRateLimitInterceptor(limiter), Stream: middleware.StreamRateLimitInterceptor(limiter)},
+		},
+		constants.InterceptorValidation: {middleware.ValidationInterceptor(
+			api_key_service.RequestRules,
+			audit_service.RequestRules,
+			brand_service.RequestRules,
+			category_service.RequestRules,
+			customer_service.RequestRules,
+			search_service.RequestRules,
+			transaction_service.RequestRules,
+			voucher_service.RequestRules,
+		)},
+	}
+
+	var unary []grpc.UnaryServerInterceptor
+	var stream []grpc.StreamServerInterceptor
+	seen := map[string]bool{}
+	for _, name := range cfg.Interceptors {
+		interceptors, ok := available[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown gRPC interceptor %q", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("gRPC interceptor %q is listed twice", name)
+		}
+		seen[name] = true
+		for _, interceptor := range interceptors {
+			unary = append(unary, interceptor.Unary)
+			stream = append(stream, interceptor.Stream)
+		}
+	}
+	if !seen[constants.InterceptorAuth] {
+		return nil, fmt.Errorf("gRPC interceptor %q is required", constants.InterceptorAuth)
+	}
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
+
+	pbApiKey.RegisterApiKeyServiceServer(server, api_key_service.NewApiKeyService())
+	pbAudit.RegisterAuditServiceServer(server, audit_service.NewAuditService())
+	pbBrand.RegisterBrandServiceServer(server, brand_service.NewBrandService())
+	pbCategory.RegisterCategoryServiceServer(server, category_service.NewCategoryService())
+	pbCustomer.RegisterCustomerServiceServer(server, customer_service.NewCustomerService())
+	pbSearch.RegisterSearchServiceServer(server, search_service.NewSearchService())
+	pbTransaction.RegisterTransactionServiceServer(server, transaction_service.NewTransactionService())
+	pbVoucher.RegisterVoucherServiceServer(server, voucher_service.NewVoucherService())
+
+	for service := range server.GetServiceInfo() {
+		cfg.Health.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+	cfg.Health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, cfg.Health)
+	reflection.Register(server)
+
+	return server, nil
+}
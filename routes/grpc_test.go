@@ -0,0 +1,193 @@
+package routes
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	pbBrand "customer-voucher-service/protogen/brand"
+	pbCustomer "customer-voucher-service/protogen/customer"
+	pbVoucher "customer-voucher-service/protogen/voucher"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/rate_limit"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type noApiKeys struct{}
+
+func (noApiKeys) AuthenticateApiKey(ctx context.Context, key string) (*auth.Caller, error) {
+	return nil, nil
+}
+
+func testGrpcConfig() GrpcConfig {
+	return GrpcConfig{
+		Interceptors:   strings.Split(constants.DefaultGrpcInterceptors, ","),
+		DefaultTimeout: time.Second,
+		MaxTimeout:     time.Minute,
+		Logger:         slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+var testSecret = []byte("test-secret")
+
+// adminContext carries the token of an admin, who may call every method.
+func adminContext(t *testing.T) context.Context {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, auth.Claims{
+		Role: constants.RoleAdmin,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "admin-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}).SignedString(testSecret)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+// fieldViolations reads the fields and rules of the BadRequest detail of err.
+func fieldViolations(t *testing.T, err error) map[string]string {
+	violations := map[string]string{}
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				violations[violation.GetField()] = violation.GetReason()
+			}
+		}
+	}
+	if len(violations) == 0 {
+		t.Fatalf("expected field violations in %v", err)
+	}
+	return violations
+}
+
+func dialTestServer(t *testing.T) *grpc.ClientConn {
+	return dialTestServerWithConfig(t, testGrpcConfig())
+}
+
+func dialTestServerWithConfig(t *testing.T, cfg GrpcConfig) *grpc.ClientConn {
+	verifier, err := auth.NewVerifier(auth.Config{Algorithm: auth.AlgorithmHMAC, Secret: testSecret})
+	if err != nil {
+		t.Fatalf("failed to create verifier: %v", err)
+	}
+	server, err := GrpcServer(cfg, verifier, noApiKeys{}, rate_limit.NewLimiter(rate_limit.NewMemoryStore(), nil))
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+	lis := bufconn.Listen(1024 * 1024)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestGrpcServer_HealthWithoutCredentials(t *testing.T) {
+	conn := dialTestServer(t)
+
+	for _, service := range []string{"", pbBrand.BrandServiceServiceDesc.ServiceName} {
+		res, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		assert.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+	}
+}
+
+func TestGrpcServer_HealthShutdown(t *testing.T) {
+	cfg := testGrpcConfig()
+	cfg.Health = health.NewServer()
+	conn := dialTestServerWithConfig(t, cfg)
+
+	cfg.Health.Shutdown()
+	for _, service := range []string{"", pbBrand.BrandServiceServiceDesc.ServiceName} {
+		res, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		assert.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, res.GetStatus())
+	}
+}
+
+func TestGrpcServer_ReflectionWithoutCredentials(t *testing.T) {
+	conn := dialTestServer(t)
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{}}))
+	res, err := stream.Recv()
+	assert.NoError(t, err)
+
+	services := []string{}
+	for _, service := range res.GetListServicesResponse().GetService() {
+		services = append(services, service.GetName())
+	}
+	assert.Contains(t, services, pbBrand.BrandServiceServiceDesc.ServiceName)
+	assert.Contains(t, services, "transaction.TransactionService")
+}
+
+func TestGrpcServer_ServicesRequireCredentials(t *testing.T) {
+	conn := dialTestServer(t)
+
+	_, err := pbBrand.NewBrandServiceClient(conn).ListBrand(context.Background(), &pbBrand.ListBrandReq{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestGrpcServer_InterceptorConfig(t *testing.T) {
+	limiter := rate_limit.NewLimiter(rate_limit.NewMemoryStore(), nil)
+
+	cfg := testGrpcConfig()
+	cfg.Interceptors = append(cfg.Interceptors, "tracing")
+	_, err := GrpcServer(cfg, nil, noApiKeys{}, limiter)
+	assert.ErrorContains(t, err, "tracing")
+
+	cfg.Interceptors = []string{constants.InterceptorRecovery, constants.InterceptorLogging}
+	_, err = GrpcServer(cfg, nil, noApiKeys{}, limiter)
+	assert.ErrorContains(t, err, constants.InterceptorAuth)
+
+	cfg.Interceptors = []string{constants.InterceptorAuth, constants.InterceptorRecovery}
+	_, err = GrpcServer(cfg, nil, noApiKeys{}, limiter)
+	assert.NoError(t, err)
+}
+
+func TestGrpcServer_ValidatesRequests(t *testing.T) {
+	conn := dialTestServer(t)
+	ctx := adminContext(t)
+
+	_, err := pbVoucher.NewVoucherServiceClient(conn).CreateVoucher(ctx, &pbVoucher.CreateVoucherReq{
+		BrandId:     1,
+		VoucherCode: "promo 10",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, map[string]string{
+		"name":        "required",
+		"costInPoint": "required",
+		"voucherCode": "vouchercode",
+	}, fieldViolations(t, err))
+
+	_, err = pbCustomer.NewCustomerServiceClient(conn).CreateCustomer(ctx, &pbCustomer.CreateCustomerReq{
+		FullName: "Jane",
+		Email:    "not-an-email",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, map[string]string{"email": "email"}, fieldViolations(t, err))
+}
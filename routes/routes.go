@@ -1,20 +1,41 @@
 package routes
 
 import (
+	"customer-voucher-service/constants"
+	"customer-voucher-service/handlers/api_key_handler"
+	"customer-voucher-service/handlers/audit_handler"
 	"customer-voucher-service/handlers/brand_handler"
+	"customer-voucher-service/handlers/category_handler"
 	"customer-voucher-service/handlers/customer_handler"
+	"customer-voucher-service/handlers/search_handler"
 	"customer-voucher-service/handlers/transaction_handler"
 	"customer-voucher-service/handlers/voucher_handler"
+	"customer-voucher-service/middleware"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/rate_limit"
+	"expvar"
 
 	"github.com/gin-gonic/gin"
 )
 
-func ApiRoutes(r *gin.Engine) {
-	api := r.Group("/api/v1")
-	{
-		brand_handler.BrandRoutes(api)
-		customer_handler.CustomerRoutes(api)
-		voucher_handler.VoucherRoutes(api)
-		transaction_handler.TransactionRoutes(api)
+// ApiRoutes serves every route on /api/v1, with the APIResponse envelope, and on
+// /api/v2, which answers errors with RFC 7807 problem details.
+func ApiRoutes(r *gin.Engine, verifier *auth.Verifier, apiKeys auth.ApiKeyAuthenticator, limiter *rate_limit.Limiter) {
+	for path, renderer := range map[string]middleware.Renderer{
+		"/api/v1": middleware.EnvelopeRenderer,
+		"/api/v2": middleware.ProblemRenderer,
+	} {
+		api := r.Group(path, middleware.RequestID(), middleware.Response(renderer), middleware.LimitFailedAuth(limiter), middleware.AuthenticateApiKey(apiKeys), middleware.Authenticate(verifier), middleware.RateLimit(limiter))
+		{
+			brand_handler.BrandRoutes(api)
+			customer_handler.CustomerRoutes(api)
+			voucher_handler.VoucherRoutes(api)
+			category_handler.CategoryRoutes(api)
+			search_handler.SearchRoutes(api)
+			transaction_handler.TransactionRoutes(api)
+			audit_handler.AuditRoutes(api)
+			api_key_handler.ApiKeyRoutes(api)
+			api.GET("/debug/vars", middleware.RequirePermission(constants.PermissionMetricsRead), gin.WrapH(expvar.Handler()))
+		}
 	}
 }
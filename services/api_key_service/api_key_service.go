@@ -0,0 +1,303 @@
+package api_key_service
+
+import (
+	"context"
+	"crypto/subtle"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"customer-voucher-service/db"
+	"customer-voucher-service/models/api_key_model"
+	"customer-voucher-service/models/audit_model"
+	"customer-voucher-service/models/brand_model"
+	pbApiKey "customer-voucher-service/protogen/api_key"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
+	"customer-voucher-service/utils/validator"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type IApiKeyService interface {
+	CreateApiKey(ctx context.Context, req *pbApiKey.CreateApiKeyReq) (*pbApiKey.CreateApiKeyRes, error)
+	ListApiKey(ctx context.Context, req *pbApiKey.ListApiKeyReq) (*pbApiKey.ListApiKeyRes, error)
+	RevokeApiKey(ctx context.Context, req *pbApiKey.RevokeApiKeyReq) (*pbApiKey.RevokeApiKeyRes, error)
+	RotateApiKey(ctx context.Context, req *pbApiKey.RotateApiKeyReq) (*pbApiKey.RotateApiKeyRes, error)
+}
+
+type ApiKeyService struct {
+	pbApiKey.UnimplementedApiKeyServiceServer
+	apiKeyRepo api_key_model.IApiKeyRepo
+	brandRepo  brand_model.IBrandRepo
+	auditRepo  audit_model.IAuditRepo
+	transactor transactor.Transactor
+}
+
+func NewApiKeyService() *ApiKeyService {
+	return &ApiKeyService{
+		apiKeyRepo: api_key_model.NewApiKeyRepo(db.DB),
+		brandRepo:  brand_model.NewBrandRepo(db.DB),
+		auditRepo:  audit_model.NewAuditRepo(db.DB),
+		transactor: transactor.New(db.DB),
+	}
+}
+
+// RequestRules are the field rules of each method, checked by the gRPC validation
+// interceptor. The methods check them again for HTTP calls.
+var RequestRules = validator.Rules{
+	pbApiKey.ApiKeyServiceCreateApiKeyFullMethodName: validator.RuleFor(validateCreateApiKeyReq),
+	pbApiKey.ApiKeyServiceRevokeApiKeyFullMethodName: validator.RuleFor(validateRevokeApiKeyReq),
+	pbApiKey.ApiKeyServiceRotateApiKeyFullMethodName: validator.RuleFor(validateRotateApiKeyReq),
+}
+
+type createApiKeyReqValidate struct {
+	BrandId int32  `validate:"required"`
+	Name    string `validate:"required,max=255"`
+}
+
+func validateCreateApiKeyReq(ctx context.Context, req *pbApiKey.CreateApiKeyReq) error {
+	return validator.ValidateReqField(createApiKeyReqValidate{
+		BrandId: req.BrandId,
+		Name:    req.Name,
+	})
+}
+
+func (s *ApiKeyService) CreateApiKey(ctx context.Context, req *pbApiKey.CreateApiKeyReq) (*pbApiKey.CreateApiKeyRes, error) {
+	if err := validateCreateApiKeyReq(ctx, req); err != nil {
+		return &pbApiKey.CreateApiKeyRes{IsSuccess: false}, err
+	}
+	scopes, err := normalizeScopes(req.Scopes)
+	if err != nil {
+		return &pbApiKey.CreateApiKeyRes{IsSuccess: false}, err
+	}
+	resBrand, err := s.brandRepo.FindBrandById(uint(req.BrandId))
+	if err != nil || resBrand == nil {
+		return &pbApiKey.CreateApiKeyRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("brand"))
+	}
+	if err := auth.AuthorizeBrand(ctx, resBrand.ID); err != nil {
+		return &pbApiKey.CreateApiKeyRes{IsSuccess: false}, err
+	}
+
+	key, prefix, err := auth.GenerateApiKey()
+	if err != nil {
+		return nil, err
+	}
+	apiKey := &api_key_model.ApiKey{
+		BrandID: resBrand.ID,
+		Name:    req.Name,
+		Prefix:  prefix,
+		KeyHash: auth.HashApiKey(key),
+		Scopes:  scopes,
+	}
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.apiKeyRepo.WithContext(ctx).CreateApiKey(apiKey); err != nil {
+			return err
+		}
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionCreate, constants.AuditEntityApiKey, apiKey.ID, nil, apiKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbApiKey.CreateApiKeyRes{IsSuccess: true, Data: toPbApiKey(apiKey), Key: key}, nil
+}
+
+func (s *ApiKeyService) ListApiKey(ctx context.Context, req *pbApiKey.ListApiKeyReq) (*pbApiKey.ListApiKeyRes, error) {
+	page, err := pagination.NewPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return &pbApiKey.ListApiKeyRes{}, err
+	}
+	// Brand operators only see their own brand's keys, so their list defaults to it.
+	if caller, ok := auth.CallerFromContext(ctx); ok && caller.Role == constants.RoleBrandOperator && req.BrandId == nil {
+		brandId := int32(caller.BrandID)
+		req.BrandId = &brandId
+	}
+	var brandId *uint
+	if req.BrandId != nil {
+		if err := auth.AuthorizeBrand(ctx, uint(*req.BrandId)); err != nil {
+			return &pbApiKey.ListApiKeyRes{}, err
+		}
+		id := uint(*req.BrandId)
+		brandId = &id
+	}
+
+	result, total, err := s.apiKeyRepo.ListApiKey(brandId, page)
+	if err != nil {
+		return nil, err
+	}
+	result, hasNext := pagination.Trim(result, page.Size)
+	list := []*pbApiKey.ApiKey{}
+	for _, k := range result {
+		list = append(list, toPbApiKey(k))
+	}
+	res := &pbApiKey.ListApiKeyRes{
+		Data:       list,
+		TotalCount: total,
+	}
+	if hasNext {
+		res.NextPageToken = pagination.EncodeToken(pagination.Cursor{ID: result[len(result)-1].ID})
+	}
+	return res, nil
+}
+
+type changeApiKeyReqValidate struct {
+	Id         int32  `validate:"required"`
+	ModifiedBy string `validate:"max=255"`
+}
+
+func validateRevokeApiKeyReq(ctx context.Context, req *pbApiKey.RevokeApiKeyReq) error {
+	return validator.ValidateReqField(changeApiKeyReqValidate{
+		Id:         req.Id,
+		ModifiedBy: auth.Actor(ctx, req.ModifiedBy),
+	})
+}
+
+func (s *ApiKeyService) RevokeApiKey(ctx context.Context, req *pbApiKey.RevokeApiKeyReq) (*pbApiKey.RevokeApiKeyRes, error) {
+	req.ModifiedBy = auth.Actor(ctx, req.ModifiedBy)
+	if err := validateRevokeApiKeyReq(ctx, req); err != nil {
+		return &pbApiKey.RevokeApiKeyRes{IsSuccess: false}, err
+	}
+	resApiKey, err := s.findActiveApiKey(ctx, uint(req.Id))
+	if err != nil {
+		return &pbApiKey.RevokeApiKeyRes{IsSuccess: false}, err
+	}
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.apiKeyRepo.WithContext(ctx).RevokeApiKey(resApiKey.ID, req.ModifiedBy); err != nil {
+			return err
+		}
+		return s.recordRevoke(ctx, resApiKey, req.ModifiedBy)
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbApiKey.RevokeApiKeyRes{IsSuccess: false}, error_base.ErrInvalidState.WithMessage("api key is already revoked")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pbApiKey.RevokeApiKeyRes{IsSuccess: true}, nil
+}
+
+func validateRotateApiKeyReq(ctx context.Context, req *pbApiKey.RotateApiKeyReq) error {
+	return validator.ValidateReqField(changeApiKeyReqValidate{
+		Id:         req.Id,
+		ModifiedBy: auth.Actor(ctx, req.ModifiedBy),
+	})
+}
+
+// RotateApiKey replaces a key with a new one for the same brand, name and scopes.
+// The old key stops working at once.
+func (s *ApiKeyService) RotateApiKey(ctx context.Context, req *pbApiKey.RotateApiKeyReq) (*pbApiKey.RotateApiKeyRes, error) {
+	req.ModifiedBy = auth.Actor(ctx, req.ModifiedBy)
+	if err := validateRotateApiKeyReq(ctx, req); err != nil {
+		return &pbApiKey.RotateApiKeyRes{IsSuccess: false}, err
+	}
+	resApiKey, err := s.findActiveApiKey(ctx, uint(req.Id))
+	if err != nil {
+		return &pbApiKey.RotateApiKeyRes{IsSuccess: false}, err
+	}
+
+	key, prefix, err := auth.GenerateApiKey()
+	if err != nil {
+		return nil, err
+	}
+	apiKey := &api_key_model.ApiKey{
+		BrandID: resApiKey.BrandID,
+		Name:    resApiKey.Name,
+		Prefix:  prefix,
+		KeyHash: auth.HashApiKey(key),
+		Scopes:  resApiKey.Scopes,
+	}
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.apiKeyRepo.WithContext(ctx).RotateApiKey(resApiKey.ID, req.ModifiedBy, apiKey); err != nil {
+			return err
+		}
+		if err := s.recordRevoke(ctx, resApiKey, req.ModifiedBy); err != nil {
+			return err
+		}
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionCreate, constants.AuditEntityApiKey, apiKey.ID, nil, apiKey)
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbApiKey.RotateApiKeyRes{IsSuccess: false}, error_base.ErrInvalidState.WithMessage("api key is already revoked")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pbApiKey.RotateApiKeyRes{IsSuccess: true, Data: toPbApiKey(apiKey), Key: key}, nil
+}
+
+// AuthenticateApiKey implements auth.ApiKeyAuthenticator.
+func (s *ApiKeyService) AuthenticateApiKey(ctx context.Context, key string) (*auth.Caller, error) {
+	prefix, ok := auth.ApiKeyLookupPrefix(key)
+	if !ok {
+		return nil, error_base.ErrInvalidCredentials
+	}
+	apiKey, err := s.apiKeyRepo.WithContext(ctx).FindApiKeyByPrefix(prefix)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, error_base.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(auth.HashApiKey(key)), []byte(apiKey.KeyHash)) != 1 {
+		return nil, error_base.ErrInvalidCredentials
+	}
+	return &auth.Caller{
+		ID:      "api_key:" + apiKey.Prefix,
+		Name:    apiKey.Name,
+		Role:    constants.RoleApiKey,
+		BrandID: apiKey.BrandID,
+		Scopes:  apiKey.Scopes,
+	}, nil
+}
+
+func (s *ApiKeyService) findActiveApiKey(ctx context.Context, id uint) (*api_key_model.ApiKey, error) {
+	resApiKey, err := s.apiKeyRepo.FindApiKeyById(id)
+	if err != nil || resApiKey == nil {
+		return nil, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("api key"))
+	}
+	if err := auth.AuthorizeBrand(ctx, resApiKey.BrandID); err != nil {
+		return nil, err
+	}
+	if resApiKey.IsRevoked {
+		return nil, error_base.ErrInvalidState.WithMessage("api key is already revoked")
+	}
+	return resApiKey, nil
+}
+
+func (s *ApiKeyService) recordRevoke(ctx context.Context, apiKey *api_key_model.ApiKey, modifiedBy string) error {
+	after := *apiKey
+	after.IsRevoked, after.ModifiedBy = true, modifiedBy
+	return audit_model.Record(ctx, s.auditRepo, constants.AuditActionDelete, constants.AuditEntityApiKey, apiKey.ID, apiKey, &after)
+}
+
+// normalizeScopes requires at least one known scope and drops duplicates.
+func normalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.RequiredMessage("scopes"))
+	}
+	seen := map[string]bool{}
+	result := []string{}
+	for _, scope := range scopes {
+		if !auth.IsValidScope(scope) {
+			return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("scopes"))
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			result = append(result, scope)
+		}
+	}
+	return result, nil
+}
+
+func toPbApiKey(k *api_key_model.ApiKey) *pbApiKey.ApiKey {
+	return &pbApiKey.ApiKey{
+		Id:          int32(k.ID),
+		BrandId:     int32(k.BrandID),
+		Name:        k.Name,
+		Prefix:      k.Prefix,
+		Scopes:      k.Scopes,
+		IsRevoked:   k.IsRevoked,
+		CreatedDate: k.CreatedDate.Format(constants.FormatDate),
+		CreatedBy:   k.CreatedBy,
+	}
+}
@@ -0,0 +1,298 @@
+package api_key_service
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/models/api_key_model"
+	"customer-voucher-service/models/audit_model"
+	"customer-voucher-service/models/brand_model"
+	pbApiKey "customer-voucher-service/protogen/api_key"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/pagination"
+	"errors"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+// MockApiKeyRepo keeps keys in memory so a created key can be authenticated again.
+type MockTransactor struct{}
+
+func (MockTransactor) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type MockApiKeyRepo struct {
+	keys []*api_key_model.ApiKey
+}
+
+func (m *MockApiKeyRepo) WithContext(ctx context.Context) api_key_model.IApiKeyRepo {
+	return m
+}
+
+func (m *MockApiKeyRepo) CreateApiKey(apiKey *api_key_model.ApiKey) error {
+	apiKey.ID = uint(len(m.keys) + 1)
+	m.keys = append(m.keys, apiKey)
+	return nil
+}
+
+func (m *MockApiKeyRepo) ListApiKey(brandId *uint, page *pagination.Page) ([]*api_key_model.ApiKey, int64, error) {
+	result := []*api_key_model.ApiKey{}
+	for _, k := range m.keys {
+		if brandId == nil || k.BrandID == *brandId {
+			result = append(result, k)
+		}
+	}
+	return result, int64(len(result)), nil
+}
+
+func (m *MockApiKeyRepo) FindApiKeyById(id uint) (*api_key_model.ApiKey, error) {
+	for _, k := range m.keys {
+		if k.ID == id {
+			copied := *k
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockApiKeyRepo) FindApiKeyByPrefix(prefix string) (*api_key_model.ApiKey, error) {
+	for _, k := range m.keys {
+		if k.Prefix == prefix && !k.IsRevoked {
+			return k, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockApiKeyRepo) RevokeApiKey(id uint, modifiedBy string) error {
+	for _, k := range m.keys {
+		if k.ID == id && !k.IsRevoked {
+			k.IsRevoked, k.ModifiedBy = true, modifiedBy
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+// staleApiKeyRepo finds keys as they were before a concurrent revoke.
+type staleApiKeyRepo struct {
+	*MockApiKeyRepo
+}
+
+func (m *staleApiKeyRepo) WithContext(ctx context.Context) api_key_model.IApiKeyRepo {
+	return m
+}
+
+func (m *staleApiKeyRepo) FindApiKeyById(id uint) (*api_key_model.ApiKey, error) {
+	apiKey, err := m.MockApiKeyRepo.FindApiKeyById(id)
+	if err == nil {
+		apiKey.IsRevoked = false
+	}
+	return apiKey, err
+}
+
+func (m *MockApiKeyRepo) RotateApiKey(id uint, modifiedBy string, newApiKey *api_key_model.ApiKey) error {
+	if err := m.RevokeApiKey(id, modifiedBy); err != nil {
+		return err
+	}
+	return m.CreateApiKey(newApiKey)
+}
+
+type MockBrandRepo struct {
+	brand_model.IBrandRepo
+}
+
+func (m *MockBrandRepo) FindBrandById(id uint) (*brand_model.Brand, error) {
+	if id == 1 || id == 2 {
+		return &brand_model.Brand{ID: id, Name: "Test Brand"}, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+type MockAuditRepo struct {
+	audit_model.IAuditRepo
+	entries []*audit_model.AuditLog
+}
+
+func (m *MockAuditRepo) WithContext(ctx context.Context) audit_model.IAuditRepo {
+	return m
+}
+
+func (m *MockAuditRepo) CreateAuditLog(auditLog *audit_model.AuditLog) error {
+	m.entries = append(m.entries, auditLog)
+	return nil
+}
+
+func newTestService() *ApiKeyService {
+	return &ApiKeyService{
+		transactor: MockTransactor{},
+		apiKeyRepo: &MockApiKeyRepo{},
+		brandRepo:  &MockBrandRepo{},
+		auditRepo:  &MockAuditRepo{},
+	}
+}
+
+func operatorContext(brandId uint) context.Context {
+	return auth.WithCaller(context.Background(), &auth.Caller{ID: "operator-1", Role: constants.RoleBrandOperator, BrandID: brandId})
+}
+
+func TestCreateApiKey_StoresHashOnly(t *testing.T) {
+	service := newTestService()
+
+	res, err := service.CreateApiKey(operatorContext(1), &pbApiKey.CreateApiKeyReq{
+		BrandId: 1,
+		Name:    "POS",
+		Scopes:  []string{constants.ScopeCodesBurn, constants.ScopeCodesBurn},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(res.Key, constants.ApiKeyPrefix+"_"+res.Data.Prefix+"_") {
+		t.Errorf("Expected key to start with its prefix, got %s", res.Key)
+	}
+	stored := service.apiKeyRepo.(*MockApiKeyRepo).keys[0]
+	if stored.KeyHash != auth.HashApiKey(res.Key) || strings.Contains(stored.KeyHash, res.Key) {
+		t.Error("Expected only the hash of the key to be stored")
+	}
+	if len(stored.Scopes) != 1 {
+		t.Errorf("Expected duplicate scopes to be dropped, got %v", stored.Scopes)
+	}
+	if entries := service.auditRepo.(*MockAuditRepo).entries; len(entries) != 1 || strings.Contains(entries[0].After, stored.KeyHash) {
+		t.Error("Expected one audit entry without the key hash")
+	}
+}
+
+func TestCreateApiKey_InvalidScope(t *testing.T) {
+	service := newTestService()
+
+	for _, scopes := range [][]string{nil, {"vouchers:delete"}} {
+		res, err := service.CreateApiKey(context.Background(), &pbApiKey.CreateApiKeyReq{BrandId: 1, Name: "POS", Scopes: scopes})
+		if err == nil {
+			t.Errorf("Expected error for scopes %v, got nil", scopes)
+		}
+		if res == nil || res.IsSuccess {
+			t.Error("Expected IsSuccess to be false")
+		}
+	}
+}
+
+func TestCreateApiKey_OtherBrandForbidden(t *testing.T) {
+	service := newTestService()
+
+	_, err := service.CreateApiKey(operatorContext(2), &pbApiKey.CreateApiKeyReq{BrandId: 1, Name: "POS", Scopes: []string{constants.ScopeVouchersRead}})
+
+	if !errors.Is(err, error_base.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestAuthenticateApiKey(t *testing.T) {
+	service := newTestService()
+	res, err := service.CreateApiKey(context.Background(), &pbApiKey.CreateApiKeyReq{BrandId: 1, Name: "POS", Scopes: []string{constants.ScopeCodesBurn}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	caller, err := service.AuthenticateApiKey(context.Background(), res.Key)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if caller.Role != constants.RoleApiKey || caller.BrandID != 1 || !caller.HasPermission(constants.PermissionVoucherBurn) {
+		t.Errorf("Unexpected caller %+v", caller)
+	}
+	if caller.HasPermission(constants.PermissionVoucherWrite) {
+		t.Error("Expected the key to hold only its scopes")
+	}
+
+	for _, key := range []string{"", "not-a-key", res.Key + "x", constants.ApiKeyPrefix + "_unknown_secret"} {
+		if _, err := service.AuthenticateApiKey(context.Background(), key); !errors.Is(err, error_base.ErrInvalidCredentials) {
+			t.Errorf("Expected ErrInvalidCredentials for %q, got %v", key, err)
+		}
+	}
+}
+
+func TestRevokeApiKey(t *testing.T) {
+	service := newTestService()
+	res, _ := service.CreateApiKey(context.Background(), &pbApiKey.CreateApiKeyReq{BrandId: 1, Name: "POS", Scopes: []string{constants.ScopeCodesBurn}})
+
+	_, err := service.RevokeApiKey(operatorContext(2), &pbApiKey.RevokeApiKeyReq{Id: res.Data.Id})
+	if !errors.Is(err, error_base.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+
+	revokeRes, err := service.RevokeApiKey(operatorContext(1), &pbApiKey.RevokeApiKeyReq{Id: res.Data.Id})
+	if err != nil || !revokeRes.IsSuccess {
+		t.Fatalf("Expected revoke to succeed, got %v", err)
+	}
+	if _, err := service.AuthenticateApiKey(context.Background(), res.Key); !errors.Is(err, error_base.ErrInvalidCredentials) {
+		t.Errorf("Expected revoked key to be rejected, got %v", err)
+	}
+
+	revokeRes, err = service.RevokeApiKey(operatorContext(1), &pbApiKey.RevokeApiKeyReq{Id: res.Data.Id})
+	if err == nil || revokeRes.IsSuccess {
+		t.Error("Expected revoking twice to fail")
+	}
+}
+
+func TestRotateApiKey(t *testing.T) {
+	service := newTestService()
+	res, _ := service.CreateApiKey(context.Background(), &pbApiKey.CreateApiKeyReq{BrandId: 1, Name: "POS", Scopes: []string{constants.ScopeVouchersRead}})
+
+	rotateRes, err := service.RotateApiKey(operatorContext(1), &pbApiKey.RotateApiKeyReq{Id: res.Data.Id})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if rotateRes.Key == res.Key || rotateRes.Data.Name != "POS" || rotateRes.Data.BrandId != 1 {
+		t.Errorf("Unexpected rotated key %+v", rotateRes.Data)
+	}
+	if _, err := service.AuthenticateApiKey(context.Background(), res.Key); !errors.Is(err, error_base.ErrInvalidCredentials) {
+		t.Errorf("Expected old key to be rejected, got %v", err)
+	}
+	if _, err := service.AuthenticateApiKey(context.Background(), rotateRes.Key); err != nil {
+		t.Errorf("Expected new key to authenticate, got %v", err)
+	}
+}
+
+func TestRotateApiKey_AlreadyRevokedConcurrently(t *testing.T) {
+	repo := &MockApiKeyRepo{}
+	service := newTestService()
+	service.apiKeyRepo = &staleApiKeyRepo{MockApiKeyRepo: repo}
+	res, _ := service.CreateApiKey(context.Background(), &pbApiKey.CreateApiKeyReq{BrandId: 1, Name: "POS", Scopes: []string{constants.ScopeVouchersRead}})
+	if _, err := service.RotateApiKey(operatorContext(1), &pbApiKey.RotateApiKeyReq{Id: res.Data.Id}); err != nil {
+		t.Fatalf("Expected the first rotation to succeed, got %v", err)
+	}
+
+	rotateRes, err := service.RotateApiKey(operatorContext(1), &pbApiKey.RotateApiKeyReq{Id: res.Data.Id})
+	if !errors.Is(err, error_base.ErrInvalidState) {
+		t.Errorf("Expected ErrInvalidState, got %v", err)
+	}
+	if rotateRes == nil || rotateRes.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+	if len(repo.keys) != 2 {
+		t.Errorf("Expected a single replacement key, got %d keys", len(repo.keys))
+	}
+}
+
+func TestListApiKey_DefaultsToOperatorBrand(t *testing.T) {
+	service := newTestService()
+	service.CreateApiKey(context.Background(), &pbApiKey.CreateApiKeyReq{BrandId: 1, Name: "POS", Scopes: []string{constants.ScopeVouchersRead}})
+	service.CreateApiKey(context.Background(), &pbApiKey.CreateApiKeyReq{BrandId: 2, Name: "Web", Scopes: []string{constants.ScopeVouchersRead}})
+
+	res, err := service.ListApiKey(operatorContext(2), &pbApiKey.ListApiKeyReq{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(res.Data) != 1 || res.Data[0].BrandId != 2 {
+		t.Errorf("Expected only brand 2 keys, got %v", res.Data)
+	}
+
+	otherBrand := int32(1)
+	if _, err := service.ListApiKey(operatorContext(2), &pbApiKey.ListApiKeyReq{BrandId: &otherBrand}); !errors.Is(err, error_base.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package audit_service
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"customer-voucher-service/db"
+	"customer-voucher-service/models/audit_model"
+	pbAudit "customer-voucher-service/protogen/audit"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/validator"
+)
+
+type IAuditService interface {
+	ListAuditLog(ctx context.Context, req *pbAudit.ListAuditLogReq) (*pbAudit.ListAuditLogRes, error)
+}
+
+type AuditService struct {
+	pbAudit.UnimplementedAuditServiceServer
+	auditRepo audit_model.IAuditRepo
+}
+
+func NewAuditService() *AuditService {
+	return &AuditService{auditRepo: audit_model.NewAuditRepo(db.DB)}
+}
+
+// RequestRules are the field rules of each method, checked by the gRPC validation
+// interceptor. The methods check them again for HTTP calls.
+var RequestRules = validator.Rules{
+	pbAudit.AuditServiceListAuditLogFullMethodName: validator.RuleFor(validateListAuditLogReq),
+}
+
+var auditEntityTypes = map[string]bool{
+	constants.AuditEntityBrand:       true,
+	constants.AuditEntityVoucher:     true,
+	constants.AuditEntityCategory:    true,
+	constants.AuditEntityTag:         true,
+	constants.AuditEntityCustomer:    true,
+	constants.AuditEntityTransaction: true,
+	constants.AuditEntityApiKey:      true,
+}
+
+type listAuditLogReqValidate struct {
+	Actor string `validate:"max=255"`
+}
+
+func validateListAuditLogReq(ctx context.Context, req *pbAudit.ListAuditLogReq) error {
+	return validator.ValidateReqField(listAuditLogReqValidate{
+		Actor: req.Actor,
+	})
+}
+
+func (s *AuditService) ListAuditLog(ctx context.Context, req *pbAudit.ListAuditLogReq) (*pbAudit.ListAuditLogRes, error) {
+	if err := validateListAuditLogReq(ctx, req); err != nil {
+		return &pbAudit.ListAuditLogRes{}, err
+	}
+	if req.EntityType != "" && !auditEntityTypes[req.EntityType] {
+		return &pbAudit.ListAuditLogRes{}, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("entityType"))
+	}
+	if req.EntityId < 0 {
+		return &pbAudit.ListAuditLogRes{}, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("entityId"))
+	}
+	page, err := pagination.NewPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return &pbAudit.ListAuditLogRes{}, err
+	}
+
+	result, total, err := s.auditRepo.ListAuditLog(req, page)
+	if err != nil {
+		return nil, err
+	}
+	result, hasNext := pagination.Trim(result, page.Size)
+	list := []*pbAudit.AuditLog{}
+	for _, a := range result {
+		list = append(list, &pbAudit.AuditLog{
+			Id:          int32(a.ID),
+			Actor:       a.Actor,
+			Action:      a.Action,
+			EntityType:  a.EntityType,
+			EntityId:    int32(a.EntityID),
+			Before:      a.Before,
+			After:       a.After,
+			RequestId:   a.RequestID,
+			CreatedDate: a.CreatedDate.Format(constants.FormatDate),
+		})
+	}
+	res := &pbAudit.ListAuditLogRes{
+		Data:       list,
+		TotalCount: total,
+	}
+	if hasNext {
+		res.NextPageToken = pagination.EncodeToken(pagination.Cursor{ID: result[len(result)-1].ID})
+	}
+	return res, nil
+}
@@ -0,0 +1,61 @@
+package audit_service
+
+import (
+	"context"
+	"customer-voucher-service/models/audit_model"
+	pbAudit "customer-voucher-service/protogen/audit"
+	"customer-voucher-service/utils/pagination"
+	"testing"
+)
+
+type MockAuditRepo struct {
+	audit_model.IAuditRepo
+	listAuditLogFunc func(req *pbAudit.ListAuditLogReq, page *pagination.Page) ([]*audit_model.AuditLog, int64, error)
+}
+
+func (m *MockAuditRepo) ListAuditLog(req *pbAudit.ListAuditLogReq, page *pagination.Page) ([]*audit_model.AuditLog, int64, error) {
+	if m.listAuditLogFunc != nil {
+		return m.listAuditLogFunc(req, page)
+	}
+	return nil, 0, nil
+}
+
+func TestListAuditLog_Success(t *testing.T) {
+	mockRepo := &MockAuditRepo{
+		listAuditLogFunc: func(req *pbAudit.ListAuditLogReq, page *pagination.Page) ([]*audit_model.AuditLog, int64, error) {
+			if req.EntityType != "customer" || req.EntityId != 7 || page.Size != 1 {
+				t.Errorf("Unexpected filters %v, page size %d", req, page.Size)
+			}
+			return []*audit_model.AuditLog{
+				{ID: 9, Actor: "admin-1", Action: "update", EntityType: "customer", EntityID: 7, Before: `{"points":5000}`, After: `{"points":50}`, RequestID: "req-1"},
+				{ID: 8, Actor: "admin-1", Action: "create", EntityType: "customer", EntityID: 7},
+			}, 2, nil
+		},
+	}
+	service := &AuditService{auditRepo: mockRepo}
+
+	result, err := service.ListAuditLog(context.Background(), &pbAudit.ListAuditLogReq{EntityType: "customer", EntityId: 7, PageSize: 1})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.Data) != 1 || result.TotalCount != 2 {
+		t.Fatalf("Expected 1 of 2 entries, got %d of %d", len(result.Data), result.TotalCount)
+	}
+	if result.Data[0].Before != `{"points":5000}` || result.Data[0].RequestId != "req-1" {
+		t.Errorf("Unexpected entry %v", result.Data[0])
+	}
+	if result.NextPageToken == "" {
+		t.Error("Expected a next page token")
+	}
+}
+
+func TestListAuditLog_InvalidEntityType(t *testing.T) {
+	service := &AuditService{auditRepo: &MockAuditRepo{}}
+
+	result, err := service.ListAuditLog(context.Background(), &pbAudit.ListAuditLogReq{EntityType: "order"})
+
+	if err == nil || result == nil {
+		t.Errorf("Expected a validation error, got %v %v", result, err)
+	}
+}
@@ -3,24 +3,51 @@ package brand_service
 import (
 	"context"
 	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
 	"customer-voucher-service/db"
+	"customer-voucher-service/models/audit_model"
 	"customer-voucher-service/models/brand_model"
+	"customer-voucher-service/models/voucher_model"
 	pbBrand "customer-voucher-service/protogen/brand"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
 	"customer-voucher-service/utils/validator"
+	"errors"
 )
 
 type IBrandService interface {
 	CreateBrand(ctx context.Context, req *pbBrand.CreateBrandReq) (*pbBrand.CreateBrandRes, error)
 	ListBrand(ctx context.Context, req *pbBrand.ListBrandReq) (*pbBrand.ListBrandRes, error)
+	DetailBrand(ctx context.Context, req *pbBrand.DetailBrandReq) (*pbBrand.DetailBrandRes, error)
+	UpdateBrand(ctx context.Context, req *pbBrand.UpdateBrandReq) (*pbBrand.UpdateBrandRes, error)
+	DeleteBrand(ctx context.Context, req *pbBrand.DeleteBrandReq) (*pbBrand.DeleteBrandRes, error)
+	RestoreBrand(ctx context.Context, req *pbBrand.RestoreBrandReq) (*pbBrand.RestoreBrandRes, error)
 }
 
 type BrandService struct {
 	pbBrand.UnimplementedBrandServiceServer
-	brandRepo brand_model.IBrandRepo
+	brandRepo  brand_model.IBrandRepo
+	auditRepo  audit_model.IAuditRepo
+	transactor transactor.Transactor
 }
 
 func NewBrandService() *BrandService {
-	return &BrandService{brandRepo: brand_model.NewBrandRepo(db.DB)}
+	return &BrandService{
+		brandRepo:  brand_model.NewBrandRepo(db.DB),
+		auditRepo:  audit_model.NewAuditRepo(db.DB),
+		transactor: transactor.New(db.DB),
+	}
+}
+
+// RequestRules are the field rules of each method, checked by the gRPC validation
+// interceptor. The methods check them again for HTTP calls.
+var RequestRules = validator.Rules{
+	pbBrand.BrandServiceCreateBrandFullMethodName:  validator.RuleFor(validateCreateBrandReq),
+	pbBrand.BrandServiceUpdateBrandFullMethodName:  validator.RuleFor(validateUpdateBrandReq),
+	pbBrand.BrandServiceDeleteBrandFullMethodName:  validator.RuleFor(validateDeleteBrandReq),
+	pbBrand.BrandServiceRestoreBrandFullMethodName: validator.RuleFor(validateRestoreBrandReq),
 }
 
 type createBrandReqValidate struct {
@@ -28,19 +55,27 @@ type createBrandReqValidate struct {
 	Description string `validate:"max=255"`
 }
 
-func (s *BrandService) CreateBrand(ctx context.Context, req *pbBrand.CreateBrandReq) (*pbBrand.CreateBrandRes, error) {
-	validateReq := createBrandReqValidate{
+func validateCreateBrandReq(ctx context.Context, req *pbBrand.CreateBrandReq) error {
+	return validator.ValidateReqField(createBrandReqValidate{
 		Name:        req.Name,
 		Description: req.Description,
-	}
-	if err := validator.ValidateReqField(validateReq); err != nil {
+	})
+}
+
+func (s *BrandService) CreateBrand(ctx context.Context, req *pbBrand.CreateBrandReq) (*pbBrand.CreateBrandRes, error) {
+	if err := validateCreateBrandReq(ctx, req); err != nil {
 		return &pbBrand.CreateBrandRes{IsSuccess: false}, err
 	}
 	brand := &brand_model.Brand{
 		Name:        req.Name,
 		Description: req.Description,
 	}
-	err := s.brandRepo.CreateBrand(brand)
+	err := s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.brandRepo.WithContext(ctx).CreateBrand(brand); err != nil {
+			return err
+		}
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionCreate, constants.AuditEntityBrand, brand.ID, nil, brand)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -48,24 +83,191 @@ func (s *BrandService) CreateBrand(ctx context.Context, req *pbBrand.CreateBrand
 }
 
 func (s *BrandService) ListBrand(ctx context.Context, req *pbBrand.ListBrandReq) (*pbBrand.ListBrandRes, error) {
-	result, err := s.brandRepo.ListBrand()
+	page, err := pagination.NewPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return &pbBrand.ListBrandRes{}, err
+	}
+
+	result, total, err := s.brandRepo.ListBrand(page)
 	if err != nil {
 		return nil, err
 	}
+	result, hasNext := pagination.Trim(result, page.Size)
 	list := []*pbBrand.Brand{}
 
 	for _, b := range result {
-		data := pbBrand.Brand{
-			Id:           int32(b.ID),
-			Name:         b.Name,
-			Description:  b.Description,
-			CreatedDate:  b.CreatedDate.Format(constants.FormatDate),
-			ModifiedDate: b.ModifiedDate.Format(constants.FormatDate),
-			IsDeleted:    &b.IsDeleted,
-		}
-		list = append(list, &data)
+		list = append(list, toPbBrand(b))
+	}
+	res := &pbBrand.ListBrandRes{
+		Data:       list,
+		TotalCount: total,
+	}
+	if hasNext {
+		res.NextPageToken = pagination.EncodeToken(pagination.Cursor{ID: result[len(result)-1].ID})
+	}
+	return res, nil
+}
+
+func (s *BrandService) DetailBrand(ctx context.Context, req *pbBrand.DetailBrandReq) (*pbBrand.DetailBrandRes, error) {
+	result, err := s.brandRepo.FindBrandById(uint(req.Id))
+	if err != nil || result == nil {
+		return &pbBrand.DetailBrandRes{}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("brand"))
 	}
-	return &pbBrand.ListBrandRes{
-		Data: list,
+
+	return &pbBrand.DetailBrandRes{
+		Data: toPbBrand(result),
 	}, nil
 }
+
+type updateBrandReqValidate struct {
+	Id          int32  `validate:"required"`
+	Name        string `validate:"required,max=255"`
+	Description string `validate:"max=255"`
+	ModifiedBy  string `validate:"max=255"`
+}
+
+func validateUpdateBrandReq(ctx context.Context, req *pbBrand.UpdateBrandReq) error {
+	return validator.ValidateReqField(updateBrandReqValidate{
+		Id:          req.Id,
+		Name:        req.Name,
+		Description: req.Description,
+		ModifiedBy:  auth.Actor(ctx, req.ModifiedBy),
+	})
+}
+
+func (s *BrandService) UpdateBrand(ctx context.Context, req *pbBrand.UpdateBrandReq) (*pbBrand.UpdateBrandRes, error) {
+	req.ModifiedBy = auth.Actor(ctx, req.ModifiedBy)
+	if err := validateUpdateBrandReq(ctx, req); err != nil {
+		return &pbBrand.UpdateBrandRes{IsSuccess: false}, err
+	}
+	resBrand, err := s.brandRepo.FindBrandById(uint(req.Id))
+	if err != nil || resBrand == nil {
+		return &pbBrand.UpdateBrandRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("brand"))
+	}
+	brand := &brand_model.Brand{
+		ID:          resBrand.ID,
+		Name:        req.Name,
+		Description: req.Description,
+		ModifiedBy:  req.ModifiedBy,
+	}
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.brandRepo.WithContext(ctx).UpdateBrand(brand); err != nil {
+			return err
+		}
+		after := *resBrand
+		after.Name, after.Description, after.ModifiedBy = req.Name, req.Description, req.ModifiedBy
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionUpdate, constants.AuditEntityBrand, resBrand.ID, resBrand, &after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbBrand.UpdateBrandRes{IsSuccess: true}, nil
+}
+
+type changeBrandStatusReqValidate struct {
+	Id         int32  `validate:"required"`
+	ModifiedBy string `validate:"max=255"`
+}
+
+func validateDeleteBrandReq(ctx context.Context, req *pbBrand.DeleteBrandReq) error {
+	return validator.ValidateReqField(changeBrandStatusReqValidate{
+		Id:         req.Id,
+		ModifiedBy: auth.Actor(ctx, req.ModifiedBy),
+	})
+}
+
+// DeleteBrand is blocked while the brand has active vouchers, unless
+// cascadeVouchers is set, in which case they are soft-deleted with it.
+func (s *BrandService) DeleteBrand(ctx context.Context, req *pbBrand.DeleteBrandReq) (*pbBrand.DeleteBrandRes, error) {
+	req.ModifiedBy = auth.Actor(ctx, req.ModifiedBy)
+	if err := validateDeleteBrandReq(ctx, req); err != nil {
+		return &pbBrand.DeleteBrandRes{IsSuccess: false}, err
+	}
+	resBrand, err := s.brandRepo.FindBrandById(uint(req.Id))
+	if err != nil || resBrand == nil {
+		return &pbBrand.DeleteBrandRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("brand"))
+	}
+	var deletedVouchers []*voucher_model.Voucher
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		brandRepo := s.brandRepo.WithContext(ctx)
+		if !req.CascadeVouchers {
+			count, err := brandRepo.CountActiveVoucher(resBrand.ID)
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				return error_base.ErrBrandHasActiveVouchers
+			}
+		}
+		deletedVouchers, err = brandRepo.DeleteBrand(resBrand.ID, req.ModifiedBy, req.CascadeVouchers)
+		if err != nil {
+			return err
+		}
+		after := *resBrand
+		after.IsDeleted, after.ModifiedBy = true, req.ModifiedBy
+		err = audit_model.Record(ctx, s.auditRepo, constants.AuditActionDelete, constants.AuditEntityBrand, resBrand.ID, resBrand, &after)
+		if err != nil {
+			return err
+		}
+		for _, voucher := range deletedVouchers {
+			before := *voucher
+			before.IsDeleted, before.DeletedReason = false, ""
+			err = audit_model.Record(ctx, s.auditRepo, constants.AuditActionDelete, constants.AuditEntityVoucher, voucher.ID, &before, voucher)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, error_base.ErrBrandHasActiveVouchers) {
+		return &pbBrand.DeleteBrandRes{IsSuccess: false}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pbBrand.DeleteBrandRes{IsSuccess: true, DeletedVoucherCount: int64(len(deletedVouchers))}, nil
+}
+
+func validateRestoreBrandReq(ctx context.Context, req *pbBrand.RestoreBrandReq) error {
+	return validator.ValidateReqField(changeBrandStatusReqValidate{
+		Id:         req.Id,
+		ModifiedBy: auth.Actor(ctx, req.ModifiedBy),
+	})
+}
+
+// RestoreBrand only restores the brand. Vouchers deleted along with it stay
+// deleted and have to be restored one by one.
+func (s *BrandService) RestoreBrand(ctx context.Context, req *pbBrand.RestoreBrandReq) (*pbBrand.RestoreBrandRes, error) {
+	req.ModifiedBy = auth.Actor(ctx, req.ModifiedBy)
+	if err := validateRestoreBrandReq(ctx, req); err != nil {
+		return &pbBrand.RestoreBrandRes{IsSuccess: false}, err
+	}
+	resBrand, err := s.brandRepo.FindDeletedBrandById(uint(req.Id))
+	if err != nil || resBrand == nil {
+		return &pbBrand.RestoreBrandRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("deleted brand"))
+	}
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.brandRepo.WithContext(ctx).RestoreBrand(resBrand.ID, req.ModifiedBy); err != nil {
+			return err
+		}
+		after := *resBrand
+		after.IsDeleted, after.ModifiedBy = false, req.ModifiedBy
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionRestore, constants.AuditEntityBrand, resBrand.ID, resBrand, &after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbBrand.RestoreBrandRes{IsSuccess: true}, nil
+}
+
+func toPbBrand(b *brand_model.Brand) *pbBrand.Brand {
+	isDeleted := b.IsDeleted
+	return &pbBrand.Brand{
+		Id:           int32(b.ID),
+		Name:         b.Name,
+		Description:  b.Description,
+		CreatedDate:  b.CreatedDate.Format(constants.FormatDate),
+		ModifiedDate: b.ModifiedDate.Format(constants.FormatDate),
+		IsDeleted:    &isDeleted,
+	}
+}
@@ -2,17 +2,48 @@ package brand_service
 
 import (
 	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/models/audit_model"
 	"customer-voucher-service/models/brand_model"
+	"customer-voucher-service/models/voucher_model"
 	pbBrand "customer-voucher-service/protogen/brand"
+	"customer-voucher-service/utils/pagination"
 	"errors"
 	"testing"
 	"time"
 )
 
+type MockTransactor struct{}
+
+func (MockTransactor) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// DepthTransactor tells the mocks how many transactions they run in.
+type DepthTransactor struct {
+	depth *int
+}
+
+func (t DepthTransactor) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	*t.depth++
+	defer func() { *t.depth-- }()
+	return fn(ctx)
+}
+
 type MockBrandRepo struct {
-	createBrandFunc func(brand *brand_model.Brand) error
-	listBrandFunc   func() ([]*brand_model.Brand, error)
-	findByIdFunc    func(id uint) (*brand_model.Brand, error)
+	createBrandFunc  func(brand *brand_model.Brand) error
+	listBrandFunc    func(page *pagination.Page) ([]*brand_model.Brand, int64, error)
+	findByIdFunc     func(id uint) (*brand_model.Brand, error)
+	findDeletedFunc  func(id uint) (*brand_model.Brand, error)
+	updateFunc       func(brand *brand_model.Brand) error
+	countVoucherFunc func(brandId uint) (int64, error)
+	deleteFunc       func(id uint, modifiedBy string, cascadeVouchers bool) ([]*voucher_model.Voucher, error)
+	restoreFunc      func(id uint, modifiedBy string) error
+}
+
+func (m *MockBrandRepo) WithContext(ctx context.Context) brand_model.IBrandRepo {
+	return m
 }
 
 func (m *MockBrandRepo) CreateBrand(brand *brand_model.Brand) error {
@@ -22,11 +53,11 @@ func (m *MockBrandRepo) CreateBrand(brand *brand_model.Brand) error {
 	return nil
 }
 
-func (m *MockBrandRepo) ListBrand() ([]*brand_model.Brand, error) {
+func (m *MockBrandRepo) ListBrand(page *pagination.Page) ([]*brand_model.Brand, int64, error) {
 	if m.listBrandFunc != nil {
-		return m.listBrandFunc()
+		return m.listBrandFunc(page)
 	}
-	return []*brand_model.Brand{}, nil
+	return []*brand_model.Brand{}, 0, nil
 }
 
 func (m *MockBrandRepo) FindBrandById(id uint) (*brand_model.Brand, error) {
@@ -36,6 +67,59 @@ func (m *MockBrandRepo) FindBrandById(id uint) (*brand_model.Brand, error) {
 	return nil, nil
 }
 
+func (m *MockBrandRepo) FindDeletedBrandById(id uint) (*brand_model.Brand, error) {
+	if m.findDeletedFunc != nil {
+		return m.findDeletedFunc(id)
+	}
+	return nil, nil
+}
+
+func (m *MockBrandRepo) UpdateBrand(brand *brand_model.Brand) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(brand)
+	}
+	return nil
+}
+
+func (m *MockBrandRepo) CountActiveVoucher(brandId uint) (int64, error) {
+	if m.countVoucherFunc != nil {
+		return m.countVoucherFunc(brandId)
+	}
+	return 0, nil
+}
+
+func (m *MockBrandRepo) DeleteBrand(id uint, modifiedBy string, cascadeVouchers bool) ([]*voucher_model.Voucher, error) {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(id, modifiedBy, cascadeVouchers)
+	}
+	return []*voucher_model.Voucher{}, nil
+}
+
+func (m *MockBrandRepo) RestoreBrand(id uint, modifiedBy string) error {
+	if m.restoreFunc != nil {
+		return m.restoreFunc(id, modifiedBy)
+	}
+	return nil
+}
+
+type MockAuditRepo struct {
+	audit_model.IAuditRepo
+	entries []*audit_model.AuditLog
+	err     error
+}
+
+func (m *MockAuditRepo) WithContext(ctx context.Context) audit_model.IAuditRepo {
+	return m
+}
+
+func (m *MockAuditRepo) CreateAuditLog(auditLog *audit_model.AuditLog) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.entries = append(m.entries, auditLog)
+	return nil
+}
+
 func TestCreateBrand_Success(t *testing.T) {
 	mockRepo := &MockBrandRepo{
 		createBrandFunc: func(brand *brand_model.Brand) error {
@@ -47,7 +131,9 @@ func TestCreateBrand_Success(t *testing.T) {
 	}
 
 	service := &BrandService{
-		brandRepo: mockRepo,
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
 	}
 
 	req := &pbBrand.CreateBrandReq{
@@ -71,7 +157,9 @@ func TestCreateBrand_Success(t *testing.T) {
 func TestCreateBrand_ValidationError_EmptyName(t *testing.T) {
 	mockRepo := &MockBrandRepo{}
 	service := &BrandService{
-		brandRepo: mockRepo,
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
 	}
 
 	req := &pbBrand.CreateBrandReq{
@@ -95,7 +183,9 @@ func TestCreateBrand_ValidationError_EmptyName(t *testing.T) {
 func TestCreateBrand_ValidationError_NameTooLong(t *testing.T) {
 	mockRepo := &MockBrandRepo{}
 	service := &BrandService{
-		brandRepo: mockRepo,
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
 	}
 
 	longName := ""
@@ -124,7 +214,9 @@ func TestCreateBrand_ValidationError_NameTooLong(t *testing.T) {
 func TestCreateBrand_ValidationError_DescriptionTooLong(t *testing.T) {
 	mockRepo := &MockBrandRepo{}
 	service := &BrandService{
-		brandRepo: mockRepo,
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
 	}
 
 	longDescription := ""
@@ -159,7 +251,9 @@ func TestCreateBrand_RepositoryError(t *testing.T) {
 	}
 
 	service := &BrandService{
-		brandRepo: mockRepo,
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
 	}
 
 	req := &pbBrand.CreateBrandReq{
@@ -202,13 +296,15 @@ func TestListBrand_Success(t *testing.T) {
 	}
 
 	mockRepo := &MockBrandRepo{
-		listBrandFunc: func() ([]*brand_model.Brand, error) {
-			return mockBrands, nil
+		listBrandFunc: func(page *pagination.Page) ([]*brand_model.Brand, int64, error) {
+			return mockBrands, int64(len(mockBrands)), nil
 		},
 	}
 
 	service := &BrandService{
-		brandRepo: mockRepo,
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
 	}
 
 	req := &pbBrand.ListBrandReq{}
@@ -254,13 +350,15 @@ func TestListBrand_Success(t *testing.T) {
 
 func TestListBrand_EmptyList(t *testing.T) {
 	mockRepo := &MockBrandRepo{
-		listBrandFunc: func() ([]*brand_model.Brand, error) {
-			return []*brand_model.Brand{}, nil
+		listBrandFunc: func(page *pagination.Page) ([]*brand_model.Brand, int64, error) {
+			return []*brand_model.Brand{}, 0, nil
 		},
 	}
 
 	service := &BrandService{
-		brandRepo: mockRepo,
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
 	}
 
 	req := &pbBrand.ListBrandReq{}
@@ -281,13 +379,15 @@ func TestListBrand_EmptyList(t *testing.T) {
 func TestListBrand_RepositoryError(t *testing.T) {
 	expectedError := errors.New("database error")
 	mockRepo := &MockBrandRepo{
-		listBrandFunc: func() ([]*brand_model.Brand, error) {
-			return []*brand_model.Brand{}, expectedError
+		listBrandFunc: func(page *pagination.Page) ([]*brand_model.Brand, int64, error) {
+			return []*brand_model.Brand{}, 0, expectedError
 		},
 	}
 
 	service := &BrandService{
-		brandRepo: mockRepo,
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
 	}
 
 	req := &pbBrand.ListBrandReq{}
@@ -327,13 +427,15 @@ func TestListBrand_WithDeletedBrands(t *testing.T) {
 	}
 
 	mockRepo := &MockBrandRepo{
-		listBrandFunc: func() ([]*brand_model.Brand, error) {
-			return mockBrands, nil
+		listBrandFunc: func(page *pagination.Page) ([]*brand_model.Brand, int64, error) {
+			return mockBrands, int64(len(mockBrands)), nil
 		},
 	}
 
 	service := &BrandService{
-		brandRepo: mockRepo,
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
 	}
 
 	req := &pbBrand.ListBrandReq{}
@@ -357,3 +459,350 @@ func TestListBrand_WithDeletedBrands(t *testing.T) {
 		t.Error("Expected second brand to be deleted")
 	}
 }
+
+func TestListBrand_Pagination(t *testing.T) {
+	now := time.Now()
+	var gotPage *pagination.Page
+	mockRepo := &MockBrandRepo{
+		listBrandFunc: func(page *pagination.Page) ([]*brand_model.Brand, int64, error) {
+			gotPage = page
+			return []*brand_model.Brand{
+				{ID: 6, Name: "Brand 6", CreatedDate: now, ModifiedDate: now},
+				{ID: 7, Name: "Brand 7", CreatedDate: now, ModifiedDate: now},
+				{ID: 8, Name: "Brand 8", CreatedDate: now, ModifiedDate: now},
+			}, 10, nil
+		},
+	}
+
+	service := &BrandService{
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
+	}
+
+	req := &pbBrand.ListBrandReq{
+		PageSize:  2,
+		PageToken: pagination.EncodeToken(pagination.Cursor{ID: 5}),
+	}
+
+	result, err := service.ListBrand(context.Background(), req)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if gotPage.Size != 2 || gotPage.Cursor == nil || gotPage.Cursor.ID != 5 {
+		t.Errorf("Expected page size 2 after ID 5, got %+v", gotPage)
+	}
+	if len(result.Data) != 2 {
+		t.Errorf("Expected 2 brands, got %d", len(result.Data))
+	}
+	if result.TotalCount != 10 {
+		t.Errorf("Expected total count 10, got %d", result.TotalCount)
+	}
+
+	cursor, err := pagination.DecodeToken(result.NextPageToken)
+	if err != nil {
+		t.Errorf("Expected valid next page token, got %v", err)
+	}
+	if cursor.ID != 7 {
+		t.Errorf("Expected next page to start after ID 7, got %d", cursor.ID)
+	}
+}
+
+func TestListBrand_LastPage(t *testing.T) {
+	mockRepo := &MockBrandRepo{
+		listBrandFunc: func(page *pagination.Page) ([]*brand_model.Brand, int64, error) {
+			return []*brand_model.Brand{{ID: 1, Name: "Brand 1"}}, 1, nil
+		},
+	}
+
+	service := &BrandService{
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
+	}
+
+	result, err := service.ListBrand(context.Background(), &pbBrand.ListBrandReq{})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result.NextPageToken != "" {
+		t.Errorf("Expected no next page token, got '%s'", result.NextPageToken)
+	}
+}
+
+func TestListBrand_InvalidPageRequest(t *testing.T) {
+	service := &BrandService{
+		transactor: MockTransactor{},
+		brandRepo:  &MockBrandRepo{},
+		auditRepo:  &MockAuditRepo{},
+	}
+
+	cases := []*pbBrand.ListBrandReq{
+		{PageToken: "not-a-token"},
+		{PageSize: -1},
+		{PageSize: 101},
+	}
+	for _, req := range cases {
+		result, err := service.ListBrand(context.Background(), req)
+		if err == nil {
+			t.Errorf("Expected validation error for %v", req)
+		}
+		if result == nil {
+			t.Error("Expected result to not be nil on validation error")
+		}
+	}
+}
+
+func TestDetailBrand_Success(t *testing.T) {
+	now := time.Now()
+	mockRepo := &MockBrandRepo{
+		findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+			return &brand_model.Brand{ID: id, Name: "Brand 1", CreatedDate: now, ModifiedDate: now}, nil
+		},
+	}
+
+	service := &BrandService{
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
+	}
+
+	result, err := service.DetailBrand(context.Background(), &pbBrand.DetailBrandReq{Id: 1})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result.Data.Id != 1 || result.Data.Name != "Brand 1" {
+		t.Errorf("Unexpected brand %v", result.Data)
+	}
+}
+
+func TestDetailBrand_NotFound(t *testing.T) {
+	mockRepo := &MockBrandRepo{
+		findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+			return nil, errors.New("record not found")
+		},
+	}
+
+	service := &BrandService{
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
+	}
+
+	result, err := service.DetailBrand(context.Background(), &pbBrand.DetailBrandReq{Id: 1})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil {
+		t.Error("Expected result to not be nil")
+	}
+}
+
+func TestUpdateBrand_Success(t *testing.T) {
+	var updated *brand_model.Brand
+	mockRepo := &MockBrandRepo{
+		findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+			return &brand_model.Brand{ID: id, Name: "Old"}, nil
+		},
+		updateFunc: func(brand *brand_model.Brand) error {
+			updated = brand
+			return nil
+		},
+	}
+
+	service := &BrandService{
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
+	}
+
+	result, err := service.UpdateBrand(context.Background(), &pbBrand.UpdateBrandReq{Id: 1, Name: "New", Description: "Desc", ModifiedBy: "admin"})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+	if updated == nil || updated.Name != "New" || updated.ModifiedBy != "admin" {
+		t.Errorf("Expected brand to be updated, got %+v", updated)
+	}
+}
+
+func TestUpdateBrand_AuditLogFails(t *testing.T) {
+	mockRepo := &MockBrandRepo{
+		findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+			return &brand_model.Brand{ID: id, Name: "Old"}, nil
+		},
+	}
+
+	service := &BrandService{
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{err: errors.New("audit log unavailable")},
+	}
+
+	result, err := service.UpdateBrand(context.Background(), &pbBrand.UpdateBrandReq{Id: 1, Name: "New", ModifiedBy: "admin"})
+
+	if err == nil {
+		t.Error("Expected the audit failure to fail the update")
+	}
+	if result != nil {
+		t.Errorf("Expected no result, got %v", result)
+	}
+}
+
+func TestUpdateBrand_ValidationError(t *testing.T) {
+	service := &BrandService{
+		transactor: MockTransactor{},
+		brandRepo:  &MockBrandRepo{},
+		auditRepo:  &MockAuditRepo{},
+	}
+
+	result, err := service.UpdateBrand(context.Background(), &pbBrand.UpdateBrandReq{Id: 1, ModifiedBy: "admin"})
+
+	if err == nil {
+		t.Error("Expected validation error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestDeleteBrand_BlockedByActiveVouchers(t *testing.T) {
+	depth := 0
+	deleteCalled := false
+	mockRepo := &MockBrandRepo{
+		findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+			return &brand_model.Brand{ID: id}, nil
+		},
+		countVoucherFunc: func(brandId uint) (int64, error) {
+			if depth == 0 {
+				t.Error("Expected active vouchers to be counted inside the delete transaction")
+			}
+			return 3, nil
+		},
+		deleteFunc: func(id uint, modifiedBy string, cascadeVouchers bool) ([]*voucher_model.Voucher, error) {
+			deleteCalled = true
+			return nil, nil
+		},
+	}
+
+	service := &BrandService{
+		transactor: DepthTransactor{depth: &depth},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
+	}
+
+	result, err := service.DeleteBrand(context.Background(), &pbBrand.DeleteBrandReq{Id: 1, ModifiedBy: "admin"})
+
+	if !errors.Is(err, error_base.ErrBrandHasActiveVouchers) {
+		t.Errorf("Expected ErrBrandHasActiveVouchers, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+	if deleteCalled {
+		t.Error("Expected brand not to be deleted")
+	}
+}
+
+func TestDeleteBrand_CascadeVouchers(t *testing.T) {
+	var gotCascade bool
+	mockRepo := &MockBrandRepo{
+		findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+			return &brand_model.Brand{ID: id}, nil
+		},
+		countVoucherFunc: func(brandId uint) (int64, error) {
+			t.Error("Expected active vouchers not to be counted when cascading")
+			return 3, nil
+		},
+		deleteFunc: func(id uint, modifiedBy string, cascadeVouchers bool) ([]*voucher_model.Voucher, error) {
+			gotCascade = cascadeVouchers
+			return []*voucher_model.Voucher{{ID: 5, BrandID: id, IsDeleted: true}, {ID: 6, BrandID: id, IsDeleted: true}, {ID: 7, BrandID: id, IsDeleted: true}}, nil
+		},
+	}
+
+	mockAuditRepo := &MockAuditRepo{}
+	service := &BrandService{
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  mockAuditRepo,
+	}
+
+	result, err := service.DeleteBrand(context.Background(), &pbBrand.DeleteBrandReq{Id: 1, CascadeVouchers: true, ModifiedBy: "admin"})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if !result.IsSuccess || result.DeletedVoucherCount != 3 {
+		t.Errorf("Expected 3 vouchers to be deleted, got %v", result)
+	}
+	if !gotCascade {
+		t.Error("Expected delete to cascade to vouchers")
+	}
+	voucherEntries := 0
+	for _, entry := range mockAuditRepo.entries {
+		if entry.EntityType == constants.AuditEntityVoucher && entry.Action == constants.AuditActionDelete {
+			voucherEntries++
+		}
+	}
+	if len(mockAuditRepo.entries) != 4 || voucherEntries != 3 {
+		t.Errorf("Expected a delete entry for the brand and each voucher, got %d entries", len(mockAuditRepo.entries))
+	}
+}
+
+func TestRestoreBrand_Success(t *testing.T) {
+	restored := false
+	mockRepo := &MockBrandRepo{
+		findDeletedFunc: func(id uint) (*brand_model.Brand, error) {
+			return &brand_model.Brand{ID: id, IsDeleted: true}, nil
+		},
+		restoreFunc: func(id uint, modifiedBy string) error {
+			restored = true
+			return nil
+		},
+	}
+
+	service := &BrandService{
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
+	}
+
+	result, err := service.RestoreBrand(context.Background(), &pbBrand.RestoreBrandReq{Id: 1, ModifiedBy: "admin"})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if !result.IsSuccess || !restored {
+		t.Error("Expected brand to be restored")
+	}
+}
+
+func TestRestoreBrand_NotDeleted(t *testing.T) {
+	mockRepo := &MockBrandRepo{
+		findDeletedFunc: func(id uint) (*brand_model.Brand, error) {
+			return nil, errors.New("record not found")
+		},
+	}
+
+	service := &BrandService{
+		transactor: MockTransactor{},
+		brandRepo:  mockRepo,
+		auditRepo:  &MockAuditRepo{},
+	}
+
+	result, err := service.RestoreBrand(context.Background(), &pbBrand.RestoreBrandReq{Id: 1, ModifiedBy: "admin"})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
@@ -0,0 +1,230 @@
+package category_service
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"customer-voucher-service/db"
+	"customer-voucher-service/models/audit_model"
+	"customer-voucher-service/models/category_model"
+	pbCategory "customer-voucher-service/protogen/category"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
+	"customer-voucher-service/utils/validator"
+)
+
+type ICategoryService interface {
+	CreateCategory(ctx context.Context, req *pbCategory.CreateCategoryReq) (*pbCategory.CreateCategoryRes, error)
+	ListCategory(ctx context.Context, req *pbCategory.ListCategoryReq) (*pbCategory.ListCategoryRes, error)
+	UpdateCategory(ctx context.Context, req *pbCategory.UpdateCategoryReq) (*pbCategory.UpdateCategoryRes, error)
+	DeleteCategory(ctx context.Context, req *pbCategory.DeleteCategoryReq) (*pbCategory.DeleteCategoryRes, error)
+}
+
+type CategoryService struct {
+	pbCategory.UnimplementedCategoryServiceServer
+	categoryRepo category_model.ICategoryRepo
+	auditRepo    audit_model.IAuditRepo
+	transactor   transactor.Transactor
+}
+
+func NewCategoryService() *CategoryService {
+	return &CategoryService{
+		categoryRepo: category_model.NewCategoryRepo(db.DB),
+		auditRepo:    audit_model.NewAuditRepo(db.DB),
+		transactor:   transactor.New(db.DB),
+	}
+}
+
+// RequestRules are the field rules of each method, checked by the gRPC validation
+// interceptor. The methods check them again for HTTP calls.
+var RequestRules = validator.Rules{
+	pbCategory.CategoryServiceCreateCategoryFullMethodName: validator.RuleFor(validateCreateCategoryReq),
+	pbCategory.CategoryServiceUpdateCategoryFullMethodName: validator.RuleFor(validateUpdateCategoryReq),
+}
+
+type createCategoryReqValidate struct {
+	Name        string `validate:"required,max=255"`
+	Description string `validate:"max=255"`
+}
+
+func validateCreateCategoryReq(ctx context.Context, req *pbCategory.CreateCategoryReq) error {
+	return validator.ValidateReqField(createCategoryReqValidate{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+}
+
+func (s *CategoryService) CreateCategory(ctx context.Context, req *pbCategory.CreateCategoryReq) (*pbCategory.CreateCategoryRes, error) {
+	if err := validateCreateCategoryReq(ctx, req); err != nil {
+		return &pbCategory.CreateCategoryRes{IsSuccess: false}, err
+	}
+	var parentId *uint
+	if req.ParentId != nil {
+		resParent, err := s.categoryRepo.FindCategoryById(uint(*req.ParentId))
+		if err != nil || resParent == nil {
+			return &pbCategory.CreateCategoryRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("parent category"))
+		}
+		parentId = &resParent.ID
+	}
+	category := &category_model.Category{
+		ParentID:    parentId,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	err := s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.categoryRepo.WithContext(ctx).CreateCategory(category); err != nil {
+			return err
+		}
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionCreate, constants.AuditEntityCategory, category.ID, nil, category)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbCategory.CreateCategoryRes{IsSuccess: true}, nil
+}
+
+func (s *CategoryService) ListCategory(ctx context.Context, req *pbCategory.ListCategoryReq) (*pbCategory.ListCategoryRes, error) {
+	page, err := pagination.NewPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return &pbCategory.ListCategoryRes{}, err
+	}
+
+	result, total, err := s.categoryRepo.ListCategory(req, page)
+	if err != nil {
+		return nil, err
+	}
+	result, hasNext := pagination.Trim(result, page.Size)
+	list := []*pbCategory.Category{}
+
+	for _, c := range result {
+		data := pbCategory.Category{
+			Id:           int32(c.ID),
+			Name:         c.Name,
+			Description:  c.Description,
+			CreatedDate:  c.CreatedDate.Format(constants.FormatDate),
+			ModifiedDate: c.ModifiedDate.Format(constants.FormatDate),
+			IsDeleted:    &c.IsDeleted,
+		}
+		if c.ParentID != nil {
+			parentId := int32(*c.ParentID)
+			data.ParentId = &parentId
+		}
+		list = append(list, &data)
+	}
+	res := &pbCategory.ListCategoryRes{
+		Data:       list,
+		TotalCount: total,
+	}
+	if hasNext {
+		res.NextPageToken = pagination.EncodeToken(pagination.Cursor{ID: result[len(result)-1].ID})
+	}
+	return res, nil
+}
+
+type updateCategoryReqValidate struct {
+	Id          int32  `validate:"required"`
+	Name        string `validate:"required,max=255"`
+	Description string `validate:"max=255"`
+}
+
+func validateUpdateCategoryReq(ctx context.Context, req *pbCategory.UpdateCategoryReq) error {
+	return validator.ValidateReqField(updateCategoryReqValidate{
+		Id:          req.Id,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+}
+
+func (s *CategoryService) UpdateCategory(ctx context.Context, req *pbCategory.UpdateCategoryReq) (*pbCategory.UpdateCategoryRes, error) {
+	if err := validateUpdateCategoryReq(ctx, req); err != nil {
+		return &pbCategory.UpdateCategoryRes{IsSuccess: false}, err
+	}
+	resCategory, err := s.categoryRepo.FindCategoryById(uint(req.Id))
+	if err != nil || resCategory == nil {
+		return &pbCategory.UpdateCategoryRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("category"))
+	}
+	before := *resCategory
+
+	// Unset keeps the parent, 0 moves the category to the root.
+	switch {
+	case req.ParentId == nil:
+	case *req.ParentId == 0:
+		resCategory.ParentID = nil
+	default:
+		resParent, err := s.categoryRepo.FindCategoryById(uint(*req.ParentId))
+		if err != nil || resParent == nil {
+			return &pbCategory.UpdateCategoryRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("parent category"))
+		}
+		// a category cannot be moved under itself or one of its own subcategories
+		descendantIds, err := s.categoryRepo.ListDescendantCategoryId(resCategory.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range descendantIds {
+			if id == resParent.ID {
+				return &pbCategory.UpdateCategoryRes{IsSuccess: false}, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("parentId"))
+			}
+		}
+		resCategory.ParentID = &resParent.ID
+	}
+
+	resCategory.Name = req.Name
+	resCategory.Description = req.Description
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.categoryRepo.WithContext(ctx).UpdateCategory(resCategory); err != nil {
+			return err
+		}
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionUpdate, constants.AuditEntityCategory, resCategory.ID, &before, resCategory)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbCategory.UpdateCategoryRes{IsSuccess: true}, nil
+}
+
+func (s *CategoryService) DeleteCategory(ctx context.Context, req *pbCategory.DeleteCategoryReq) (*pbCategory.DeleteCategoryRes, error) {
+	resCategory, err := s.categoryRepo.FindCategoryById(uint(req.Id))
+	if err != nil || resCategory == nil {
+		return &pbCategory.DeleteCategoryRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("category"))
+	}
+	count, err := s.categoryRepo.CountChildCategory(resCategory.ID)
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return &pbCategory.DeleteCategoryRes{IsSuccess: false}, error_base.ErrInvalidState.WithMessage("category still has subcategories")
+	}
+	count, err = s.categoryRepo.CountActiveVoucher(resCategory.ID)
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return &pbCategory.DeleteCategoryRes{IsSuccess: false}, error_base.ErrInvalidState.WithMessage("category still has vouchers")
+	}
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		voucherIds, err := s.categoryRepo.WithContext(ctx).DeleteCategory(resCategory.ID)
+		if err != nil {
+			return err
+		}
+		after := *resCategory
+		after.IsDeleted = true
+		err = audit_model.Record(ctx, s.auditRepo, constants.AuditActionDelete, constants.AuditEntityCategory, resCategory.ID, resCategory, &after)
+		if err != nil {
+			return err
+		}
+		// the vouchers taken off the category are only known by ID, so their entries hold just category_id
+		for _, voucherId := range voucherIds {
+			err = audit_model.Record(ctx, s.auditRepo, constants.AuditActionUpdate, constants.AuditEntityVoucher, voucherId,
+				map[string]interface{}{"category_id": resCategory.ID}, map[string]interface{}{"category_id": nil})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbCategory.DeleteCategoryRes{IsSuccess: true}, nil
+}
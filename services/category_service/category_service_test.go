@@ -0,0 +1,492 @@
+package category_service
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/models/audit_model"
+	"customer-voucher-service/models/category_model"
+	pbCategory "customer-voucher-service/protogen/category"
+	"customer-voucher-service/utils/pagination"
+	"errors"
+	"testing"
+	"time"
+)
+
+type MockTransactor struct{}
+
+func (MockTransactor) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type MockAuditRepo struct {
+	audit_model.IAuditRepo
+	entries []*audit_model.AuditLog
+}
+
+func (m *MockAuditRepo) WithContext(ctx context.Context) audit_model.IAuditRepo {
+	return m
+}
+
+func (m *MockAuditRepo) CreateAuditLog(auditLog *audit_model.AuditLog) error {
+	m.entries = append(m.entries, auditLog)
+	return nil
+}
+
+type MockCategoryRepo struct {
+	createCategoryFunc func(category *category_model.Category) error
+	listCategoryFunc   func(req *pbCategory.ListCategoryReq, page *pagination.Page) ([]*category_model.Category, int64, error)
+	findByIdFunc       func(id uint) (*category_model.Category, error)
+	updateCategoryFunc func(category *category_model.Category) error
+	deleteCategoryFunc func(id uint) ([]uint, error)
+	listDescendantFunc func(id uint) ([]uint, error)
+	countChildFunc     func(id uint) (int64, error)
+	countVoucherFunc   func(id uint) (int64, error)
+}
+
+func (m *MockCategoryRepo) WithContext(ctx context.Context) category_model.ICategoryRepo {
+	return m
+}
+
+func (m *MockCategoryRepo) CreateCategory(category *category_model.Category) error {
+	if m.createCategoryFunc != nil {
+		return m.createCategoryFunc(category)
+	}
+	return nil
+}
+
+func (m *MockCategoryRepo) ListCategory(req *pbCategory.ListCategoryReq, page *pagination.Page) ([]*category_model.Category, int64, error) {
+	if m.listCategoryFunc != nil {
+		return m.listCategoryFunc(req, page)
+	}
+	return []*category_model.Category{}, 0, nil
+}
+
+func (m *MockCategoryRepo) FindCategoryById(id uint) (*category_model.Category, error) {
+	if m.findByIdFunc != nil {
+		return m.findByIdFunc(id)
+	}
+	return nil, nil
+}
+
+func (m *MockCategoryRepo) UpdateCategory(category *category_model.Category) error {
+	if m.updateCategoryFunc != nil {
+		return m.updateCategoryFunc(category)
+	}
+	return nil
+}
+
+func (m *MockCategoryRepo) DeleteCategory(id uint) ([]uint, error) {
+	if m.deleteCategoryFunc != nil {
+		return m.deleteCategoryFunc(id)
+	}
+	return []uint{}, nil
+}
+
+func (m *MockCategoryRepo) ListDescendantCategoryId(id uint) ([]uint, error) {
+	if m.listDescendantFunc != nil {
+		return m.listDescendantFunc(id)
+	}
+	return []uint{id}, nil
+}
+
+func (m *MockCategoryRepo) CountChildCategory(id uint) (int64, error) {
+	if m.countChildFunc != nil {
+		return m.countChildFunc(id)
+	}
+	return 0, nil
+}
+
+func (m *MockCategoryRepo) CountActiveVoucher(id uint) (int64, error) {
+	if m.countVoucherFunc != nil {
+		return m.countVoucherFunc(id)
+	}
+	return 0, nil
+}
+
+func TestCreateCategory_Success(t *testing.T) {
+	parentId := int32(1)
+	mockRepo := &MockCategoryRepo{
+		findByIdFunc: func(id uint) (*category_model.Category, error) {
+			return &category_model.Category{ID: id, Name: "Food & Beverage"}, nil
+		},
+		createCategoryFunc: func(category *category_model.Category) error {
+			if category.Name != "Coffee" {
+				t.Errorf("Expected name to be 'Coffee', got '%s'", category.Name)
+			}
+			if category.ParentID == nil || *category.ParentID != 1 {
+				t.Error("Expected parent ID to be 1")
+			}
+			return nil
+		},
+	}
+
+	service := &CategoryService{
+		transactor:   MockTransactor{},
+		categoryRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	result, err := service.CreateCategory(context.Background(), &pbCategory.CreateCategoryReq{Name: "Coffee", ParentId: &parentId})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+}
+
+func TestCreateCategory_ValidationError_EmptyName(t *testing.T) {
+	service := &CategoryService{
+		transactor:   MockTransactor{},
+		categoryRepo: &MockCategoryRepo{},
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	result, err := service.CreateCategory(context.Background(), &pbCategory.CreateCategoryReq{Name: ""})
+
+	if err == nil {
+		t.Error("Expected validation error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestCreateCategory_ParentNotFound(t *testing.T) {
+	parentId := int32(99)
+	mockRepo := &MockCategoryRepo{
+		findByIdFunc: func(id uint) (*category_model.Category, error) {
+			return nil, errors.New("record not found")
+		},
+	}
+
+	service := &CategoryService{
+		transactor:   MockTransactor{},
+		categoryRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	result, err := service.CreateCategory(context.Background(), &pbCategory.CreateCategoryReq{Name: "Coffee", ParentId: &parentId})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestListCategory_Success(t *testing.T) {
+	parentId := uint(1)
+	mockRepo := &MockCategoryRepo{
+		listCategoryFunc: func(req *pbCategory.ListCategoryReq, page *pagination.Page) ([]*category_model.Category, int64, error) {
+			return []*category_model.Category{
+				{ID: 1, Name: "Food & Beverage", CreatedDate: time.Now(), ModifiedDate: time.Now()},
+				{ID: 2, ParentID: &parentId, Name: "Coffee", CreatedDate: time.Now(), ModifiedDate: time.Now()},
+			}, 2, nil
+		},
+	}
+
+	service := &CategoryService{
+		transactor:   MockTransactor{},
+		categoryRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	result, err := service.ListCategory(context.Background(), &pbCategory.ListCategoryReq{})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if len(result.Data) != 2 {
+		t.Fatalf("Expected 2 categories, got %d", len(result.Data))
+	}
+	if result.Data[0].ParentId != nil {
+		t.Error("Expected root category to have no parent")
+	}
+	if result.Data[1].GetParentId() != 1 {
+		t.Errorf("Expected parent ID to be 1, got %d", result.Data[1].GetParentId())
+	}
+	if result.NextPageToken != "" {
+		t.Errorf("Expected no next page token, got '%s'", result.NextPageToken)
+	}
+}
+
+func TestListCategory_Paged(t *testing.T) {
+	var gotPage *pagination.Page
+	mockRepo := &MockCategoryRepo{
+		listCategoryFunc: func(req *pbCategory.ListCategoryReq, page *pagination.Page) ([]*category_model.Category, int64, error) {
+			gotPage = page
+			return []*category_model.Category{
+				{ID: 6, Name: "Coffee"},
+				{ID: 7, Name: "Tea"},
+				{ID: 8, Name: "Juice"},
+			}, 10, nil
+		},
+	}
+
+	service := &CategoryService{
+		transactor:   MockTransactor{},
+		categoryRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	req := &pbCategory.ListCategoryReq{
+		PageSize:  2,
+		PageToken: pagination.EncodeToken(pagination.Cursor{ID: 5}),
+	}
+
+	result, err := service.ListCategory(context.Background(), req)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if gotPage.Size != 2 || gotPage.Cursor == nil || gotPage.Cursor.ID != 5 {
+		t.Errorf("Expected page size 2 after ID 5, got %+v", gotPage)
+	}
+	if len(result.Data) != 2 {
+		t.Errorf("Expected 2 categories, got %d", len(result.Data))
+	}
+	if result.TotalCount != 10 {
+		t.Errorf("Expected total count 10, got %d", result.TotalCount)
+	}
+
+	cursor, err := pagination.DecodeToken(result.NextPageToken)
+	if err != nil {
+		t.Errorf("Expected valid next page token, got %v", err)
+	}
+	if cursor.ID != 7 {
+		t.Errorf("Expected next page to start after ID 7, got %d", cursor.ID)
+	}
+}
+
+func TestUpdateCategory_Success(t *testing.T) {
+	mockRepo := &MockCategoryRepo{
+		findByIdFunc: func(id uint) (*category_model.Category, error) {
+			return &category_model.Category{ID: id, Name: "Old"}, nil
+		},
+		updateCategoryFunc: func(category *category_model.Category) error {
+			if category.Name != "New" {
+				t.Errorf("Expected name to be 'New', got '%s'", category.Name)
+			}
+			return nil
+		},
+	}
+
+	service := &CategoryService{
+		transactor:   MockTransactor{},
+		categoryRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	result, err := service.UpdateCategory(context.Background(), &pbCategory.UpdateCategoryReq{Id: 2, Name: "New"})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+}
+
+func TestUpdateCategory_ParentId(t *testing.T) {
+	zero, other := int32(0), int32(5)
+	three, five := uint(3), uint(5)
+	tests := []struct {
+		name     string
+		parentId *int32
+		want     *uint
+	}{
+		{name: "unset keeps the parent", parentId: nil, want: &three},
+		{name: "0 moves to the root", parentId: &zero, want: nil},
+		{name: "id moves under it", parentId: &other, want: &five},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *category_model.Category
+			mockRepo := &MockCategoryRepo{
+				findByIdFunc: func(id uint) (*category_model.Category, error) {
+					return &category_model.Category{ID: id, ParentID: &three}, nil
+				},
+				updateCategoryFunc: func(category *category_model.Category) error {
+					saved = category
+					return nil
+				},
+			}
+			service := &CategoryService{
+				transactor:   MockTransactor{},
+				categoryRepo: mockRepo,
+				auditRepo:    &MockAuditRepo{},
+			}
+
+			_, err := service.UpdateCategory(context.Background(), &pbCategory.UpdateCategoryReq{Id: 2, Name: "Renamed", ParentId: tt.parentId})
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if (saved.ParentID == nil) != (tt.want == nil) || (tt.want != nil && *saved.ParentID != *tt.want) {
+				t.Errorf("Expected parent %v, got %v", tt.want, saved.ParentID)
+			}
+		})
+	}
+}
+
+func TestUpdateCategory_ParentIsDescendant(t *testing.T) {
+	parentId := int32(3)
+	mockRepo := &MockCategoryRepo{
+		findByIdFunc: func(id uint) (*category_model.Category, error) {
+			return &category_model.Category{ID: id}, nil
+		},
+		listDescendantFunc: func(id uint) ([]uint, error) {
+			return []uint{1, 2, 3}, nil
+		},
+		updateCategoryFunc: func(category *category_model.Category) error {
+			t.Error("Expected category not to be updated")
+			return nil
+		},
+	}
+
+	service := &CategoryService{
+		transactor:   MockTransactor{},
+		categoryRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	result, err := service.UpdateCategory(context.Background(), &pbCategory.UpdateCategoryReq{Id: 1, Name: "Root", ParentId: &parentId})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestDeleteCategory_Success(t *testing.T) {
+	deletedId := uint(0)
+	mockRepo := &MockCategoryRepo{
+		findByIdFunc: func(id uint) (*category_model.Category, error) {
+			return &category_model.Category{ID: id}, nil
+		},
+		deleteCategoryFunc: func(id uint) ([]uint, error) {
+			deletedId = id
+			return []uint{}, nil
+		},
+	}
+
+	service := &CategoryService{
+		transactor:   MockTransactor{},
+		categoryRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	result, err := service.DeleteCategory(context.Background(), &pbCategory.DeleteCategoryReq{Id: 4})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+	if deletedId != 4 {
+		t.Errorf("Expected category 4 to be deleted, got %d", deletedId)
+	}
+}
+
+func TestDeleteCategory_Audited(t *testing.T) {
+	mockRepo := &MockCategoryRepo{
+		findByIdFunc: func(id uint) (*category_model.Category, error) {
+			return &category_model.Category{ID: id, Name: "Coffee"}, nil
+		},
+		deleteCategoryFunc: func(id uint) ([]uint, error) {
+			return []uint{7, 9}, nil
+		},
+	}
+	auditRepo := &MockAuditRepo{}
+
+	service := &CategoryService{
+		transactor:   MockTransactor{},
+		categoryRepo: mockRepo,
+		auditRepo:    auditRepo,
+	}
+
+	_, err := service.DeleteCategory(context.Background(), &pbCategory.DeleteCategoryReq{Id: 4})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(auditRepo.entries) != 3 {
+		t.Fatalf("Expected the category and its 2 vouchers to be audited, got %d entries", len(auditRepo.entries))
+	}
+	category := auditRepo.entries[0]
+	if category.Action != constants.AuditActionDelete || category.EntityType != constants.AuditEntityCategory || category.EntityID != 4 {
+		t.Errorf("Expected a delete entry for category 4, got %+v", category)
+	}
+	voucher := auditRepo.entries[2]
+	if voucher.EntityType != constants.AuditEntityVoucher || voucher.EntityID != 9 ||
+		voucher.Before != `{"category_id":4}` || voucher.After != `{"category_id":null}` {
+		t.Errorf("Expected voucher 9 to be taken off category 4, got %+v", voucher)
+	}
+}
+
+func TestDeleteCategory_HasVouchers(t *testing.T) {
+	mockRepo := &MockCategoryRepo{
+		findByIdFunc: func(id uint) (*category_model.Category, error) {
+			return &category_model.Category{ID: id}, nil
+		},
+		countVoucherFunc: func(id uint) (int64, error) {
+			return 3, nil
+		},
+		deleteCategoryFunc: func(id uint) ([]uint, error) {
+			t.Error("Expected category not to be deleted")
+			return nil, nil
+		},
+	}
+
+	service := &CategoryService{
+		transactor:   MockTransactor{},
+		categoryRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	result, err := service.DeleteCategory(context.Background(), &pbCategory.DeleteCategoryReq{Id: 1})
+
+	if !errors.Is(err, error_base.ErrInvalidState) {
+		t.Errorf("Expected invalid state error, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestDeleteCategory_HasSubcategories(t *testing.T) {
+	mockRepo := &MockCategoryRepo{
+		findByIdFunc: func(id uint) (*category_model.Category, error) {
+			return &category_model.Category{ID: id}, nil
+		},
+		countChildFunc: func(id uint) (int64, error) {
+			return 2, nil
+		},
+		deleteCategoryFunc: func(id uint) ([]uint, error) {
+			t.Error("Expected category not to be deleted")
+			return nil, nil
+		},
+	}
+
+	service := &CategoryService{
+		transactor:   MockTransactor{},
+		categoryRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	result, err := service.DeleteCategory(context.Background(), &pbCategory.DeleteCategoryReq{Id: 1})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
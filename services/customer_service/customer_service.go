@@ -3,48 +3,112 @@ package customer_service
 import (
 	"context"
 	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
 	"customer-voucher-service/constants/message"
 	"customer-voucher-service/db"
+	"customer-voucher-service/models/audit_model"
 	"customer-voucher-service/models/customer_model"
+	"customer-voucher-service/models/transaction_model"
 	pbCustomer "customer-voucher-service/protogen/customer"
+	pbTransaction "customer-voucher-service/protogen/transaction"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/env"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
 	"customer-voucher-service/utils/validator"
 	"errors"
+	"strings"
+
+	"gorm.io/gorm"
 )
 
 type ICustomerService interface {
 	CreateCustomer(ctx context.Context, req *pbCustomer.CreateCustomerReq) (*pbCustomer.CreateCustomerRes, error)
 	ListCustomer(ctx context.Context, req *pbCustomer.ListCustomerReq) (*pbCustomer.ListCustomerRes, error)
+	DetailCustomer(ctx context.Context, req *pbCustomer.DetailCustomerReq) (*pbCustomer.DetailCustomerRes, error)
+	UpdateCustomer(ctx context.Context, req *pbCustomer.UpdateCustomerReq) (*pbCustomer.UpdateCustomerRes, error)
 	UpdateCustomerPoints(ctx context.Context, req *pbCustomer.UpdateCustomerPointsReq) (*pbCustomer.UpdateCustomerPointsRes, error)
+	DeactivateCustomer(ctx context.Context, req *pbCustomer.DeactivateCustomerReq) (*pbCustomer.DeactivateCustomerRes, error)
+	ReactivateCustomer(ctx context.Context, req *pbCustomer.ReactivateCustomerReq) (*pbCustomer.ReactivateCustomerRes, error)
+	ReferralReport(ctx context.Context, req *pbCustomer.ReferralReportReq) (*pbCustomer.ReferralReportRes, error)
 }
 
 type CustomerService struct {
 	pbCustomer.UnimplementedCustomerServiceServer
-	customerRepo customer_model.ICustomerRepo
+	customerRepo    customer_model.ICustomerRepo
+	transactionRepo transaction_model.ITransactionRepo
+	auditRepo       audit_model.IAuditRepo
+	transactor      transactor.Transactor
 }
 
 func NewCustomerService() *CustomerService {
-	return &CustomerService{customerRepo: customer_model.NewCustomerRepo(db.DB)}
+	return &CustomerService{
+		customerRepo:    customer_model.NewCustomerRepo(db.DB),
+		transactionRepo: transaction_model.NewTransactionRepo(db.DB),
+		auditRepo:       audit_model.NewAuditRepo(db.DB),
+		transactor:      transactor.New(db.DB),
+	}
+}
+
+// RequestRules are the field rules of each method, checked by the gRPC validation
+// interceptor. The methods check them again for HTTP calls.
+var RequestRules = validator.Rules{
+	pbCustomer.CustomerServiceCreateCustomerFullMethodName:     validator.RuleFor(validateCreateCustomerReq),
+	pbCustomer.CustomerServiceDetailCustomerFullMethodName:     validator.RuleFor(validateDetailCustomerReq),
+	pbCustomer.CustomerServiceUpdateCustomerFullMethodName:     validator.RuleFor(validateUpdateCustomerReq),
+	pbCustomer.CustomerServiceDeactivateCustomerFullMethodName: validator.RuleFor(validateDeactivateCustomerReq),
+	pbCustomer.CustomerServiceReactivateCustomerFullMethodName: validator.RuleFor(validateReactivateCustomerReq),
 }
 
 type createCustomerReqValidate struct {
-	FullName string `validate:"required,max=255"`
-	Email    string `validate:"required,email,max=255"`
+	FullName     string `validate:"required,max=255"`
+	Email        string `validate:"required,email,max=255"`
+	ReferralCode string `validate:"max=20"`
+}
+
+func validateCreateCustomerReq(ctx context.Context, req *pbCustomer.CreateCustomerReq) error {
+	return validator.ValidateReqField(createCustomerReqValidate{
+		FullName:     req.FullName,
+		Email:        req.Email,
+		ReferralCode: req.GetReferralCode(),
+	})
 }
 
 func (s *CustomerService) CreateCustomer(ctx context.Context, req *pbCustomer.CreateCustomerReq) (*pbCustomer.CreateCustomerRes, error) {
-	validateReq := createCustomerReqValidate{
-		FullName: req.FullName,
-		Email:    req.Email,
-	}
-	if err := validator.ValidateReqField(validateReq); err != nil {
+	if err := validateCreateCustomerReq(ctx, req); err != nil {
 		return &pbCustomer.CreateCustomerRes{IsSuccess: false}, err
 	}
+
+	var referredById *uint
+	if req.GetReferralCode() != "" {
+		referrer, err := s.checkReferralCode(req.GetReferralCode(), req.Email)
+		if err != nil {
+			return &pbCustomer.CreateCustomerRes{IsSuccess: false}, err
+		}
+		referredById = &referrer.ID
+	}
+
+	referralCode, err := customer_model.GenerateReferralCode()
+	if err != nil {
+		return nil, err
+	}
 	customer := &customer_model.Customer{
-		FullName: req.FullName,
-		Email:    req.Email,
-		Points:   req.Points,
+		FullName:     req.FullName,
+		Email:        req.Email,
+		Points:       req.Points,
+		ReferralCode: referralCode,
+		ReferredByID: referredById,
+	}
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.customerRepo.WithContext(ctx).CreateCustomer(customer); err != nil {
+			return err
+		}
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionCreate, constants.AuditEntityCustomer, customer.ID, nil, customer)
+	})
+	// The repo draws another referral code when it is taken, a duplicate is the email.
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &pbCustomer.CreateCustomerRes{IsSuccess: false}, error_base.ErrEmailAlreadyExists
 	}
-	err := s.customerRepo.CreateCustomer(customer)
 	if err != nil {
 		return nil, err
 	}
@@ -52,44 +116,321 @@ func (s *CustomerService) CreateCustomer(ctx context.Context, req *pbCustomer.Cr
 }
 
 func (s *CustomerService) ListCustomer(ctx context.Context, req *pbCustomer.ListCustomerReq) (*pbCustomer.ListCustomerRes, error) {
-	result, err := s.customerRepo.ListCustomer()
+	page, err := pagination.NewPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return &pbCustomer.ListCustomerRes{}, err
+	}
+
+	result, total, err := s.customerRepo.ListCustomer(page)
 	if err != nil {
 		return nil, err
 	}
+	result, hasNext := pagination.Trim(result, page.Size)
 	list := []*pbCustomer.Customer{}
 
 	for _, cust := range result {
-		points := int64(cust.Points)
-		data := pbCustomer.Customer{
-			Id:           int32(cust.ID),
-			FullName:     cust.FullName,
-			Email:        cust.Email,
-			Points:       &points,
-			CreatedDate:  cust.CreatedDate.Format(constants.FormatDate),
-			ModifiedDate: cust.ModifiedDate.Format(constants.FormatDate),
-			IsDeleted:    &cust.IsDeleted,
+		list = append(list, toPbCustomer(cust))
+	}
+	res := &pbCustomer.ListCustomerRes{
+		Data:       list,
+		TotalCount: total,
+	}
+	if hasNext {
+		res.NextPageToken = pagination.EncodeToken(pagination.Cursor{ID: result[len(result)-1].ID})
+	}
+	return res, nil
+}
+
+type detailCustomerReqValidate struct {
+	Id int32 `validate:"required"`
+}
+
+func validateDetailCustomerReq(ctx context.Context, req *pbCustomer.DetailCustomerReq) error {
+	return validator.ValidateReqField(detailCustomerReqValidate{
+		Id: req.Id,
+	})
+}
+
+func (s *CustomerService) DetailCustomer(ctx context.Context, req *pbCustomer.DetailCustomerReq) (*pbCustomer.DetailCustomerRes, error) {
+	if err := validateDetailCustomerReq(ctx, req); err != nil {
+		return &pbCustomer.DetailCustomerRes{}, err
+	}
+	if req.RecentLimit < 0 || req.RecentLimit > constants.MaxRecentTransactionLimit {
+		return &pbCustomer.DetailCustomerRes{}, error_base.ErrValidationFailed.WithMessage(message.LimitMessage("recentLimit", constants.MaxRecentTransactionLimit, constants.DefaultRecentTransactionLimit))
+	}
+	limit := int(req.RecentLimit)
+	if limit == 0 {
+		limit = constants.DefaultRecentTransactionLimit
+	}
+
+	respCustomer, err := s.customerRepo.FindCustomerById(uint(req.Id))
+	if err != nil || respCustomer == nil {
+		return &pbCustomer.DetailCustomerRes{}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("customer"))
+	}
+	summary, err := s.transactionRepo.SummarizeTransactionByCustomer(respCustomer.ID)
+	if err != nil {
+		return nil, err
+	}
+	recent, err := s.transactionRepo.ListRecentTransactionByCustomer(respCustomer.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pbCustomer.DetailCustomerRes{
+		Data:               toPbCustomer(respCustomer),
+		PointsSpent:        summary.PointsSpent,
+		RedemptionCount:    summary.RedemptionCount,
+		RecentTransactions: []*pbTransaction.Transaction{},
+	}
+	if summary.LastRedemptionDate != nil {
+		res.LastRedemptionDate = summary.LastRedemptionDate.Format(constants.FormatDate)
+	}
+	for _, trans := range recent {
+		res.RecentTransactions = append(res.RecentTransactions, transaction_model.ToPbTransaction(trans))
+	}
+	return res, nil
+}
+
+type updateCustomerReqValidate struct {
+	Id         int32  `validate:"required"`
+	FullName   string `validate:"required,max=255"`
+	Email      string `validate:"required,email,max=255"`
+	ModifiedBy string `validate:"max=255"`
+}
+
+func validateUpdateCustomerReq(ctx context.Context, req *pbCustomer.UpdateCustomerReq) error {
+	return validator.ValidateReqField(updateCustomerReqValidate{
+		Id:         req.Id,
+		FullName:   req.FullName,
+		Email:      strings.TrimSpace(req.Email),
+		ModifiedBy: auth.Actor(ctx, req.ModifiedBy),
+	})
+}
+
+func (s *CustomerService) UpdateCustomer(ctx context.Context, req *pbCustomer.UpdateCustomerReq) (*pbCustomer.UpdateCustomerRes, error) {
+	req.ModifiedBy = auth.Actor(ctx, req.ModifiedBy)
+	if err := validateUpdateCustomerReq(ctx, req); err != nil {
+		return &pbCustomer.UpdateCustomerRes{IsSuccess: false}, err
+	}
+	email := strings.TrimSpace(req.Email)
+
+	respCustomer, err := s.customerRepo.FindCustomerById(uint(req.Id))
+	if err != nil || respCustomer == nil {
+		return &pbCustomer.UpdateCustomerRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("customer"))
+	}
+
+	var history *customer_model.CustomerEmailHistory
+	if email != respCustomer.Email {
+		existing, err := s.customerRepo.FindCustomerByEmail(email)
+		if err == nil && existing != nil && existing.ID != respCustomer.ID {
+			return &pbCustomer.UpdateCustomerRes{IsSuccess: false}, error_base.ErrEmailAlreadyExists
+		}
+		history = &customer_model.CustomerEmailHistory{
+			CustomerID: respCustomer.ID,
+			OldEmail:   respCustomer.Email,
+			NewEmail:   email,
+			CreatedBy:  req.ModifiedBy,
 		}
-		list = append(list, &data)
 	}
-	return &pbCustomer.ListCustomerRes{
-		Data: list,
-	}, nil
+
+	customer := &customer_model.Customer{
+		ID:         respCustomer.ID,
+		FullName:   req.FullName,
+		Email:      email,
+		ModifiedBy: req.ModifiedBy,
+	}
+	// The pre-check above can race with another request, the unique index is the final word.
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.customerRepo.WithContext(ctx).UpdateCustomer(customer, history); err != nil {
+			return err
+		}
+		after := *respCustomer
+		after.FullName, after.Email, after.ModifiedBy = req.FullName, email, req.ModifiedBy
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionUpdate, constants.AuditEntityCustomer, respCustomer.ID, respCustomer, &after)
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &pbCustomer.UpdateCustomerRes{IsSuccess: false}, error_base.ErrEmailAlreadyExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pbCustomer.UpdateCustomerRes{IsSuccess: true}, nil
 }
 
 func (s *CustomerService) UpdateCustomerPoints(ctx context.Context, req *pbCustomer.UpdateCustomerPointsReq) (*pbCustomer.UpdateCustomerPointsRes, error) {
 	if req.Points < 0 {
-		return &pbCustomer.UpdateCustomerPointsRes{IsSuccess: false}, errors.New(message.InvalidFormatMessage("customerPoints"))
+		return &pbCustomer.UpdateCustomerPointsRes{IsSuccess: false}, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("customerPoints"))
 	}
 
 	respCustomer, err := s.customerRepo.FindCustomerById(uint(req.Id))
 	if err != nil || respCustomer == nil {
-		return &pbCustomer.UpdateCustomerPointsRes{IsSuccess: false}, errors.New(message.NotFoundMessage("customer"))
+		return &pbCustomer.UpdateCustomerPointsRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("customer"))
 	}
 
-	err = s.customerRepo.UpdatePointsCustomer(respCustomer.ID, req.Points)
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.customerRepo.WithContext(ctx).SetPointsCustomer(respCustomer.ID, req.Points); err != nil {
+			return err
+		}
+		after := *respCustomer
+		after.Points = req.Points
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionUpdate, constants.AuditEntityCustomer, respCustomer.ID, respCustomer, &after)
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &pbCustomer.UpdateCustomerPointsRes{IsSuccess: true}, nil
 }
+
+type deactivateCustomerReqValidate struct {
+	Id         int32  `validate:"required"`
+	Reason     string `validate:"required,max=255"`
+	ModifiedBy string `validate:"max=255"`
+}
+
+func validateDeactivateCustomerReq(ctx context.Context, req *pbCustomer.DeactivateCustomerReq) error {
+	return validator.ValidateReqField(deactivateCustomerReqValidate{
+		Id:         req.Id,
+		Reason:     req.Reason,
+		ModifiedBy: auth.Actor(ctx, req.ModifiedBy),
+	})
+}
+
+// DeactivateCustomer soft-deletes the customer, which blocks them from
+// redeeming and gifting vouchers until they are reactivated.
+func (s *CustomerService) DeactivateCustomer(ctx context.Context, req *pbCustomer.DeactivateCustomerReq) (*pbCustomer.DeactivateCustomerRes, error) {
+	req.ModifiedBy = auth.Actor(ctx, req.ModifiedBy)
+	if err := validateDeactivateCustomerReq(ctx, req); err != nil {
+		return &pbCustomer.DeactivateCustomerRes{IsSuccess: false}, err
+	}
+	respCustomer, err := s.customerRepo.FindCustomerById(uint(req.Id))
+	if err != nil || respCustomer == nil {
+		return &pbCustomer.DeactivateCustomerRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("customer"))
+	}
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.customerRepo.WithContext(ctx).DeactivateCustomer(respCustomer.ID, req.Reason, req.ModifiedBy); err != nil {
+			return err
+		}
+		after := *respCustomer
+		after.IsDeleted, after.DeactivatedReason, after.ModifiedBy = true, req.Reason, req.ModifiedBy
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionDelete, constants.AuditEntityCustomer, respCustomer.ID, respCustomer, &after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbCustomer.DeactivateCustomerRes{IsSuccess: true}, nil
+}
+
+type reactivateCustomerReqValidate struct {
+	Id         int32  `validate:"required"`
+	ModifiedBy string `validate:"max=255"`
+}
+
+func validateReactivateCustomerReq(ctx context.Context, req *pbCustomer.ReactivateCustomerReq) error {
+	return validator.ValidateReqField(reactivateCustomerReqValidate{
+		Id:         req.Id,
+		ModifiedBy: auth.Actor(ctx, req.ModifiedBy),
+	})
+}
+
+func (s *CustomerService) ReactivateCustomer(ctx context.Context, req *pbCustomer.ReactivateCustomerReq) (*pbCustomer.ReactivateCustomerRes, error) {
+	req.ModifiedBy = auth.Actor(ctx, req.ModifiedBy)
+	if err := validateReactivateCustomerReq(ctx, req); err != nil {
+		return &pbCustomer.ReactivateCustomerRes{IsSuccess: false}, err
+	}
+	respCustomer, err := s.customerRepo.FindDeactivatedCustomerById(uint(req.Id))
+	if err != nil || respCustomer == nil {
+		return &pbCustomer.ReactivateCustomerRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("deactivated customer"))
+	}
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.customerRepo.WithContext(ctx).ReactivateCustomer(respCustomer.ID, req.ModifiedBy); err != nil {
+			return err
+		}
+		after := *respCustomer
+		after.IsDeleted, after.DeactivatedReason, after.ModifiedBy = false, "", req.ModifiedBy
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionRestore, constants.AuditEntityCustomer, respCustomer.ID, respCustomer, &after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbCustomer.ReactivateCustomerRes{IsSuccess: true}, nil
+}
+
+func (s *CustomerService) checkReferralCode(code string, email string) (*customer_model.Customer, error) {
+	referrer, err := s.customerRepo.FindCustomerByReferralCode(strings.ToUpper(code))
+	if err != nil || referrer == nil {
+		return nil, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("referral code"))
+	}
+	if IsSelfReferral(referrer.Email, email) {
+		return nil, error_base.ErrValidationFailed.WithMessage("cannot use your own referral code")
+	}
+	count, err := s.customerRepo.CountReferralCustomer(referrer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= env.GetInt64("REFERRAL_MAX_PER_REFERRER", constants.DefaultReferralMaxPerReferrer) {
+		return nil, error_base.ErrInvalidState.WithMessage("referral code has reached its usage limit")
+	}
+	return referrer, nil
+}
+
+func (s *CustomerService) ReferralReport(ctx context.Context, req *pbCustomer.ReferralReportReq) (*pbCustomer.ReferralReportRes, error) {
+	result, err := s.customerRepo.ReferralReport()
+	if err != nil {
+		return nil, err
+	}
+	list := []*pbCustomer.ReferralReport{}
+
+	for _, r := range result {
+		data := pbCustomer.ReferralReport{
+			CustomerId:    int32(r.CustomerID),
+			FullName:      r.FullName,
+			Email:         r.Email,
+			ReferralCode:  r.ReferralCode,
+			ReferralCount: r.ReferralCount,
+			RewardedCount: r.RewardedCount,
+		}
+		list = append(list, &data)
+	}
+	return &pbCustomer.ReferralReportRes{
+		Data: list,
+	}, nil
+}
+
+func toPbCustomer(cust *customer_model.Customer) *pbCustomer.Customer {
+	points := int64(cust.Points)
+	isDeleted := cust.IsDeleted
+	data := &pbCustomer.Customer{
+		Id:                int32(cust.ID),
+		FullName:          cust.FullName,
+		Email:             cust.Email,
+		Points:            &points,
+		CreatedDate:       cust.CreatedDate.Format(constants.FormatDate),
+		ModifiedDate:      cust.ModifiedDate.Format(constants.FormatDate),
+		IsDeleted:         &isDeleted,
+		ReferralCode:      cust.ReferralCode,
+		DeactivatedReason: cust.DeactivatedReason,
+	}
+	if cust.ReferredByID != nil {
+		referredById := int32(*cust.ReferredByID)
+		data.ReferredById = &referredById
+	}
+	return data
+}
+
+// IsSelfReferral treats "+tag" aliases of the same mailbox as the same person.
+func IsSelfReferral(referrerEmail string, refereeEmail string) bool {
+	return normalizeEmail(referrerEmail) == normalizeEmail(refereeEmail)
+}
+
+func normalizeEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	local, domain, found := strings.Cut(email, "@")
+	if !found {
+		return email
+	}
+	if idx := strings.Index(local, "+"); idx >= 0 {
+		local = local[:idx]
+	}
+	return local + "@" + domain
+}
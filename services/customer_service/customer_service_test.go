@@ -0,0 +1,789 @@
+package customer_service
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/models/audit_model"
+	"customer-voucher-service/models/customer_model"
+	"customer-voucher-service/models/transaction_model"
+	pbCustomer "customer-voucher-service/protogen/customer"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/pagination"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type MockTransactor struct{}
+
+func (MockTransactor) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type MockCustomerRepo struct {
+	createCustomerFunc func(customer *customer_model.Customer) error
+	listCustomerFunc   func(page *pagination.Page) ([]*customer_model.Customer, int64, error)
+	findByIdFunc       func(id uint) (*customer_model.Customer, error)
+	findByEmailFunc    func(email string) (*customer_model.Customer, error)
+	updateCustomerFunc func(customer *customer_model.Customer, history *customer_model.CustomerEmailHistory) error
+	setPointsFunc      func(id uint, points int64) error
+	findByReferralFunc func(code string) (*customer_model.Customer, error)
+	countReferralFunc  func(referrerId uint) (int64, error)
+	markRewardedFunc   func(id uint) error
+	referralReportFunc func() ([]*customer_model.ReferralReport, error)
+	findDeactivated    func(id uint) (*customer_model.Customer, error)
+	deactivateFunc     func(id uint, reason string, modifiedBy string) error
+	reactivateFunc     func(id uint, modifiedBy string) error
+}
+
+func (m *MockCustomerRepo) WithContext(ctx context.Context) customer_model.ICustomerRepo {
+	return m
+}
+
+type MockTransactionRepo struct {
+	transaction_model.ITransactionRepo
+	summarizeFunc  func(customerId uint) (*transaction_model.CustomerTransactionSummary, error)
+	listRecentFunc func(customerId uint, limit int) ([]*transaction_model.Transaction, error)
+}
+
+func (m *MockTransactionRepo) WithContext(ctx context.Context) transaction_model.ITransactionRepo {
+	return m
+}
+
+func (m *MockTransactionRepo) SummarizeTransactionByCustomer(customerId uint) (*transaction_model.CustomerTransactionSummary, error) {
+	if m.summarizeFunc != nil {
+		return m.summarizeFunc(customerId)
+	}
+	return &transaction_model.CustomerTransactionSummary{}, nil
+}
+
+func (m *MockTransactionRepo) ListRecentTransactionByCustomer(customerId uint, limit int) ([]*transaction_model.Transaction, error) {
+	if m.listRecentFunc != nil {
+		return m.listRecentFunc(customerId, limit)
+	}
+	return []*transaction_model.Transaction{}, nil
+}
+
+func (m *MockCustomerRepo) CreateCustomer(customer *customer_model.Customer) error {
+	if m.createCustomerFunc != nil {
+		return m.createCustomerFunc(customer)
+	}
+	return nil
+}
+
+func (m *MockCustomerRepo) ListCustomer(page *pagination.Page) ([]*customer_model.Customer, int64, error) {
+	if m.listCustomerFunc != nil {
+		return m.listCustomerFunc(page)
+	}
+	return []*customer_model.Customer{}, 0, nil
+}
+
+func (m *MockCustomerRepo) FindCustomerById(id uint) (*customer_model.Customer, error) {
+	if m.findByIdFunc != nil {
+		return m.findByIdFunc(id)
+	}
+	return nil, nil
+}
+
+func (m *MockCustomerRepo) FindCustomerByEmail(email string) (*customer_model.Customer, error) {
+	if m.findByEmailFunc != nil {
+		return m.findByEmailFunc(email)
+	}
+	return nil, nil
+}
+
+func (m *MockCustomerRepo) UpdateCustomer(customer *customer_model.Customer, history *customer_model.CustomerEmailHistory) error {
+	if m.updateCustomerFunc != nil {
+		return m.updateCustomerFunc(customer, history)
+	}
+	return nil
+}
+
+func (m *MockCustomerRepo) FindDeactivatedCustomerById(id uint) (*customer_model.Customer, error) {
+	if m.findDeactivated != nil {
+		return m.findDeactivated(id)
+	}
+	return nil, nil
+}
+
+func (m *MockCustomerRepo) DeactivateCustomer(id uint, reason string, modifiedBy string) error {
+	if m.deactivateFunc != nil {
+		return m.deactivateFunc(id, reason, modifiedBy)
+	}
+	return nil
+}
+
+func (m *MockCustomerRepo) ReactivateCustomer(id uint, modifiedBy string) error {
+	if m.reactivateFunc != nil {
+		return m.reactivateFunc(id, modifiedBy)
+	}
+	return nil
+}
+
+func (m *MockCustomerRepo) AddPointsCustomer(id uint, points int64) (int64, error) {
+	return points, nil
+}
+
+func (m *MockCustomerRepo) DeductPointsCustomer(id uint, points int64) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockCustomerRepo) SetPointsCustomer(id uint, points int64) error {
+	if m.setPointsFunc != nil {
+		return m.setPointsFunc(id, points)
+	}
+	return nil
+}
+
+func (m *MockCustomerRepo) FindCustomerByReferralCode(code string) (*customer_model.Customer, error) {
+	if m.findByReferralFunc != nil {
+		return m.findByReferralFunc(code)
+	}
+	return nil, nil
+}
+
+func (m *MockCustomerRepo) CountReferralCustomer(referrerId uint) (int64, error) {
+	if m.countReferralFunc != nil {
+		return m.countReferralFunc(referrerId)
+	}
+	return 0, nil
+}
+
+func (m *MockCustomerRepo) MarkReferralRewarded(id uint) error {
+	if m.markRewardedFunc != nil {
+		return m.markRewardedFunc(id)
+	}
+	return nil
+}
+
+func (m *MockCustomerRepo) ReferralReport() ([]*customer_model.ReferralReport, error) {
+	if m.referralReportFunc != nil {
+		return m.referralReportFunc()
+	}
+	return []*customer_model.ReferralReport{}, nil
+}
+
+type MockAuditRepo struct {
+	audit_model.IAuditRepo
+	entries []*audit_model.AuditLog
+}
+
+func (m *MockAuditRepo) WithContext(ctx context.Context) audit_model.IAuditRepo {
+	return m
+}
+
+func (m *MockAuditRepo) CreateAuditLog(auditLog *audit_model.AuditLog) error {
+	m.entries = append(m.entries, auditLog)
+	return nil
+}
+
+func TestCreateCustomer_Success(t *testing.T) {
+	mockRepo := &MockCustomerRepo{
+		createCustomerFunc: func(customer *customer_model.Customer) error {
+			if customer.FullName != "John Doe" || customer.Email != "john@example.com" {
+				t.Errorf("Expected customer 'John Doe' <john@example.com>, got '%s' <%s>", customer.FullName, customer.Email)
+			}
+			if len(customer.ReferralCode) != constants.ReferralCodeLength {
+				t.Errorf("Expected referral code of length %d, got '%s'", constants.ReferralCodeLength, customer.ReferralCode)
+			}
+			if customer.ReferredByID != nil {
+				t.Error("Expected ReferredByID to be nil")
+			}
+			return nil
+		},
+	}
+
+	service := &CustomerService{
+		transactor:   MockTransactor{},
+		customerRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	req := &pbCustomer.CreateCustomerReq{
+		FullName: "John Doe",
+		Email:    "john@example.com",
+		Points:   100,
+	}
+
+	result, err := service.CreateCustomer(context.Background(), req)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+}
+
+func TestCreateCustomer_WithReferralCode(t *testing.T) {
+	referralCode := "abcd2345"
+	mockRepo := &MockCustomerRepo{
+		findByReferralFunc: func(code string) (*customer_model.Customer, error) {
+			if code != "ABCD2345" {
+				t.Errorf("Expected referral code to be normalized to 'ABCD2345', got '%s'", code)
+			}
+			return &customer_model.Customer{ID: 7, Email: "referrer@example.com", ReferralCode: code}, nil
+		},
+		createCustomerFunc: func(customer *customer_model.Customer) error {
+			if customer.ReferredByID == nil || *customer.ReferredByID != 7 {
+				t.Error("Expected ReferredByID to be 7")
+			}
+			return nil
+		},
+	}
+
+	service := &CustomerService{
+		transactor:   MockTransactor{},
+		customerRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	req := &pbCustomer.CreateCustomerReq{
+		FullName:     "Jane Doe",
+		Email:        "jane@example.com",
+		ReferralCode: &referralCode,
+	}
+
+	result, err := service.CreateCustomer(context.Background(), req)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+}
+
+func TestCreateCustomer_ReferralCodeNotFound(t *testing.T) {
+	referralCode := "UNKNOWN1"
+	mockRepo := &MockCustomerRepo{
+		findByReferralFunc: func(code string) (*customer_model.Customer, error) {
+			return nil, errors.New("record not found")
+		},
+		createCustomerFunc: func(customer *customer_model.Customer) error {
+			t.Error("Expected customer not to be created")
+			return nil
+		},
+	}
+
+	service := &CustomerService{
+		transactor:   MockTransactor{},
+		customerRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	req := &pbCustomer.CreateCustomerReq{
+		FullName:     "Jane Doe",
+		Email:        "jane@example.com",
+		ReferralCode: &referralCode,
+	}
+
+	result, err := service.CreateCustomer(context.Background(), req)
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestCreateCustomer_SelfReferral(t *testing.T) {
+	referralCode := "ABCD2345"
+	mockRepo := &MockCustomerRepo{
+		findByReferralFunc: func(code string) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: 7, Email: "jane@example.com"}, nil
+		},
+	}
+
+	service := &CustomerService{
+		transactor:   MockTransactor{},
+		customerRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	req := &pbCustomer.CreateCustomerReq{
+		FullName:     "Jane Doe",
+		Email:        "Jane+second@example.com",
+		ReferralCode: &referralCode,
+	}
+
+	result, err := service.CreateCustomer(context.Background(), req)
+
+	if err == nil {
+		t.Error("Expected self referral error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestCreateCustomer_ReferralLimitReached(t *testing.T) {
+	referralCode := "ABCD2345"
+	mockRepo := &MockCustomerRepo{
+		findByReferralFunc: func(code string) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: 7, Email: "referrer@example.com"}, nil
+		},
+		countReferralFunc: func(referrerId uint) (int64, error) {
+			return constants.DefaultReferralMaxPerReferrer, nil
+		},
+	}
+
+	service := &CustomerService{
+		transactor:   MockTransactor{},
+		customerRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	req := &pbCustomer.CreateCustomerReq{
+		FullName:     "Jane Doe",
+		Email:        "jane@example.com",
+		ReferralCode: &referralCode,
+	}
+
+	result, err := service.CreateCustomer(context.Background(), req)
+
+	if err == nil {
+		t.Error("Expected referral limit error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestCreateCustomer_DuplicateEmail(t *testing.T) {
+	mockRepo := &MockCustomerRepo{
+		createCustomerFunc: func(customer *customer_model.Customer) error {
+			return gorm.ErrDuplicatedKey
+		},
+	}
+
+	service := &CustomerService{
+		transactor:   MockTransactor{},
+		customerRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	result, err := service.CreateCustomer(context.Background(), &pbCustomer.CreateCustomerReq{FullName: "John Doe", Email: "john@example.com"})
+
+	if !errors.Is(err, error_base.ErrEmailAlreadyExists) {
+		t.Errorf("Expected ErrEmailAlreadyExists, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestUpdateCustomer_Success(t *testing.T) {
+	var updated *customer_model.Customer
+	var gotHistory *customer_model.CustomerEmailHistory
+	mockRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, FullName: "John Doe", Email: "john@example.com"}, nil
+		},
+		findByEmailFunc: func(email string) (*customer_model.Customer, error) {
+			return nil, gorm.ErrRecordNotFound
+		},
+		updateCustomerFunc: func(customer *customer_model.Customer, history *customer_model.CustomerEmailHistory) error {
+			updated = customer
+			gotHistory = history
+			return nil
+		},
+	}
+
+	service := &CustomerService{
+		transactor:   MockTransactor{},
+		customerRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	req := &pbCustomer.UpdateCustomerReq{Id: 1, FullName: "John Smith", Email: " john.smith@example.com ", ModifiedBy: "admin"}
+	result, err := service.UpdateCustomer(context.Background(), req)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+	if updated == nil || updated.FullName != "John Smith" || updated.Email != "john.smith@example.com" || updated.ModifiedBy != "admin" {
+		t.Errorf("Expected customer to be updated, got %+v", updated)
+	}
+	if gotHistory == nil || gotHistory.OldEmail != "john@example.com" || gotHistory.NewEmail != "john.smith@example.com" {
+		t.Errorf("Expected email change to be recorded, got %+v", gotHistory)
+	}
+}
+
+func TestUpdateCustomer_NameOnlyHasNoHistory(t *testing.T) {
+	var gotHistory *customer_model.CustomerEmailHistory
+	mockRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, FullName: "John Doe", Email: "john@example.com"}, nil
+		},
+		updateCustomerFunc: func(customer *customer_model.Customer, history *customer_model.CustomerEmailHistory) error {
+			gotHistory = history
+			return nil
+		},
+	}
+
+	service := &CustomerService{
+		transactor:   MockTransactor{},
+		customerRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	_, err := service.UpdateCustomer(context.Background(), &pbCustomer.UpdateCustomerReq{Id: 1, FullName: "John Smith", Email: "john@example.com", ModifiedBy: "admin"})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if gotHistory != nil {
+		t.Errorf("Expected no email history, got %+v", gotHistory)
+	}
+}
+
+func TestUpdateCustomer_EmailTaken(t *testing.T) {
+	updateCalled := false
+	mockRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, Email: "john@example.com"}, nil
+		},
+		findByEmailFunc: func(email string) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: 2, Email: email}, nil
+		},
+		updateCustomerFunc: func(customer *customer_model.Customer, history *customer_model.CustomerEmailHistory) error {
+			updateCalled = true
+			return nil
+		},
+	}
+
+	service := &CustomerService{
+		transactor:   MockTransactor{},
+		customerRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	result, err := service.UpdateCustomer(context.Background(), &pbCustomer.UpdateCustomerReq{Id: 1, FullName: "John Doe", Email: "jane@example.com", ModifiedBy: "admin"})
+
+	if !errors.Is(err, error_base.ErrEmailAlreadyExists) {
+		t.Errorf("Expected ErrEmailAlreadyExists, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+	if updateCalled {
+		t.Error("Expected customer not to be updated")
+	}
+}
+
+func TestUpdateCustomer_DuplicateKeyOnSave(t *testing.T) {
+	mockRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, Email: "john@example.com"}, nil
+		},
+		findByEmailFunc: func(email string) (*customer_model.Customer, error) {
+			return nil, gorm.ErrRecordNotFound
+		},
+		updateCustomerFunc: func(customer *customer_model.Customer, history *customer_model.CustomerEmailHistory) error {
+			return gorm.ErrDuplicatedKey
+		},
+	}
+
+	service := &CustomerService{
+		transactor:   MockTransactor{},
+		customerRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	_, err := service.UpdateCustomer(context.Background(), &pbCustomer.UpdateCustomerReq{Id: 1, FullName: "John Doe", Email: "jane@example.com", ModifiedBy: "admin"})
+
+	var appErr error_base.AppError
+	if !errors.As(err, &appErr) || appErr.HttpCode != 409 {
+		t.Errorf("Expected 409 AppError, got %v", err)
+	}
+}
+
+func TestUpdateCustomer_ValidationError(t *testing.T) {
+	service := &CustomerService{
+		transactor:   MockTransactor{},
+		customerRepo: &MockCustomerRepo{},
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	cases := []*pbCustomer.UpdateCustomerReq{
+		{FullName: "John Doe", Email: "john@example.com", ModifiedBy: "admin"},
+		{Id: 1, Email: "john@example.com", ModifiedBy: "admin"},
+		{Id: 1, FullName: "John Doe", Email: "not-an-email", ModifiedBy: "admin"},
+		{Id: 1, FullName: "John Doe", Email: "john@example.com", ModifiedBy: strings.Repeat("a", 256)},
+	}
+	for _, req := range cases {
+		result, err := service.UpdateCustomer(context.Background(), req)
+		if err == nil {
+			t.Errorf("Expected validation error for %v", req)
+		}
+		if result == nil || result.IsSuccess {
+			t.Error("Expected IsSuccess to be false")
+		}
+	}
+}
+
+func TestDetailCustomer_Success(t *testing.T) {
+	lastRedeem := time.Date(2024, 5, 1, 10, 0, 0, 0, time.Local)
+	mockRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, FullName: "John Doe", Email: "john@example.com", Points: 700}, nil
+		},
+	}
+	var requestedLimit int
+	mockTransactionRepo := &MockTransactionRepo{
+		summarizeFunc: func(customerId uint) (*transaction_model.CustomerTransactionSummary, error) {
+			return &transaction_model.CustomerTransactionSummary{PointsSpent: 1500, RedemptionCount: 3, LastRedemptionDate: &lastRedeem}, nil
+		},
+		listRecentFunc: func(customerId uint, limit int) ([]*transaction_model.Transaction, error) {
+			requestedLimit = limit
+			return []*transaction_model.Transaction{
+				{ID: 3, CustomerID: customerId, Total: 500, RedeemDate: lastRedeem},
+				{ID: 2, CustomerID: customerId, Total: 500, RedeemDate: lastRedeem.Add(-time.Hour)},
+			}, nil
+		},
+	}
+	service := &CustomerService{transactor: MockTransactor{}, customerRepo: mockRepo, transactionRepo: mockTransactionRepo, auditRepo: &MockAuditRepo{}}
+
+	result, err := service.DetailCustomer(context.Background(), &pbCustomer.DetailCustomerReq{Id: 1})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Data.FullName != "John Doe" || *result.Data.Points != 700 {
+		t.Errorf("Expected customer profile, got %+v", result.Data)
+	}
+	if result.PointsSpent != 1500 || result.RedemptionCount != 3 {
+		t.Errorf("Expected summary 1500/3, got %d/%d", result.PointsSpent, result.RedemptionCount)
+	}
+	if result.LastRedemptionDate != lastRedeem.Format(constants.FormatDate) {
+		t.Errorf("Expected last redemption date %s, got %s", lastRedeem.Format(constants.FormatDate), result.LastRedemptionDate)
+	}
+	if len(result.RecentTransactions) != 2 || result.RecentTransactions[0].Id != 3 {
+		t.Errorf("Expected 2 recent transactions newest first, got %v", result.RecentTransactions)
+	}
+	if requestedLimit != constants.DefaultRecentTransactionLimit {
+		t.Errorf("Expected default limit %d, got %d", constants.DefaultRecentTransactionLimit, requestedLimit)
+	}
+}
+
+func TestDetailCustomer_NoTransactions(t *testing.T) {
+	mockRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id}, nil
+		},
+	}
+	service := &CustomerService{transactor: MockTransactor{}, customerRepo: mockRepo, transactionRepo: &MockTransactionRepo{}}
+
+	result, err := service.DetailCustomer(context.Background(), &pbCustomer.DetailCustomerReq{Id: 1, RecentLimit: 10})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.LastRedemptionDate != "" {
+		t.Errorf("Expected empty last redemption date, got %s", result.LastRedemptionDate)
+	}
+	if result.RecentTransactions == nil || len(result.RecentTransactions) != 0 {
+		t.Error("Expected empty recent transactions")
+	}
+}
+
+func TestDetailCustomer_NotFound(t *testing.T) {
+	mockRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
+	service := &CustomerService{transactor: MockTransactor{}, customerRepo: mockRepo, transactionRepo: &MockTransactionRepo{}}
+
+	result, err := service.DetailCustomer(context.Background(), &pbCustomer.DetailCustomerReq{Id: 1})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil {
+		t.Error("Expected result to not be nil")
+	}
+}
+
+func TestDetailCustomer_InvalidRecentLimit(t *testing.T) {
+	service := &CustomerService{transactor: MockTransactor{}, customerRepo: &MockCustomerRepo{}, transactionRepo: &MockTransactionRepo{}}
+
+	for _, limit := range []int32{-1, constants.MaxRecentTransactionLimit + 1} {
+		result, err := service.DetailCustomer(context.Background(), &pbCustomer.DetailCustomerReq{Id: 1, RecentLimit: limit})
+		if err == nil {
+			t.Errorf("Expected error for recentLimit %d", limit)
+		}
+		if result == nil {
+			t.Error("Expected result to not be nil")
+		}
+	}
+}
+
+func TestDeactivateCustomer_Success(t *testing.T) {
+	var deactivatedReason string
+	mockRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id}, nil
+		},
+		deactivateFunc: func(id uint, reason string, modifiedBy string) error {
+			deactivatedReason = reason
+			return nil
+		},
+	}
+	service := &CustomerService{transactor: MockTransactor{}, customerRepo: mockRepo, auditRepo: &MockAuditRepo{}}
+
+	req := &pbCustomer.DeactivateCustomerReq{Id: 1, Reason: "fraud", ModifiedBy: "admin"}
+	result, err := service.DeactivateCustomer(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+	if deactivatedReason != "fraud" {
+		t.Errorf("Expected reason to be stored, got %s", deactivatedReason)
+	}
+}
+
+func TestDeactivateCustomer_NotFound(t *testing.T) {
+	mockRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return nil, errors.New("record not found")
+		},
+	}
+	service := &CustomerService{transactor: MockTransactor{}, customerRepo: mockRepo, auditRepo: &MockAuditRepo{}}
+
+	req := &pbCustomer.DeactivateCustomerReq{Id: 1, Reason: "fraud", ModifiedBy: "admin"}
+	result, err := service.DeactivateCustomer(context.Background(), req)
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestReactivateCustomer_Success(t *testing.T) {
+	reactivated := false
+	mockRepo := &MockCustomerRepo{
+		findDeactivated: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, IsDeleted: true}, nil
+		},
+		reactivateFunc: func(id uint, modifiedBy string) error {
+			reactivated = true
+			return nil
+		},
+	}
+	service := &CustomerService{transactor: MockTransactor{}, customerRepo: mockRepo, auditRepo: &MockAuditRepo{}}
+
+	result, err := service.ReactivateCustomer(context.Background(), &pbCustomer.ReactivateCustomerReq{Id: 1, ModifiedBy: "admin"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.IsSuccess || !reactivated {
+		t.Error("Expected customer to be reactivated")
+	}
+}
+
+func TestReactivateCustomer_NotDeactivated(t *testing.T) {
+	service := &CustomerService{transactor: MockTransactor{}, customerRepo: &MockCustomerRepo{}}
+
+	result, err := service.ReactivateCustomer(context.Background(), &pbCustomer.ReactivateCustomerReq{Id: 1, ModifiedBy: "admin"})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestUpdateCustomerPoints_RecordsAuditLog(t *testing.T) {
+	mockRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, FullName: "Jane", Points: 5000}, nil
+		},
+		setPointsFunc: func(id uint, points int64) error {
+			return nil
+		},
+	}
+	mockAuditRepo := &MockAuditRepo{}
+	service := &CustomerService{transactor: MockTransactor{}, customerRepo: mockRepo, auditRepo: mockAuditRepo}
+
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{ID: "admin-1", Role: constants.RoleAdmin})
+	result, err := service.UpdateCustomerPoints(ctx, &pbCustomer.UpdateCustomerPointsReq{Id: 7, Points: 50})
+
+	if err != nil || !result.IsSuccess {
+		t.Fatalf("Expected success, got %v %v", result, err)
+	}
+	if len(mockAuditRepo.entries) != 1 {
+		t.Fatalf("Expected one audit entry, got %d", len(mockAuditRepo.entries))
+	}
+	entry := mockAuditRepo.entries[0]
+	if entry.Actor != "admin-1" || entry.Action != constants.AuditActionUpdate || entry.EntityType != constants.AuditEntityCustomer || entry.EntityID != 7 {
+		t.Errorf("Unexpected audit entry %+v", entry)
+	}
+	if entry.Before != `{"points":5000}` || entry.After != `{"points":50}` {
+		t.Errorf("Expected points 5000 -> 50, got %s -> %s", entry.Before, entry.After)
+	}
+}
+
+func TestReferralReport_Success(t *testing.T) {
+	mockRepo := &MockCustomerRepo{
+		referralReportFunc: func() ([]*customer_model.ReferralReport, error) {
+			return []*customer_model.ReferralReport{
+				{CustomerID: 1, FullName: "John Doe", ReferralCode: "ABCD2345", ReferralCount: 3, RewardedCount: 1},
+			}, nil
+		},
+	}
+
+	service := &CustomerService{
+		transactor:   MockTransactor{},
+		customerRepo: mockRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	result, err := service.ReferralReport(context.Background(), &pbCustomer.ReferralReportReq{})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if len(result.Data) != 1 {
+		t.Fatalf("Expected 1 report row, got %d", len(result.Data))
+	}
+	if result.Data[0].ReferralCount != 3 || result.Data[0].RewardedCount != 1 {
+		t.Errorf("Expected 3 referrals and 1 rewarded, got %d and %d", result.Data[0].ReferralCount, result.Data[0].RewardedCount)
+	}
+}
+
+func TestIsSelfReferral(t *testing.T) {
+	tests := []struct {
+		referrer string
+		referee  string
+		expected bool
+	}{
+		{"john@example.com", "john@example.com", true},
+		{"john@example.com", "JOHN+promo@example.com", true},
+		{"john@example.com", "jane@example.com", false},
+		{"john@example.com", "john@example.org", false},
+	}
+
+	for _, test := range tests {
+		result := IsSelfReferral(test.referrer, test.referee)
+		if result != test.expected {
+			t.Errorf("IsSelfReferral(%s, %s) = %v; expected %v", test.referrer, test.referee, result, test.expected)
+		}
+	}
+}
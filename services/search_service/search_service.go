@@ -0,0 +1,84 @@
+package search_service
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"customer-voucher-service/db"
+	"customer-voucher-service/models/search_model"
+	pbSearch "customer-voucher-service/protogen/search"
+	"customer-voucher-service/utils/validator"
+	"strings"
+)
+
+type ISearchService interface {
+	Search(ctx context.Context, req *pbSearch.SearchReq) (*pbSearch.SearchRes, error)
+}
+
+type SearchService struct {
+	pbSearch.UnimplementedSearchServiceServer
+	searchRepo search_model.ISearchRepo
+}
+
+func NewSearchService() *SearchService {
+	return &SearchService{searchRepo: search_model.NewSearchRepo(db.DB)}
+}
+
+// RequestRules are the field rules of each method, checked by the gRPC validation
+// interceptor. The methods check them again for HTTP calls.
+var RequestRules = validator.Rules{
+	pbSearch.SearchServiceSearchFullMethodName: validator.RuleFor(validateSearchReq),
+}
+
+type searchReqValidate struct {
+	Query string `validate:"required,max=100" label:"q"`
+}
+
+func validateSearchReq(ctx context.Context, req *pbSearch.SearchReq) error {
+	return validator.ValidateReqField(searchReqValidate{
+		Query: strings.TrimSpace(req.Query),
+	})
+}
+
+func (s *SearchService) Search(ctx context.Context, req *pbSearch.SearchReq) (*pbSearch.SearchRes, error) {
+	if err := validateSearchReq(ctx, req); err != nil {
+		return &pbSearch.SearchRes{}, err
+	}
+	if req.Type != nil && !IsValidSearchType(req.GetType()) {
+		return &pbSearch.SearchRes{}, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("type"))
+	}
+	if req.Limit < 0 || req.Limit > constants.MaxSearchLimit {
+		return &pbSearch.SearchRes{}, error_base.ErrValidationFailed.WithMessage(message.LimitMessage("limit", constants.MaxSearchLimit, constants.DefaultSearchLimit))
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = constants.DefaultSearchLimit
+	}
+
+	result, err := s.searchRepo.Search(req.Query, req.GetType(), limit)
+	if err != nil {
+		return nil, err
+	}
+	list := []*pbSearch.SearchResult{}
+
+	for _, r := range result {
+		data := pbSearch.SearchResult{
+			Type:        r.Type,
+			Id:          int32(r.ID),
+			Title:       r.Title,
+			Description: r.Description,
+			Rank:        r.Rank,
+			Highlight:   r.Highlight,
+		}
+		list = append(list, &data)
+	}
+	return &pbSearch.SearchRes{
+		Data: list,
+	}, nil
+}
+
+func IsValidSearchType(searchType string) bool {
+	return searchType == constants.SearchTypeVoucher || searchType == constants.SearchTypeBrand
+}
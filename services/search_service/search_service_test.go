@@ -0,0 +1,86 @@
+package search_service
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/models/search_model"
+	pbSearch "customer-voucher-service/protogen/search"
+	"errors"
+	"testing"
+)
+
+type MockSearchRepo struct {
+	searchFunc func(query string, resultType string, limit int) ([]*search_model.SearchResult, error)
+}
+
+func (m *MockSearchRepo) Search(query string, resultType string, limit int) ([]*search_model.SearchResult, error) {
+	if m.searchFunc != nil {
+		return m.searchFunc(query, resultType, limit)
+	}
+	return []*search_model.SearchResult{}, nil
+}
+
+func TestSearch_Success(t *testing.T) {
+	var gotLimit int
+	mockRepo := &MockSearchRepo{
+		searchFunc: func(query string, resultType string, limit int) ([]*search_model.SearchResult, error) {
+			gotLimit = limit
+			return []*search_model.SearchResult{
+				{Type: constants.SearchTypeVoucher, ID: 1, Title: "Kopi Susu", Rank: 0.5, Highlight: "<mark>Kopi</mark> Susu"},
+				{Type: constants.SearchTypeBrand, ID: 2, Title: "Kopi Kenangan", Rank: 0.3},
+			}, nil
+		},
+	}
+	service := &SearchService{searchRepo: mockRepo}
+
+	res, err := service.Search(context.Background(), &pbSearch.SearchReq{Query: "kopi"})
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if len(res.Data) != 2 {
+		t.Errorf("Expected 2 results, got %d", len(res.Data))
+	}
+	if res.Data[0].Type != constants.SearchTypeVoucher || res.Data[0].Highlight != "<mark>Kopi</mark> Susu" {
+		t.Errorf("Unexpected first result %v", res.Data[0])
+	}
+	if gotLimit != constants.DefaultSearchLimit {
+		t.Errorf("Expected default limit %d, got %d", constants.DefaultSearchLimit, gotLimit)
+	}
+}
+
+func TestSearch_ValidationError(t *testing.T) {
+	service := &SearchService{searchRepo: &MockSearchRepo{}}
+	invalidType := "customer"
+
+	cases := []*pbSearch.SearchReq{
+		{Query: "  "},
+		{Query: "kopi", Type: &invalidType},
+		{Query: "kopi", Limit: constants.MaxSearchLimit + 1},
+	}
+	for _, req := range cases {
+		res, err := service.Search(context.Background(), req)
+		if err == nil {
+			t.Errorf("Expected validation error for %v", req)
+		}
+		if res == nil {
+			t.Errorf("Expected non-nil response for validation error")
+		}
+	}
+}
+
+func TestSearch_RepoError(t *testing.T) {
+	mockRepo := &MockSearchRepo{
+		searchFunc: func(query string, resultType string, limit int) ([]*search_model.SearchResult, error) {
+			return nil, errors.New("db error")
+		},
+	}
+	service := &SearchService{searchRepo: mockRepo}
+
+	res, err := service.Search(context.Background(), &pbSearch.SearchReq{Query: "kopi"})
+	if err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+	if res != nil {
+		t.Errorf("Expected nil response, got %v", res)
+	}
+}
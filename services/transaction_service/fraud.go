@@ -0,0 +1,283 @@
+package transaction_service
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"customer-voucher-service/models/customer_model"
+	"customer-voucher-service/models/fraud_model"
+	"customer-voucher-service/models/transaction_model"
+	"customer-voucher-service/models/voucher_model"
+	pbTransaction "customer-voucher-service/protogen/transaction"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/env"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/validator"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Redemption is what the fraud rules see of a redemption before it is saved.
+type Redemption struct {
+	Customer *customer_model.Customer
+	Voucher  *voucher_model.Voucher
+	Quantity int64
+	Total    int64
+	DeviceID string
+	Now      time.Time
+}
+
+// FraudRule returns a flag when the redemption looks suspicious and nil otherwise.
+type FraudRule func(ctx context.Context, redemption *Redemption) (*fraud_model.FraudFlag, error)
+
+// DefaultFraudRules reads the rule thresholds from FRAUD_* variables.
+func DefaultFraudRules(transactionRepo transaction_model.ITransactionRepo) []FraudRule {
+	return []FraudRule{
+		HighCostVelocityRule(
+			transactionRepo,
+			env.GetInt64("FRAUD_HIGH_COST_POINTS", constants.DefaultFraudHighCostPoints),
+			env.GetInt64("FRAUD_HIGH_COST_MAX_REDEMPTIONS", constants.DefaultFraudHighCostMaxRedemptions),
+			time.Duration(env.GetInt64("FRAUD_HIGH_COST_WINDOW_MINUTES", constants.DefaultFraudHighCostWindowMinutes))*time.Minute,
+		),
+		NewAccountDrainRule(
+			time.Duration(env.GetInt64("FRAUD_NEW_ACCOUNT_DAYS", constants.DefaultFraudNewAccountDays))*24*time.Hour,
+			time.Duration(env.GetInt64("FRAUD_POINTS_SET_WINDOW_HOURS", constants.DefaultFraudPointsSetWindowHours))*time.Hour,
+			env.GetInt64("FRAUD_DRAIN_PERCENT", constants.DefaultFraudDrainPercent),
+		),
+		DeviceSpreadRule(
+			transactionRepo,
+			env.GetInt64("FRAUD_DEVICE_MAX_CUSTOMERS", constants.DefaultFraudDeviceMaxCustomers),
+			time.Duration(env.GetInt64("FRAUD_DEVICE_WINDOW_HOURS", constants.DefaultFraudDeviceWindowHours))*time.Hour,
+		),
+	}
+}
+
+// HighCostVelocityRule holds a redemption of a voucher costing at least minCostInPoint
+// once the customer has made more than maxRedemptions of those within window.
+func HighCostVelocityRule(transactionRepo transaction_model.ITransactionRepo, minCostInPoint int64, maxRedemptions int64, window time.Duration) FraudRule {
+	return func(ctx context.Context, redemption *Redemption) (*fraud_model.FraudFlag, error) {
+		if redemption.Voucher.CostInPoint < minCostInPoint {
+			return nil, nil
+		}
+		count, err := transactionRepo.CountHighCostRedemptionByCustomer(redemption.Customer.ID, minCostInPoint, redemption.Now.Add(-window))
+		if err != nil {
+			return nil, err
+		}
+		if count+1 <= maxRedemptions {
+			return nil, nil
+		}
+		return &fraud_model.FraudFlag{
+			Rule:     constants.FraudRuleHighCostVelocity,
+			Decision: constants.FraudDecisionHold,
+			Reason:   fmt.Sprintf("%d redemptions of vouchers costing %d points or more within %s", count+1, minCostInPoint, window),
+		}, nil
+	}
+}
+
+// NewAccountDrainRule holds a redemption by an account younger than accountAge that
+// spends at least drainPercent of its balance within pointsSetWindow of a manual
+// points change.
+func NewAccountDrainRule(accountAge time.Duration, pointsSetWindow time.Duration, drainPercent int64) FraudRule {
+	return func(ctx context.Context, redemption *Redemption) (*fraud_model.FraudFlag, error) {
+		customer := redemption.Customer
+		if customer.CreatedDate.Before(redemption.Now.Add(-accountAge)) {
+			return nil, nil
+		}
+		if customer.PointsSetDate == nil || customer.PointsSetDate.Before(redemption.Now.Add(-pointsSetWindow)) {
+			return nil, nil
+		}
+		if customer.Points <= 0 || redemption.Total*100 < customer.Points*drainPercent {
+			return nil, nil
+		}
+		return &fraud_model.FraudFlag{
+			Rule:     constants.FraudRuleNewAccountDrain,
+			Decision: constants.FraudDecisionHold,
+			Reason:   fmt.Sprintf("new account spends %d of %d points within %s of a manual points change", redemption.Total, customer.Points, pointsSetWindow),
+		}, nil
+	}
+}
+
+// DeviceSpreadRule blocks a redemption from a device that more than maxCustomers
+// customers have used to redeem the same voucher within window.
+func DeviceSpreadRule(transactionRepo transaction_model.ITransactionRepo, maxCustomers int64, window time.Duration) FraudRule {
+	return func(ctx context.Context, redemption *Redemption) (*fraud_model.FraudFlag, error) {
+		if redemption.DeviceID == "" {
+			return nil, nil
+		}
+		others, err := transactionRepo.CountOtherCustomerByDevice(redemption.DeviceID, redemption.Voucher.ID, redemption.Customer.ID, redemption.Now.Add(-window))
+		if err != nil {
+			return nil, err
+		}
+		if others+1 <= maxCustomers {
+			return nil, nil
+		}
+		return &fraud_model.FraudFlag{
+			Rule:     constants.FraudRuleDeviceSpread,
+			Decision: constants.FraudDecisionBlock,
+			Reason:   fmt.Sprintf("%d customers redeemed this voucher from the device within %s", others+1, window),
+		}, nil
+	}
+}
+
+var fraudDecisionRank = map[string]int{
+	constants.FraudDecisionAllow: 0,
+	constants.FraudDecisionHold:  1,
+	constants.FraudDecisionBlock: 2,
+}
+
+// evaluateFraud runs every rule and returns the strictest decision with all the flags.
+func (s *TransactionService) evaluateFraud(ctx context.Context, redemption *Redemption) (string, []fraud_model.FraudFlag, error) {
+	decision := constants.FraudDecisionAllow
+	flags := []fraud_model.FraudFlag{}
+	for _, rule := range s.fraudRules {
+		flag, err := rule(ctx, redemption)
+		if err != nil {
+			return "", nil, err
+		}
+		if flag == nil {
+			continue
+		}
+		flags = append(flags, *flag)
+		if fraudDecisionRank[flag.Decision] > fraudDecisionRank[decision] {
+			decision = flag.Decision
+		}
+	}
+	return decision, flags, nil
+}
+
+// ListFraudReview lists the review queue, oldest first.
+func (s *TransactionService) ListFraudReview(ctx context.Context, req *pbTransaction.ListFraudReviewReq) (*pbTransaction.ListFraudReviewRes, error) {
+	page, err := pagination.NewPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return &pbTransaction.ListFraudReviewRes{}, err
+	}
+	if req.Status != nil && !IsValidFraudReviewStatus(*req.Status) {
+		return &pbTransaction.ListFraudReviewRes{}, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("status"))
+	}
+
+	result, total, err := s.fraudRepo.ListFraudReview(req.Status, page)
+	if err != nil {
+		return nil, err
+	}
+	result, hasNext := pagination.Trim(result, page.Size)
+	list := []*pbTransaction.FraudReview{}
+	for _, review := range result {
+		list = append(list, toPbFraudReview(review))
+	}
+	res := &pbTransaction.ListFraudReviewRes{
+		Data:       list,
+		TotalCount: total,
+	}
+	if hasNext {
+		res.NextPageToken = pagination.EncodeToken(pagination.Cursor{ID: result[len(result)-1].ID})
+	}
+	return res, nil
+}
+
+type reviewTransactionReqValidate struct {
+	TransactionId int32  `validate:"required"`
+	Note          string `validate:"max=1000"`
+	ModifiedBy    string `validate:"max=255"`
+}
+
+func validateReviewTransactionReq(ctx context.Context, req *pbTransaction.ReviewTransactionReq) error {
+	return validator.ValidateReqField(reviewTransactionReqValidate{
+		TransactionId: req.TransactionId,
+		Note:          req.Note,
+		ModifiedBy:    auth.Actor(ctx, req.ModifiedBy),
+	})
+}
+
+// ReviewTransaction approves a held redemption, or rejects it and refunds its points.
+func (s *TransactionService) ReviewTransaction(ctx context.Context, req *pbTransaction.ReviewTransactionReq) (*pbTransaction.ReviewTransactionRes, error) {
+	req.ModifiedBy = auth.Actor(ctx, req.ModifiedBy)
+	if err := validateReviewTransactionReq(ctx, req); err != nil {
+		return &pbTransaction.ReviewTransactionRes{IsSuccess: false}, err
+	}
+
+	review, err := s.fraudRepo.FindFraudReviewByTransactionId(uint(req.TransactionId))
+	if err != nil || review == nil {
+		return &pbTransaction.ReviewTransactionRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("fraud review"))
+	}
+	if review.Status != constants.FraudReviewStatusPending {
+		return &pbTransaction.ReviewTransactionRes{IsSuccess: false}, error_base.ErrInvalidState.WithMessage("transaction is already reviewed")
+	}
+	trans, err := s.transactionRepo.FindTransactionById(review.TransactionID)
+	if err != nil || trans == nil {
+		return &pbTransaction.ReviewTransactionRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("transaction"))
+	}
+	// A customer deactivated since the redemption is reviewed too, and refunded on
+	// rejection like the sender of an expired gift.
+	customer, _ := s.customerRepo.FindCustomerById(trans.CustomerID)
+	if customer == nil {
+		customer, _ = s.customerRepo.FindDeactivatedCustomerById(trans.CustomerID)
+	}
+	if !req.Approve && customer == nil {
+		return &pbTransaction.ReviewTransactionRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("customer"))
+	}
+
+	reviewStatus, status := constants.FraudReviewStatusApproved, constants.TransactionStatusSuccess
+	var refund func(ctx context.Context) error
+	if !req.Approve {
+		reviewStatus, status = constants.FraudReviewStatusRejected, constants.TransactionStatusRejected
+		refund = func(ctx context.Context) error {
+			return s.addCustomerPoints(ctx, customer.ID, trans.Total)
+		}
+	}
+	// The review, the transaction and the refund change together, so a failed refund
+	// leaves the review pending to be retried.
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		err := s.fraudRepo.WithContext(ctx).CloseFraudReview(review.ID, reviewStatus, req.Note, req.ModifiedBy)
+		if err != nil {
+			return err
+		}
+		return s.updateTransactionStatus(ctx, trans, status, refund)
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbTransaction.ReviewTransactionRes{IsSuccess: false}, error_base.ErrInvalidState.WithMessage("transaction is already reviewed")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Approve && customer != nil && !customer.IsDeleted {
+		if err = s.rewardReferral(ctx, customer); err != nil {
+			log.Printf("Failed to reward referral for customer %d: %v", customer.ID, err)
+		}
+	}
+	return &pbTransaction.ReviewTransactionRes{IsSuccess: true, Data: transaction_model.ToPbTransaction(trans)}, nil
+}
+
+func IsValidFraudReviewStatus(status string) bool {
+	switch status {
+	case constants.FraudReviewStatusPending, constants.FraudReviewStatusApproved, constants.FraudReviewStatusRejected:
+		return true
+	}
+	return false
+}
+
+func toPbFraudReview(review *fraud_model.FraudReview) *pbTransaction.FraudReview {
+	flags := []*pbTransaction.FraudFlag{}
+	for _, flag := range review.Flags {
+		flags = append(flags, &pbTransaction.FraudFlag{Rule: flag.Rule, Decision: flag.Decision, Reason: flag.Reason})
+	}
+	data := &pbTransaction.FraudReview{
+		Id:            int32(review.ID),
+		TransactionId: int32(review.TransactionID),
+		CustomerId:    int32(review.CustomerID),
+		Flags:         flags,
+		Status:        review.Status,
+		Note:          review.Note,
+		ReviewedBy:    review.ReviewedBy,
+		CreatedDate:   review.CreatedDate.Format(constants.FormatDate),
+	}
+	if review.ReviewedDate != nil {
+		data.ReviewedDate = review.ReviewedDate.Format(constants.FormatDate)
+	}
+	return data
+}
@@ -0,0 +1,372 @@
+package transaction_service
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/models/customer_model"
+	"customer-voucher-service/models/fraud_model"
+	"customer-voucher-service/models/transaction_model"
+	"customer-voucher-service/models/voucher_model"
+	pbTransaction "customer-voucher-service/protogen/transaction"
+	"customer-voucher-service/utils/pagination"
+	"errors"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type MockFraudRepo struct {
+	reviews   []*fraud_model.FraudReview
+	createErr error
+	onClose   func()
+}
+
+func (m *MockFraudRepo) WithContext(ctx context.Context) fraud_model.IFraudRepo {
+	return m
+}
+
+func (m *MockFraudRepo) CreateFraudReview(review *fraud_model.FraudReview) error {
+	if m.createErr != nil {
+		return m.createErr
+	}
+	review.ID = uint(len(m.reviews) + 1)
+	m.reviews = append(m.reviews, review)
+	return nil
+}
+
+func (m *MockFraudRepo) ListFraudReview(status *string, page *pagination.Page) ([]*fraud_model.FraudReview, int64, error) {
+	result := []*fraud_model.FraudReview{}
+	for _, review := range m.reviews {
+		if status == nil || review.Status == *status {
+			result = append(result, review)
+		}
+	}
+	return result, int64(len(result)), nil
+}
+
+func (m *MockFraudRepo) FindFraudReviewByTransactionId(transactionId uint) (*fraud_model.FraudReview, error) {
+	for _, review := range m.reviews {
+		if review.TransactionID == transactionId {
+			copied := *review
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockFraudRepo) CloseFraudReview(id uint, status string, note string, reviewedBy string) error {
+	if m.onClose != nil {
+		m.onClose()
+	}
+	for _, review := range m.reviews {
+		if review.ID == id && review.Status == constants.FraudReviewStatusPending {
+			review.Status, review.Note, review.ReviewedBy = status, note, reviewedBy
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+func TestHighCostVelocityRule(t *testing.T) {
+	now := time.Now()
+	var since time.Time
+	repo := &MockTransactionRepo{
+		countHighCostFunc: func(customerId uint, minCostInPoint int64, s time.Time) (int64, error) {
+			since = s
+			return 3, nil
+		},
+	}
+	rule := HighCostVelocityRule(repo, 1000, 3, 10*time.Minute)
+	redemption := &Redemption{
+		Customer: &customer_model.Customer{ID: 1},
+		Voucher:  &voucher_model.Voucher{ID: 1, CostInPoint: 1500},
+		Now:      now,
+	}
+
+	flag, err := rule(context.Background(), redemption)
+	if err != nil || flag == nil || flag.Decision != constants.FraudDecisionHold {
+		t.Fatalf("Expected a hold flag, got %+v %v", flag, err)
+	}
+	if !since.Equal(now.Add(-10 * time.Minute)) {
+		t.Errorf("Expected window to start 10 minutes ago, got %v", since)
+	}
+
+	redemption.Voucher.CostInPoint = 500
+	if flag, _ := rule(context.Background(), redemption); flag != nil {
+		t.Errorf("Expected cheap vouchers to pass, got %+v", flag)
+	}
+}
+
+func TestNewAccountDrainRule(t *testing.T) {
+	now := time.Now()
+	rule := NewAccountDrainRule(7*24*time.Hour, 24*time.Hour, 80)
+	pointsSet := now.Add(-time.Hour)
+	customer := &customer_model.Customer{ID: 1, Points: 10000, CreatedDate: now.Add(-24 * time.Hour), PointsSetDate: &pointsSet}
+
+	flag, _ := rule(context.Background(), &Redemption{Customer: customer, Total: 9000, Now: now})
+	if flag == nil || flag.Rule != constants.FraudRuleNewAccountDrain {
+		t.Fatalf("Expected a new account drain flag, got %+v", flag)
+	}
+
+	if flag, _ := rule(context.Background(), &Redemption{Customer: customer, Total: 1000, Now: now}); flag != nil {
+		t.Errorf("Expected a small spend to pass, got %+v", flag)
+	}
+	oldCustomer := *customer
+	oldCustomer.CreatedDate = now.Add(-30 * 24 * time.Hour)
+	if flag, _ := rule(context.Background(), &Redemption{Customer: &oldCustomer, Total: 9000, Now: now}); flag != nil {
+		t.Errorf("Expected an old account to pass, got %+v", flag)
+	}
+	untouched := *customer
+	untouched.PointsSetDate = nil
+	if flag, _ := rule(context.Background(), &Redemption{Customer: &untouched, Total: 9000, Now: now}); flag != nil {
+		t.Errorf("Expected an account without manual points to pass, got %+v", flag)
+	}
+}
+
+func TestDeviceSpreadRule(t *testing.T) {
+	repo := &MockTransactionRepo{
+		countDeviceFunc: func(deviceId string, voucherId uint, customerId uint, since time.Time) (int64, error) {
+			return 3, nil
+		},
+	}
+	rule := DeviceSpreadRule(repo, 3, time.Hour)
+	redemption := &Redemption{
+		Customer: &customer_model.Customer{ID: 1},
+		Voucher:  &voucher_model.Voucher{ID: 1},
+		DeviceID: "device-1",
+		Now:      time.Now(),
+	}
+
+	flag, _ := rule(context.Background(), redemption)
+	if flag == nil || flag.Decision != constants.FraudDecisionBlock {
+		t.Fatalf("Expected a block flag, got %+v", flag)
+	}
+	redemption.DeviceID = ""
+	if flag, _ := rule(context.Background(), redemption); flag != nil {
+		t.Errorf("Expected redemptions without a device to pass, got %+v", flag)
+	}
+}
+
+// RollbackTransactor counts the outermost transactions that returned an error, and so
+// would have been rolled back.
+type RollbackTransactor struct {
+	depth      *int
+	rolledBack *int
+}
+
+func (t RollbackTransactor) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	*t.depth++
+	err := fn(ctx)
+	*t.depth--
+	if err != nil && *t.depth == 0 {
+		*t.rolledBack++
+	}
+	return err
+}
+
+func flagRule(decision string) FraudRule {
+	return func(ctx context.Context, redemption *Redemption) (*fraud_model.FraudFlag, error) {
+		return &fraud_model.FraudFlag{Rule: "test", Decision: decision, Reason: "test"}, nil
+	}
+}
+
+func newFraudTestService(customer *customer_model.Customer, rules ...FraudRule) (*TransactionService, *MockFraudRepo, *[]*transaction_model.Transaction) {
+	created := []*transaction_model.Transaction{}
+	fraudRepo := &MockFraudRepo{}
+	service := &TransactionService{
+		transactionRepo: &MockTransactionRepo{
+			createTransactionFunc: func(transaction *transaction_model.Transaction) (*transaction_model.Transaction, error) {
+				transaction.ID = uint(len(created) + 1)
+				created = append(created, transaction)
+				return transaction, nil
+			},
+			findByIdFunc: func(id uint) (*transaction_model.Transaction, error) {
+				for _, transaction := range created {
+					if transaction.ID == id {
+						copied := *transaction
+						return &copied, nil
+					}
+				}
+				return nil, gorm.ErrRecordNotFound
+			},
+			updateStatusFunc: func(id uint, from int32, to int32) error {
+				if created[id-1].Status != from {
+					return gorm.ErrRecordNotFound
+				}
+				created[id-1].Status = to
+				return nil
+			},
+		},
+		voucherRepo: &MockVoucherRepo{
+			findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+				return &voucher_model.Voucher{ID: id, CostInPoint: 100}, nil
+			},
+		},
+		customerRepo: &MockCustomerRepo{
+			findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+				copied := *customer
+				return &copied, nil
+			},
+			addPointsFunc: func(id uint, points int64) (int64, error) {
+				customer.Points += points
+				return customer.Points, nil
+			},
+			deductPointsFunc: func(id uint, points int64) (int64, error) {
+				customer.Points -= points
+				return customer.Points, nil
+			},
+		},
+		auditRepo:  &MockAuditRepo{},
+		fraudRepo:  fraudRepo,
+		fraudRules: rules,
+		transactor: MockTransactor{},
+	}
+	return service, fraudRepo, &created
+}
+
+func TestTransactionRedeemPoint_FraudBlock(t *testing.T) {
+	customer := &customer_model.Customer{ID: 1, Points: 1000}
+	service, fraudRepo, created := newFraudTestService(customer, flagRule(constants.FraudDecisionHold), flagRule(constants.FraudDecisionBlock))
+
+	req := &pbTransaction.TransactionRedeemPointReq{CustomerId: 1, VoucherId: 1, Quantity: 2, DeviceId: "device-1"}
+	result, err := service.TransactionRedeemPoint(context.Background(), req)
+
+	if !errors.Is(err, error_base.ErrRedemptionBlocked) {
+		t.Errorf("Expected ErrRedemptionBlocked, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+	if len(*created) != 0 || len(fraudRepo.reviews) != 0 || customer.Points != 1000 {
+		t.Error("Expected a blocked redemption to change nothing")
+	}
+}
+
+func TestTransactionRedeemPoint_FraudHoldAndReject(t *testing.T) {
+	customer := &customer_model.Customer{ID: 1, Points: 1000}
+	service, fraudRepo, _ := newFraudTestService(customer, flagRule(constants.FraudDecisionAllow), flagRule(constants.FraudDecisionHold))
+
+	req := &pbTransaction.TransactionRedeemPointReq{CustomerId: 1, VoucherId: 1, Quantity: 2, DeviceId: "device-1"}
+	result, err := service.TransactionRedeemPoint(context.Background(), req)
+	if err != nil || !result.IsSuccess {
+		t.Fatalf("Expected held redemption to succeed, got %v", err)
+	}
+	if *result.Data.Status != constants.TransactionStatusHeld || result.Data.DeviceId != "device-1" {
+		t.Errorf("Expected a held transaction from device-1, got %+v", result.Data)
+	}
+	if customer.Points != 800 {
+		t.Errorf("Expected points to stay deducted while held, got %d", customer.Points)
+	}
+	if len(fraudRepo.reviews) != 1 || len(fraudRepo.reviews[0].Flags) != 2 || fraudRepo.reviews[0].Status != constants.FraudReviewStatusPending {
+		t.Fatalf("Expected one pending review with both flags, got %+v", fraudRepo.reviews)
+	}
+
+	reviewRes, err := service.ReviewTransaction(context.Background(), &pbTransaction.ReviewTransactionReq{TransactionId: result.Data.Id, Approve: false, Note: "stolen account", ModifiedBy: "admin"})
+	if err != nil || !reviewRes.IsSuccess {
+		t.Fatalf("Expected review to succeed, got %v", err)
+	}
+	if *reviewRes.Data.Status != constants.TransactionStatusRejected || customer.Points != 1000 {
+		t.Errorf("Expected a rejected transaction and a refund, got status %d and %d points", *reviewRes.Data.Status, customer.Points)
+	}
+	if fraudRepo.reviews[0].Status != constants.FraudReviewStatusRejected || fraudRepo.reviews[0].ReviewedBy != "admin" {
+		t.Errorf("Unexpected review %+v", fraudRepo.reviews[0])
+	}
+
+	reviewRes, err = service.ReviewTransaction(context.Background(), &pbTransaction.ReviewTransactionReq{TransactionId: result.Data.Id, Approve: true, ModifiedBy: "admin"})
+	if err == nil || reviewRes.IsSuccess {
+		t.Error("Expected a second review to fail")
+	}
+}
+
+func TestReviewTransaction_Approve(t *testing.T) {
+	customer := &customer_model.Customer{ID: 1, Points: 1000}
+	service, fraudRepo, created := newFraudTestService(customer, flagRule(constants.FraudDecisionHold))
+
+	result, _ := service.TransactionRedeemPoint(context.Background(), &pbTransaction.TransactionRedeemPointReq{CustomerId: 1, VoucherId: 1, Quantity: 1})
+	reviewRes, err := service.ReviewTransaction(context.Background(), &pbTransaction.ReviewTransactionReq{TransactionId: result.Data.Id, Approve: true, ModifiedBy: "admin"})
+
+	if err != nil || !reviewRes.IsSuccess {
+		t.Fatalf("Expected review to succeed, got %v", err)
+	}
+	if (*created)[0].Status != constants.TransactionStatusSuccess || customer.Points != 900 {
+		t.Errorf("Expected an approved transaction keeping its points, got status %d and %d points", (*created)[0].Status, customer.Points)
+	}
+	if fraudRepo.reviews[0].Status != constants.FraudReviewStatusApproved {
+		t.Errorf("Expected approved review, got %s", fraudRepo.reviews[0].Status)
+	}
+}
+
+func TestTransactionRedeemPoint_FraudHoldReviewFails(t *testing.T) {
+	customer := &customer_model.Customer{ID: 1, Points: 1000}
+	service, fraudRepo, _ := newFraudTestService(customer, flagRule(constants.FraudDecisionHold))
+	var depth, rolledBack int
+	service.transactor = RollbackTransactor{depth: &depth, rolledBack: &rolledBack}
+	fraudRepo.createErr = errors.New("db down")
+
+	_, err := service.TransactionRedeemPoint(context.Background(), &pbTransaction.TransactionRedeemPointReq{CustomerId: 1, VoucherId: 1, Quantity: 1})
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if rolledBack != 1 {
+		t.Errorf("Expected the transaction and its deduction to roll back with the review, got %d rollbacks", rolledBack)
+	}
+}
+
+func TestReviewTransaction_RejectDeactivatedCustomer(t *testing.T) {
+	customer := &customer_model.Customer{ID: 1, Points: 1000}
+	service, fraudRepo, created := newFraudTestService(customer, flagRule(constants.FraudDecisionHold))
+	result, _ := service.TransactionRedeemPoint(context.Background(), &pbTransaction.TransactionRedeemPointReq{CustomerId: 1, VoucherId: 1, Quantity: 1})
+
+	customerRepo := service.customerRepo.(*MockCustomerRepo)
+	customerRepo.findByIdFunc = func(id uint) (*customer_model.Customer, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	customerRepo.findDeactivated = func(id uint) (*customer_model.Customer, error) {
+		return &customer_model.Customer{ID: id, IsDeleted: true}, nil
+	}
+	reviewRes, err := service.ReviewTransaction(context.Background(), &pbTransaction.ReviewTransactionReq{TransactionId: result.Data.Id, Approve: false, ModifiedBy: "admin"})
+
+	if err != nil || !reviewRes.IsSuccess {
+		t.Fatalf("Expected review to succeed, got %v", err)
+	}
+	if (*created)[0].Status != constants.TransactionStatusRejected || customer.Points != 1000 {
+		t.Errorf("Expected a rejected transaction and a refund, got status %d and %d points", (*created)[0].Status, customer.Points)
+	}
+	if fraudRepo.reviews[0].Status != constants.FraudReviewStatusRejected {
+		t.Errorf("Expected rejected review, got %s", fraudRepo.reviews[0].Status)
+	}
+}
+
+func TestReviewTransaction_RefundFails(t *testing.T) {
+	customer := &customer_model.Customer{ID: 1, Points: 1000}
+	service, fraudRepo, _ := newFraudTestService(customer, flagRule(constants.FraudDecisionHold))
+	result, _ := service.TransactionRedeemPoint(context.Background(), &pbTransaction.TransactionRedeemPointReq{CustomerId: 1, VoucherId: 1, Quantity: 1})
+
+	var depth, rolledBack, closedAtDepth int
+	service.transactor = RollbackTransactor{depth: &depth, rolledBack: &rolledBack}
+	fraudRepo.onClose = func() { closedAtDepth = depth }
+	service.customerRepo.(*MockCustomerRepo).addPointsFunc = func(id uint, points int64) (int64, error) {
+		return 0, errors.New("db down")
+	}
+	_, err := service.ReviewTransaction(context.Background(), &pbTransaction.ReviewTransactionReq{TransactionId: result.Data.Id, Approve: false, ModifiedBy: "admin"})
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if rolledBack != 1 || closedAtDepth != 1 {
+		t.Errorf("Expected the review and the status change to roll back with the refund, got %d rollbacks and the review closed at depth %d", rolledBack, closedAtDepth)
+	}
+}
+
+func TestListFraudReview_InvalidStatus(t *testing.T) {
+	service := &TransactionService{fraudRepo: &MockFraudRepo{}}
+	status := "unknown"
+
+	_, err := service.ListFraudReview(context.Background(), &pbTransaction.ListFraudReviewReq{Status: &status})
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+}
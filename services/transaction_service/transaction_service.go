@@ -3,21 +3,36 @@ package transaction_service
 import (
 	"context"
 	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
 	"customer-voucher-service/constants/message"
 	"customer-voucher-service/db"
+	"customer-voucher-service/models/audit_model"
 	"customer-voucher-service/models/customer_model"
+	"customer-voucher-service/models/fraud_model"
 	"customer-voucher-service/models/transaction_model"
 	"customer-voucher-service/models/voucher_model"
 	pbTransaction "customer-voucher-service/protogen/transaction"
+	pbVoucher "customer-voucher-service/protogen/voucher"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/env"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
 	"customer-voucher-service/utils/validator"
 	"errors"
+	"log"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type ITransactionService interface {
 	TransactionRedeemPoint(ctx context.Context, req *pbTransaction.TransactionRedeemPointReq) (*pbTransaction.TransactionRedeemPointRes, error)
 	ListTransaction(ctx context.Context, req *pbTransaction.ListTransactionReq) (*pbTransaction.ListTransactionRes, error)
 	DetailTransaction(ctx context.Context, req *pbTransaction.DetailTransactionReq) (*pbTransaction.DetailTransactionRes, error)
+	GiftVoucher(ctx context.Context, req *pbTransaction.GiftVoucherReq) (*pbTransaction.GiftVoucherRes, error)
+	ClaimGiftVoucher(ctx context.Context, req *pbTransaction.ClaimGiftVoucherReq) (*pbTransaction.ClaimGiftVoucherRes, error)
+	ListFraudReview(ctx context.Context, req *pbTransaction.ListFraudReviewReq) (*pbTransaction.ListFraudReviewRes, error)
+	ReviewTransaction(ctx context.Context, req *pbTransaction.ReviewTransactionReq) (*pbTransaction.ReviewTransactionRes, error)
 }
 
 type TransactionService struct {
@@ -25,48 +40,99 @@ type TransactionService struct {
 	transactionRepo transaction_model.ITransactionRepo
 	voucherRepo     voucher_model.IVoucherRepo
 	customerRepo    customer_model.ICustomerRepo
+	auditRepo       audit_model.IAuditRepo
+	fraudRepo       fraud_model.IFraudRepo
+	fraudRules      []FraudRule
+	transactor      transactor.Transactor
 }
 
 func NewTransactionService() *TransactionService {
+	transactionRepo := transaction_model.NewTransactionRepo(db.DB)
 	return &TransactionService{
-		transactionRepo: transaction_model.NewTransactionRepo(db.DB),
+		transactionRepo: transactionRepo,
 		voucherRepo:     voucher_model.NewVoucherRepo(db.DB),
 		customerRepo:    customer_model.NewCustomerRepo(db.DB),
+		auditRepo:       audit_model.NewAuditRepo(db.DB),
+		fraudRepo:       fraud_model.NewFraudRepo(db.DB),
+		fraudRules:      DefaultFraudRules(transactionRepo),
+		transactor:      transactor.New(db.DB),
 	}
 }
 
+// RequestRules are the field rules of each method, checked by the gRPC validation
+// interceptor. The methods check them again for HTTP calls.
+var RequestRules = validator.Rules{
+	pbTransaction.TransactionServiceTransactionRedeemPointFullMethodName: validator.RuleFor(validateTransactionRedeemPointReq),
+	pbTransaction.TransactionServiceGiftVoucherFullMethodName:            validator.RuleFor(validateGiftVoucherReq),
+	pbTransaction.TransactionServiceClaimGiftVoucherFullMethodName:       validator.RuleFor(validateClaimGiftVoucherReq),
+	pbTransaction.TransactionServiceReviewTransactionFullMethodName:      validator.RuleFor(validateReviewTransactionReq),
+}
+
 type createTransactionReqValidate struct {
-	CustomerId int32 `validate:"required"`
-	VoucherId  int32 `validate:"required"`
-	Quantity   int64 `validate:"required"`
+	CustomerId int32  `validate:"required"`
+	VoucherId  int32  `validate:"required"`
+	Quantity   int64  `validate:"required,gte=1"`
+	DeviceId   string `validate:"max=255"`
 }
 
-func (s *TransactionService) TransactionRedeemPoint(ctx context.Context, req *pbTransaction.TransactionRedeemPointReq) (*pbTransaction.TransactionRedeemPointRes, error) {
-	validateReq := createTransactionReqValidate{
+func validateTransactionRedeemPointReq(ctx context.Context, req *pbTransaction.TransactionRedeemPointReq) error {
+	return validator.ValidateReqField(createTransactionReqValidate{
 		CustomerId: req.CustomerId,
 		VoucherId:  req.VoucherId,
 		Quantity:   req.Quantity,
+		DeviceId:   req.DeviceId,
+	})
+}
+
+func (s *TransactionService) TransactionRedeemPoint(ctx context.Context, req *pbTransaction.TransactionRedeemPointReq) (*pbTransaction.TransactionRedeemPointRes, error) {
+	if err := validateTransactionRedeemPointReq(ctx, req); err != nil {
+		return &pbTransaction.TransactionRedeemPointRes{IsSuccess: false}, err
 	}
-	if err := validator.ValidateReqField(validateReq); err != nil {
+
+	if err := auth.AuthorizeCustomer(ctx, uint(req.CustomerId)); err != nil {
 		return &pbTransaction.TransactionRedeemPointRes{IsSuccess: false}, err
 	}
 
 	// check customer
-	resCustomer, err := s.customerRepo.FindCustomerById(uint(req.CustomerId))
-	if err != nil || resCustomer == nil {
-		return &pbTransaction.TransactionRedeemPointRes{IsSuccess: false}, errors.New(message.NotFoundMessage("customer"))
+	resCustomer, err := s.findActiveCustomer(uint(req.CustomerId), "customer")
+	if err != nil {
+		return &pbTransaction.TransactionRedeemPointRes{IsSuccess: false}, err
 	}
 
 	// check voucher
 	resVoucher, err := s.voucherRepo.FindVoucherById(uint(req.VoucherId))
 	if err != nil || resVoucher == nil {
-		return &pbTransaction.TransactionRedeemPointRes{IsSuccess: false}, errors.New(message.NotFoundMessage("voucher"))
+		return &pbTransaction.TransactionRedeemPointRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("voucher"))
+	}
+	if err := auth.AuthorizeBurn(ctx, resVoucher.BrandID); err != nil {
+		return &pbTransaction.TransactionRedeemPointRes{IsSuccess: false}, err
 	}
 
 	totalRedeem := CalculateTotalPointRedeem(resVoucher.CostInPoint, req.Quantity)
 
 	if !IsAbleToRedeem(totalRedeem, resCustomer.Points) {
-		return &pbTransaction.TransactionRedeemPointRes{IsSuccess: false}, errors.New("not enough points to redeem")
+		return &pbTransaction.TransactionRedeemPointRes{IsSuccess: false}, error_base.ErrInsufficientPoints
+	}
+
+	redemption := &Redemption{
+		Customer: resCustomer,
+		Voucher:  resVoucher,
+		Quantity: req.Quantity,
+		Total:    totalRedeem,
+		DeviceID: req.DeviceId,
+		Now:      time.Now(),
+	}
+	decision, flags, err := s.evaluateFraud(ctx, redemption)
+	if err != nil {
+		return nil, err
+	}
+	if decision == constants.FraudDecisionBlock {
+		log.Printf("Blocked redemption of voucher %d by customer %d: %+v", resVoucher.ID, resCustomer.ID, flags)
+		return &pbTransaction.TransactionRedeemPointRes{IsSuccess: false}, error_base.ErrRedemptionBlocked
+	}
+	status := constants.TransactionStatusSuccess
+	if decision == constants.FraudDecisionHold {
+		status = constants.TransactionStatusHeld
 	}
 
 	transaction := &transaction_model.Transaction{
@@ -75,18 +141,37 @@ func (s *TransactionService) TransactionRedeemPoint(ctx context.Context, req *pb
 		Quantity:           req.Quantity,
 		VoucherCostInPoint: resVoucher.CostInPoint,
 		Total:              totalRedeem,
-		Status:             1,
-		RedeemDate:         time.Now(),
+		Status:             status,
+		RedeemDate:         redemption.Now,
+		DeviceID:           req.DeviceId,
 	}
 
-	result, err := s.transactionRepo.CreateTransaction(transaction)
+	// A held redemption keeps its points deducted until it is reviewed, and only
+	// counts towards the referral reward once approved. Its review is created with
+	// it, so a held transaction is never left out of the queue.
+	var hold func(ctx context.Context, result *transaction_model.Transaction) error
+	if decision == constants.FraudDecisionHold {
+		hold = func(ctx context.Context, result *transaction_model.Transaction) error {
+			return s.fraudRepo.WithContext(ctx).CreateFraudReview(&fraud_model.FraudReview{
+				TransactionID: result.ID,
+				CustomerID:    resCustomer.ID,
+				Flags:         flags,
+				Status:        constants.FraudReviewStatusPending,
+			})
+		}
+	}
+	result, err := s.createTransaction(ctx, transaction, hold)
+	if errors.Is(err, error_base.ErrInsufficientPoints) {
+		return &pbTransaction.TransactionRedeemPointRes{IsSuccess: false}, err
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.customerRepo.UpdatePointsCustomer(resCustomer.ID, RedundantPointsCustomer(result.Total, resCustomer.Points))
-	if err != nil {
-		return nil, err
+	if decision != constants.FraudDecisionHold {
+		if err = s.rewardReferral(ctx, resCustomer); err != nil {
+			log.Printf("Failed to reward referral for customer %d: %v", resCustomer.ID, err)
+		}
 	}
 
 	return &pbTransaction.TransactionRedeemPointRes{
@@ -99,10 +184,90 @@ func (s *TransactionService) TransactionRedeemPoint(ctx context.Context, req *pb
 			Total:      result.Total,
 			Status:     &result.Status,
 			RedeemDate: result.RedeemDate.Format(constants.FormatDate),
+			DeviceId:   result.DeviceID,
 		},
 	}, nil
 }
 
+// createTransaction saves the transaction and deducts its total from the customer in one
+// database transaction, so a balance spent concurrently is never overdrawn. then, if set,
+// runs last in the same database transaction.
+func (s *TransactionService) createTransaction(ctx context.Context, transaction *transaction_model.Transaction, then func(ctx context.Context, result *transaction_model.Transaction) error) (*transaction_model.Transaction, error) {
+	var result *transaction_model.Transaction
+	err := s.transactor.Run(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = s.transactionRepo.WithContext(ctx).CreateTransaction(transaction)
+		if err != nil {
+			return err
+		}
+		err = audit_model.Record(ctx, s.auditRepo, constants.AuditActionCreate, constants.AuditEntityTransaction, result.ID, nil, result)
+		if err != nil {
+			return err
+		}
+
+		balance, err := s.customerRepo.WithContext(ctx).DeductPointsCustomer(transaction.CustomerID, transaction.Total)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return error_base.ErrInsufficientPoints
+		}
+		if err != nil {
+			return err
+		}
+		err = s.recordPointsChange(ctx, transaction.CustomerID, balance, -transaction.Total)
+		if err != nil || then == nil {
+			return err
+		}
+		return then(ctx, result)
+	})
+	return result, err
+}
+
+// rewardReferral credits both the referee and the referrer once the referee completes their
+// first redemption. Marking the referee rewarded only succeeds once, so concurrent
+// redemptions pay the bonus a single time.
+func (s *TransactionService) rewardReferral(ctx context.Context, customer *customer_model.Customer) error {
+	if customer.ReferredByID == nil || customer.ReferralRewarded {
+		return nil
+	}
+	err := s.transactor.Run(ctx, func(ctx context.Context) error {
+		err := s.customerRepo.WithContext(ctx).MarkReferralRewarded(customer.ID)
+		if err != nil {
+			return err
+		}
+		before := customer_model.Customer{ID: customer.ID}
+		after := customer_model.Customer{ID: customer.ID, ReferralRewarded: true}
+		err = audit_model.Record(ctx, s.auditRepo, constants.AuditActionUpdate, constants.AuditEntityCustomer, customer.ID, &before, &after)
+		if err != nil {
+			return err
+		}
+		refereeBonus := env.GetInt64("REFERRAL_REFEREE_BONUS_POINTS", constants.DefaultReferralRefereeBonusPoints)
+		if err = s.addCustomerPoints(ctx, customer.ID, refereeBonus); err != nil {
+			return err
+		}
+		referrerBonus := env.GetInt64("REFERRAL_REFERRER_BONUS_POINTS", constants.DefaultReferralReferrerBonusPoints)
+		return s.addCustomerPoints(ctx, *customer.ReferredByID, referrerBonus)
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	return err
+}
+
+// addCustomerPoints credits the customer and records the change in the audit log.
+func (s *TransactionService) addCustomerPoints(ctx context.Context, customerId uint, points int64) error {
+	balance, err := s.customerRepo.WithContext(ctx).AddPointsCustomer(customerId, points)
+	if err != nil {
+		return err
+	}
+	return s.recordPointsChange(ctx, customerId, balance, points)
+}
+
+// recordPointsChange audits a change of points that left the customer with balance.
+func (s *TransactionService) recordPointsChange(ctx context.Context, customerId uint, balance int64, points int64) error {
+	before := customer_model.Customer{ID: customerId, Points: balance - points}
+	after := customer_model.Customer{ID: customerId, Points: balance}
+	return audit_model.Record(ctx, s.auditRepo, constants.AuditActionUpdate, constants.AuditEntityCustomer, customerId, &before, &after)
+}
+
 func CalculateTotalPointRedeem(cip int64, qty int64) int64 {
 	total := cip * qty
 	return total
@@ -117,56 +282,401 @@ func IsAbleToRedeem(total int64, custPoints int64) bool {
 }
 
 func (s *TransactionService) ListTransaction(ctx context.Context, req *pbTransaction.ListTransactionReq) (*pbTransaction.ListTransactionRes, error) {
-	result, err := s.transactionRepo.ListTransaction(req)
+	page, err := pagination.NewPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return &pbTransaction.ListTransactionRes{}, err
+	}
+	if err := validateListTransactionReq(req, page); err != nil {
+		return &pbTransaction.ListTransactionRes{}, err
+	}
+	// Customers only see their own transactions, so their list defaults to themselves.
+	if caller, ok := auth.CallerFromContext(ctx); ok && caller.Role == constants.RoleCustomer && req.CustomerId == nil {
+		customerId := int32(caller.CustomerID)
+		req.CustomerId = &customerId
+	}
+	if req.CustomerId != nil {
+		if err := auth.AuthorizeCustomer(ctx, uint(*req.CustomerId)); err != nil {
+			return &pbTransaction.ListTransactionRes{}, err
+		}
+	}
+
+	result, total, err := s.transactionRepo.ListTransaction(req, page)
 	if err != nil {
 		return nil, err
 	}
+	result, hasNext := pagination.Trim(result, page.Size)
 	list := []*pbTransaction.Transaction{}
 
 	for _, trans := range result {
-		status := int32(trans.Status)
-		isDeleted := trans.IsDeleted
-		data := pbTransaction.Transaction{
-			Id:           int32(trans.ID),
-			CustomerId:   int32(trans.CustomerID),
-			VoucherId:    int32(trans.VoucherID),
-			Quantity:     trans.Quantity,
-			Total:        int64(trans.Total),
-			Status:       &status,
-			RedeemDate:   trans.RedeemDate.Format(constants.FormatDate),
-			CreatedDate:  trans.CreatedDate.Format(constants.FormatDate),
-			ModifiedDate: trans.ModifiedDate.Format(constants.FormatDate),
-			IsDeleted:    &isDeleted,
-		}
-		list = append(list, &data)
-	}
-	return &pbTransaction.ListTransactionRes{
-		Data: list,
-	}, nil
+		list = append(list, transaction_model.ToPbTransaction(trans))
+	}
+	res := &pbTransaction.ListTransactionRes{
+		Data:       list,
+		TotalCount: total,
+	}
+	if hasNext {
+		last := result[len(result)-1]
+		res.NextPageToken = pagination.EncodeToken(pagination.Cursor{ID: last.ID, Value: transaction_model.SortValue(last, req.SortBy)})
+	}
+	return res, nil
+}
+
+// validateListTransactionReq checks the filters and sort of a transaction
+// list request and normalizes its date range to constants.FormatDate.
+func validateListTransactionReq(req *pbTransaction.ListTransactionReq, page *pagination.Page) error {
+	if req.SortBy != "" && req.SortBy != constants.TransactionSortByRedeemDate && req.SortBy != constants.TransactionSortByTotal {
+		return error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("sortBy"))
+	}
+	if req.SortOrder != "" && req.SortOrder != constants.SortOrderAsc && req.SortOrder != constants.SortOrderDesc {
+		return error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("sortOrder"))
+	}
+	if page.Cursor != nil && req.SortBy != "" {
+		if _, err := transaction_model.ParseSortValue(req.SortBy, page.Cursor.Value); err != nil {
+			return error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("pageToken"))
+		}
+	}
+	if req.Status != nil && !IsValidTransactionStatus(*req.Status) {
+		return error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("status"))
+	}
+	if req.MinTotal != nil && req.MaxTotal != nil && *req.MinTotal > *req.MaxTotal {
+		return error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("total range"))
+	}
+
+	if req.StartDate != nil {
+		startDate, err := parseDateFilter(*req.StartDate, false)
+		if err != nil {
+			return error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("startDate"))
+		}
+		formatted := startDate.Format(constants.FormatDate)
+		req.StartDate = &formatted
+	}
+	if req.EndDate != nil {
+		endDate, err := parseDateFilter(*req.EndDate, true)
+		if err != nil {
+			return error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("endDate"))
+		}
+		formatted := endDate.Format(constants.FormatDate)
+		req.EndDate = &formatted
+	}
+	if req.StartDate != nil && req.EndDate != nil && *req.StartDate > *req.EndDate {
+		return error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("date range"))
+	}
+	return nil
+}
+
+// parseDateFilter accepts either constants.FormatDate or constants.FormatDay.
+// A bare day used as the end of a range covers the whole day.
+func parseDateFilter(value string, endOfDay bool) (time.Time, error) {
+	if date, err := time.ParseInLocation(constants.FormatDate, value, time.Local); err == nil {
+		return date, nil
+	}
+	date, err := time.ParseInLocation(constants.FormatDay, value, time.Local)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endOfDay {
+		date = date.Add(24*time.Hour - time.Second)
+	}
+	return date, nil
+}
+
+func IsValidTransactionStatus(status int32) bool {
+	switch status {
+	case constants.TransactionStatusSuccess, constants.TransactionStatusGiftPending, constants.TransactionStatusGiftClaimed, constants.TransactionStatusGiftExpired,
+		constants.TransactionStatusHeld, constants.TransactionStatusRejected:
+		return true
+	}
+	return false
 }
 
 func (s *TransactionService) DetailTransaction(ctx context.Context, req *pbTransaction.DetailTransactionReq) (*pbTransaction.DetailTransactionRes, error) {
-	result, err := s.transactionRepo.FindTransactionById(uint(req.Id))
+	result, err := s.transactionRepo.DetailTransaction(req)
+	if err != nil || result == nil {
+		return nil, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("transaction"))
+	}
+	// A gift can be read by both its sender and its recipient.
+	if err := auth.AuthorizeCustomer(ctx, result.CustomerID); err != nil {
+		if result.RecipientID == nil || auth.AuthorizeCustomer(ctx, *result.RecipientID) != nil {
+			return nil, err
+		}
+	}
+
+	// Deleted vouchers are still resolved so that history keeps its details.
+	resVoucher, err := s.voucherRepo.FindVoucherByIdWithDeleted(result.VoucherID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	return &pbTransaction.DetailTransactionRes{
+		Data:    transaction_model.ToPbTransaction(result),
+		Voucher: toPbVoucher(resVoucher),
+	}, nil
+}
+
+// findActiveCustomer tells a deactivated customer apart from a missing one.
+func (s *TransactionService) findActiveCustomer(id uint, label string) (*customer_model.Customer, error) {
+	customer, err := s.customerRepo.FindCustomerById(id)
+	if err == nil && customer != nil {
+		return customer, nil
+	}
+	deactivated, err := s.customerRepo.FindDeactivatedCustomerById(id)
+	if err == nil && deactivated != nil {
+		return nil, error_base.ErrInvalidState.WithMessagef("%s is deactivated", label)
+	}
+	return nil, error_base.ErrNotFound.WithMessage(message.NotFoundMessage(label))
+}
+
+type giftVoucherReqValidate struct {
+	SenderId       int32  `validate:"required"`
+	RecipientEmail string `validate:"required,email,max=255"`
+	VoucherId      int32  `validate:"required"`
+	Quantity       int64  `validate:"required,gte=1"`
+	GiftMessage    string `validate:"max=255"`
+}
+
+func validateGiftVoucherReq(ctx context.Context, req *pbTransaction.GiftVoucherReq) error {
+	return validator.ValidateReqField(giftVoucherReqValidate{
+		SenderId:       req.SenderId,
+		RecipientEmail: req.RecipientEmail,
+		VoucherId:      req.VoucherId,
+		Quantity:       req.Quantity,
+		GiftMessage:    req.GiftMessage,
+	})
+}
+
+func (s *TransactionService) GiftVoucher(ctx context.Context, req *pbTransaction.GiftVoucherReq) (*pbTransaction.GiftVoucherRes, error) {
+	if err := validateGiftVoucherReq(ctx, req); err != nil {
+		return &pbTransaction.GiftVoucherRes{IsSuccess: false}, err
+	}
+	if err := auth.AuthorizeCustomer(ctx, uint(req.SenderId)); err != nil {
+		return &pbTransaction.GiftVoucherRes{IsSuccess: false}, err
+	}
+
+	// check sender
+	resSender, err := s.findActiveCustomer(uint(req.SenderId), "sender")
+	if err != nil {
+		return &pbTransaction.GiftVoucherRes{IsSuccess: false}, err
+	}
+
+	// check recipient
+	resRecipient, err := s.customerRepo.FindCustomerByEmail(req.RecipientEmail)
+	if err != nil || resRecipient == nil {
+		return &pbTransaction.GiftVoucherRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("recipient"))
+	}
+	if resRecipient.ID == resSender.ID {
+		return &pbTransaction.GiftVoucherRes{IsSuccess: false}, error_base.ErrValidationFailed.WithMessage("cannot gift voucher to yourself")
+	}
+
+	// check voucher
+	resVoucher, err := s.voucherRepo.FindVoucherById(uint(req.VoucherId))
+	if err != nil || resVoucher == nil {
+		return &pbTransaction.GiftVoucherRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("voucher"))
+	}
+
+	totalRedeem := CalculateTotalPointRedeem(resVoucher.CostInPoint, req.Quantity)
+
+	if !IsAbleToRedeem(totalRedeem, resSender.Points) {
+		return &pbTransaction.GiftVoucherRes{IsSuccess: false}, error_base.ErrInsufficientPoints
+	}
+
+	now := time.Now()
+	giftExpiredDate := now.Add(constants.GiftExpiryDuration)
+	transaction := &transaction_model.Transaction{
+		CustomerID:         resSender.ID,
+		VoucherID:          resVoucher.ID,
+		Quantity:           req.Quantity,
+		VoucherCostInPoint: resVoucher.CostInPoint,
+		Total:              totalRedeem,
+		Status:             constants.TransactionStatusGiftPending,
+		RedeemDate:         now,
+		RecipientID:        &resRecipient.ID,
+		GiftMessage:        req.GiftMessage,
+		GiftExpiredDate:    &giftExpiredDate,
+	}
+
+	// The voucher row stays locked until the gift is committed, so UpdateVoucher cannot change
+	// its cost in between. The gift is priced at the locked cost.
+	var result *transaction_model.Transaction
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		locked, err := s.voucherRepo.WithContext(ctx).FindVoucherByIdForShare(resVoucher.ID)
+		if err != nil {
+			return err
+		}
+		transaction.VoucherCostInPoint = locked.CostInPoint
+		transaction.Total = CalculateTotalPointRedeem(locked.CostInPoint, req.Quantity)
+		result, err = s.createTransaction(ctx, transaction, nil)
+		return err
+	})
+	if errors.Is(err, error_base.ErrInsufficientPoints) {
+		return &pbTransaction.GiftVoucherRes{IsSuccess: false}, err
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbTransaction.GiftVoucherRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("voucher"))
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	status := int32(result.Status)
-	isDeleted := result.IsDeleted
-	data := &pbTransaction.Transaction{
-		Id:           int32(result.ID),
-		CustomerId:   int32(result.CustomerID),
-		VoucherId:    int32(result.VoucherID),
-		Quantity:     result.Quantity,
-		Total:        int64(result.Total),
-		Status:       &status,
-		RedeemDate:   result.RedeemDate.Format(constants.FormatDate),
-		CreatedDate:  result.CreatedDate.Format(constants.FormatDate),
-		ModifiedDate: result.ModifiedDate.Format(constants.FormatDate),
-		IsDeleted:    &isDeleted,
+	return &pbTransaction.GiftVoucherRes{
+		IsSuccess: true,
+		Data:      transaction_model.ToPbTransaction(result),
+	}, nil
+}
+
+type claimGiftVoucherReqValidate struct {
+	TransactionId int32 `validate:"required"`
+	RecipientId   int32 `validate:"required"`
+}
+
+func validateClaimGiftVoucherReq(ctx context.Context, req *pbTransaction.ClaimGiftVoucherReq) error {
+	return validator.ValidateReqField(claimGiftVoucherReqValidate{
+		TransactionId: req.TransactionId,
+		RecipientId:   req.RecipientId,
+	})
+}
+
+func (s *TransactionService) ClaimGiftVoucher(ctx context.Context, req *pbTransaction.ClaimGiftVoucherReq) (*pbTransaction.ClaimGiftVoucherRes, error) {
+	if err := validateClaimGiftVoucherReq(ctx, req); err != nil {
+		return &pbTransaction.ClaimGiftVoucherRes{IsSuccess: false}, err
+	}
+	if err := auth.AuthorizeCustomer(ctx, uint(req.RecipientId)); err != nil {
+		return &pbTransaction.ClaimGiftVoucherRes{IsSuccess: false}, err
 	}
 
-	return &pbTransaction.DetailTransactionRes{
-		Data: data,
+	resTransaction, err := s.transactionRepo.FindTransactionById(uint(req.TransactionId))
+	if err != nil || resTransaction == nil || resTransaction.RecipientID == nil || *resTransaction.RecipientID != uint(req.RecipientId) {
+		return &pbTransaction.ClaimGiftVoucherRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("gift"))
+	}
+	if resTransaction.Status != constants.TransactionStatusGiftPending {
+		return &pbTransaction.ClaimGiftVoucherRes{IsSuccess: false}, error_base.ErrInvalidState.WithMessage("gift is no longer claimable")
+	}
+	if IsGiftExpired(resTransaction, time.Now()) {
+		return &pbTransaction.ClaimGiftVoucherRes{IsSuccess: false}, error_base.ErrInvalidState.WithMessage("gift has expired")
+	}
+	// The recipient may have been deactivated after the gift was sent.
+	if _, err := s.findActiveCustomer(uint(req.RecipientId), "recipient"); err != nil {
+		return &pbTransaction.ClaimGiftVoucherRes{IsSuccess: false}, err
+	}
+
+	err = s.updateTransactionStatus(ctx, resTransaction, constants.TransactionStatusGiftClaimed, nil)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbTransaction.ClaimGiftVoucherRes{IsSuccess: false}, error_base.ErrInvalidState.WithMessage("gift is no longer claimable")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbTransaction.ClaimGiftVoucherRes{
+		IsSuccess: true,
+		Data:      transaction_model.ToPbTransaction(resTransaction),
 	}, nil
 }
+
+// ExpireGiftVoucher returns the points of every unclaimed gift past its expiry date to the
+// sender, also when the sender has been deactivated since.
+func (s *TransactionService) ExpireGiftVoucher(ctx context.Context) (int, error) {
+	result, err := s.transactionRepo.ListExpiredGiftTransaction(time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, trans := range result {
+		refund := func(ctx context.Context) error {
+			err := s.addCustomerPoints(ctx, trans.CustomerID, trans.Total)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Printf("gift %d expired but sender %d not found, points not refunded", trans.ID, trans.CustomerID)
+				return nil
+			}
+			return err
+		}
+		err = s.updateTransactionStatus(ctx, trans, constants.TransactionStatusGiftExpired, refund)
+		// The gift was claimed after it was listed.
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// updateTransactionStatus moves trans from its current status to status and runs then,
+// if set, in the same database transaction. It returns gorm.ErrRecordNotFound when
+// trans is no longer in the status it was read with, and then nothing is written.
+func (s *TransactionService) updateTransactionStatus(ctx context.Context, trans *transaction_model.Transaction, status int32, then func(ctx context.Context) error) error {
+	before := *trans
+	err := s.transactor.Run(ctx, func(ctx context.Context) error {
+		err := s.transactionRepo.WithContext(ctx).UpdateTransactionStatusFrom(trans.ID, before.Status, status)
+		if err != nil {
+			return err
+		}
+		after := before
+		after.Status = status
+		err = audit_model.Record(ctx, s.auditRepo, constants.AuditActionUpdate, constants.AuditEntityTransaction, trans.ID, &before, &after)
+		if err != nil || then == nil {
+			return err
+		}
+		return then(ctx)
+	})
+	if err != nil {
+		return err
+	}
+	trans.Status = status
+	return nil
+}
+
+// StartGiftExpiryJob expires due gifts every interval until ctx is done. A run in
+// progress is cancelled with ctx and rolls back. The returned channel is closed once
+// the job has stopped.
+func (s *TransactionService) StartGiftExpiryJob(ctx context.Context, interval time.Duration) <-chan struct{} {
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			expired, err := s.ExpireGiftVoucher(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Println("Failed to expire gift vouchers:", err)
+				}
+				continue
+			}
+			if expired > 0 {
+				log.Printf("Expired %d gift vouchers", expired)
+			}
+		}
+	}()
+	return stopped
+}
+
+func IsGiftExpired(trans *transaction_model.Transaction, now time.Time) bool {
+	return trans.GiftExpiredDate != nil && !now.Before(*trans.GiftExpiredDate)
+}
+
+func toPbVoucher(voucher *voucher_model.Voucher) *pbVoucher.Voucher {
+	if voucher == nil {
+		return nil
+	}
+	isDeleted := voucher.IsDeleted
+	return &pbVoucher.Voucher{
+		Id:            int32(voucher.ID),
+		BrandId:       int32(voucher.BrandID),
+		Name:          voucher.Name,
+		Description:   voucher.Description,
+		CostInPoint:   voucher.CostInPoint,
+		CreatedDate:   voucher.CreatedDate.Format(constants.FormatDate),
+		ModifiedDate:  voucher.ModifiedDate.Format(constants.FormatDate),
+		IsDeleted:     &isDeleted,
+		VoucherCode:   voucher.VoucherCode,
+		DeletedReason: voucher.DeletedReason,
+	}
+}
@@ -2,21 +2,39 @@ package transaction_service
 
 import (
 	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/models/audit_model"
 	"customer-voucher-service/models/customer_model"
 	"customer-voucher-service/models/transaction_model"
 	"customer-voucher-service/models/voucher_model"
 	pbTransaction "customer-voucher-service/protogen/transaction"
 	pbVoucher "customer-voucher-service/protogen/voucher"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/pagination"
 	"errors"
 	"testing"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type MockTransactionRepo struct {
 	createTransactionFunc func(transaction *transaction_model.Transaction) (*transaction_model.Transaction, error)
 	findByIdFunc          func(id uint) (*transaction_model.Transaction, error)
-	listTransactionFunc   func(req *pbTransaction.ListTransactionReq) ([]*transaction_model.Transaction, error)
+	listTransactionFunc   func(req *pbTransaction.ListTransactionReq, page *pagination.Page) ([]*transaction_model.Transaction, int64, error)
 	detailTransactionFunc func(req *pbTransaction.DetailTransactionReq) (*transaction_model.Transaction, error)
+	updateStatusFunc      func(id uint, from int32, to int32) error
+	listExpiredGiftFunc   func(now time.Time) ([]*transaction_model.Transaction, error)
+	countPendingFunc      func(voucherId uint) (int64, error)
+	summarizeFunc         func(customerId uint) (*transaction_model.CustomerTransactionSummary, error)
+	listRecentFunc        func(customerId uint, limit int) ([]*transaction_model.Transaction, error)
+	countHighCostFunc     func(customerId uint, minCostInPoint int64, since time.Time) (int64, error)
+	countDeviceFunc       func(deviceId string, voucherId uint, customerId uint, since time.Time) (int64, error)
+}
+
+func (m *MockTransactionRepo) WithContext(ctx context.Context) transaction_model.ITransactionRepo {
+	return m
 }
 
 func (m *MockTransactionRepo) CreateTransaction(transaction *transaction_model.Transaction) (*transaction_model.Transaction, error) {
@@ -33,11 +51,11 @@ func (m *MockTransactionRepo) FindTransactionById(id uint) (*transaction_model.T
 	return nil, nil
 }
 
-func (m *MockTransactionRepo) ListTransaction(req *pbTransaction.ListTransactionReq) ([]*transaction_model.Transaction, error) {
+func (m *MockTransactionRepo) ListTransaction(req *pbTransaction.ListTransactionReq, page *pagination.Page) ([]*transaction_model.Transaction, int64, error) {
 	if m.listTransactionFunc != nil {
-		return m.listTransactionFunc(req)
+		return m.listTransactionFunc(req, page)
 	}
-	return []*transaction_model.Transaction{}, nil
+	return []*transaction_model.Transaction{}, 0, nil
 }
 
 func (m *MockTransactionRepo) DetailTransaction(req *pbTransaction.DetailTransactionReq) (*transaction_model.Transaction, error) {
@@ -47,10 +65,79 @@ func (m *MockTransactionRepo) DetailTransaction(req *pbTransaction.DetailTransac
 	return nil, nil
 }
 
+func (m *MockTransactionRepo) UpdateTransactionStatusFrom(id uint, from int32, to int32) error {
+	if m.updateStatusFunc != nil {
+		return m.updateStatusFunc(id, from, to)
+	}
+	return nil
+}
+
+func (m *MockTransactionRepo) ListExpiredGiftTransaction(now time.Time) ([]*transaction_model.Transaction, error) {
+	if m.listExpiredGiftFunc != nil {
+		return m.listExpiredGiftFunc(now)
+	}
+	return []*transaction_model.Transaction{}, nil
+}
+
+func (m *MockTransactionRepo) CountPendingTransactionByVoucher(voucherId uint) (int64, error) {
+	if m.countPendingFunc != nil {
+		return m.countPendingFunc(voucherId)
+	}
+	return 0, nil
+}
+
+func (m *MockTransactionRepo) SummarizeTransactionByCustomer(customerId uint) (*transaction_model.CustomerTransactionSummary, error) {
+	if m.summarizeFunc != nil {
+		return m.summarizeFunc(customerId)
+	}
+	return &transaction_model.CustomerTransactionSummary{}, nil
+}
+
+func (m *MockTransactionRepo) ListRecentTransactionByCustomer(customerId uint, limit int) ([]*transaction_model.Transaction, error) {
+	if m.listRecentFunc != nil {
+		return m.listRecentFunc(customerId, limit)
+	}
+	return []*transaction_model.Transaction{}, nil
+}
+
+func (m *MockTransactionRepo) CountHighCostRedemptionByCustomer(customerId uint, minCostInPoint int64, since time.Time) (int64, error) {
+	if m.countHighCostFunc != nil {
+		return m.countHighCostFunc(customerId, minCostInPoint, since)
+	}
+	return 0, nil
+}
+
+func (m *MockTransactionRepo) CountOtherCustomerByDevice(deviceId string, voucherId uint, customerId uint, since time.Time) (int64, error) {
+	if m.countDeviceFunc != nil {
+		return m.countDeviceFunc(deviceId, voucherId, customerId, since)
+	}
+	return 0, nil
+}
+
+type MockTransactor struct{}
+
+func (MockTransactor) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 type MockVoucherRepo struct {
 	createVoucherFunc func(voucher *voucher_model.Voucher) error
-	listVoucherFunc   func(req *pbVoucher.ListVoucherReq) ([]*voucher_model.Voucher, error)
+	listVoucherFunc   func(req *pbVoucher.ListVoucherReq, page *pagination.Page) ([]*voucher_model.Voucher, int64, error)
 	findByIdFunc      func(id uint) (*voucher_model.Voucher, error)
+	findForUpdateFunc func(id uint) (*voucher_model.Voucher, error)
+	findForShareFunc  func(id uint) (*voucher_model.Voucher, error)
+	findOrCreateTags  func(names []string) ([]voucher_model.Tag, error)
+	replaceTagsFunc   func(voucher *voucher_model.Voucher, tags []voucher_model.Tag) error
+	listTagFunc       func(page *pagination.Page) ([]*voucher_model.Tag, int64, error)
+
+	updateVoucherFunc func(voucher *voucher_model.Voucher) error
+	findDeletedFunc   func(id uint) (*voucher_model.Voucher, error)
+	deleteFunc        func(id uint, reason string, modifiedBy string) error
+	restoreFunc       func(id uint, modifiedBy string) error
+}
+
+func (m *MockVoucherRepo) WithContext(ctx context.Context) voucher_model.IVoucherRepo {
+	return m
 }
 
 func (m *MockVoucherRepo) CreateVoucher(voucher *voucher_model.Voucher) error {
@@ -60,11 +147,11 @@ func (m *MockVoucherRepo) CreateVoucher(voucher *voucher_model.Voucher) error {
 	return nil
 }
 
-func (m *MockVoucherRepo) ListVoucher(req *pbVoucher.ListVoucherReq) ([]*voucher_model.Voucher, error) {
+func (m *MockVoucherRepo) ListVoucher(req *pbVoucher.ListVoucherReq, page *pagination.Page) ([]*voucher_model.Voucher, int64, error) {
 	if m.listVoucherFunc != nil {
-		return m.listVoucherFunc(req)
+		return m.listVoucherFunc(req, page)
 	}
-	return []*voucher_model.Voucher{}, nil
+	return []*voucher_model.Voucher{}, 0, nil
 }
 
 func (m *MockVoucherRepo) FindVoucherById(id uint) (*voucher_model.Voucher, error) {
@@ -74,11 +161,97 @@ func (m *MockVoucherRepo) FindVoucherById(id uint) (*voucher_model.Voucher, erro
 	return nil, nil
 }
 
+func (m *MockVoucherRepo) FindVoucherByIdForUpdate(id uint) (*voucher_model.Voucher, error) {
+	if m.findForUpdateFunc != nil {
+		return m.findForUpdateFunc(id)
+	}
+	return m.FindVoucherById(id)
+}
+
+func (m *MockVoucherRepo) FindVoucherByIdForShare(id uint) (*voucher_model.Voucher, error) {
+	if m.findForShareFunc != nil {
+		return m.findForShareFunc(id)
+	}
+	return m.FindVoucherById(id)
+}
+
+func (m *MockVoucherRepo) UpdateVoucher(voucher *voucher_model.Voucher) error {
+	if m.updateVoucherFunc != nil {
+		return m.updateVoucherFunc(voucher)
+	}
+	return nil
+}
+
+func (m *MockVoucherRepo) FindVoucherByIdWithDeleted(id uint) (*voucher_model.Voucher, error) {
+	if m.findDeletedFunc != nil {
+		return m.findDeletedFunc(id)
+	}
+	return nil, nil
+}
+
+func (m *MockVoucherRepo) DeleteVoucher(id uint, reason string, modifiedBy string) error {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(id, reason, modifiedBy)
+	}
+	return nil
+}
+
+func (m *MockVoucherRepo) RestoreVoucher(id uint, modifiedBy string) error {
+	if m.restoreFunc != nil {
+		return m.restoreFunc(id, modifiedBy)
+	}
+	return nil
+}
+
+func (m *MockVoucherRepo) FindOrCreateTags(names []string) ([]voucher_model.Tag, error) {
+	if m.findOrCreateTags != nil {
+		return m.findOrCreateTags(names)
+	}
+	tags := []voucher_model.Tag{}
+	for i, name := range names {
+		tags = append(tags, voucher_model.Tag{ID: uint(i + 1), Name: name})
+	}
+	return tags, nil
+}
+
+func (m *MockVoucherRepo) ReplaceVoucherTags(voucher *voucher_model.Voucher, tags []voucher_model.Tag) error {
+	if m.replaceTagsFunc != nil {
+		return m.replaceTagsFunc(voucher, tags)
+	}
+	return nil
+}
+
+func (m *MockVoucherRepo) ListTag(page *pagination.Page) ([]*voucher_model.Tag, int64, error) {
+	if m.listTagFunc != nil {
+		return m.listTagFunc(page)
+	}
+	return []*voucher_model.Tag{}, 0, nil
+}
+
+func (m *MockVoucherRepo) DeleteTag(id uint) (*voucher_model.Tag, []*voucher_model.Voucher, error) {
+	return &voucher_model.Tag{ID: id}, []*voucher_model.Voucher{}, nil
+}
+
 type MockCustomerRepo struct {
 	createCustomerFunc func(customer *customer_model.Customer) error
-	listCustomerFunc   func() ([]*customer_model.Customer, error)
+	listCustomerFunc   func(page *pagination.Page) ([]*customer_model.Customer, int64, error)
 	findByIdFunc       func(id uint) (*customer_model.Customer, error)
-	updatePointsFunc   func(id uint, newPoints int64) error
+	findByEmailFunc    func(email string) (*customer_model.Customer, error)
+	updateCustomerFunc func(customer *customer_model.Customer, history *customer_model.CustomerEmailHistory) error
+	addPointsFunc      func(id uint, points int64) (int64, error)
+	deductPointsFunc   func(id uint, points int64) (int64, error)
+	setPointsFunc      func(id uint, points int64) error
+	findByReferralFunc func(code string) (*customer_model.Customer, error)
+	countReferralFunc  func(referrerId uint) (int64, error)
+	markRewardedFunc   func(id uint) error
+	referralReportFunc func() ([]*customer_model.ReferralReport, error)
+	findDeactivated    func(id uint) (*customer_model.Customer, error)
+	deactivateFunc     func(id uint, reason string, modifiedBy string) error
+	reactivateFunc     func(id uint, modifiedBy string) error
+}
+
+func (m *MockCustomerRepo) WithContext(ctx context.Context) customer_model.ICustomerRepo {
+	return m
 }
 
 func (m *MockCustomerRepo) CreateCustomer(customer *customer_model.Customer) error {
@@ -88,11 +261,11 @@ func (m *MockCustomerRepo) CreateCustomer(customer *customer_model.Customer) err
 	return nil
 }
 
-func (m *MockCustomerRepo) ListCustomer() ([]*customer_model.Customer, error) {
+func (m *MockCustomerRepo) ListCustomer(page *pagination.Page) ([]*customer_model.Customer, int64, error) {
 	if m.listCustomerFunc != nil {
-		return m.listCustomerFunc()
+		return m.listCustomerFunc(page)
 	}
-	return []*customer_model.Customer{}, nil
+	return []*customer_model.Customer{}, 0, nil
 }
 
 func (m *MockCustomerRepo) FindCustomerById(id uint) (*customer_model.Customer, error) {
@@ -102,10 +275,101 @@ func (m *MockCustomerRepo) FindCustomerById(id uint) (*customer_model.Customer,
 	return nil, nil
 }
 
-func (m *MockCustomerRepo) UpdatePointsCustomer(id uint, newPoints int64) error {
-	if m.updatePointsFunc != nil {
-		return m.updatePointsFunc(id, newPoints)
+func (m *MockCustomerRepo) FindCustomerByEmail(email string) (*customer_model.Customer, error) {
+	if m.findByEmailFunc != nil {
+		return m.findByEmailFunc(email)
+	}
+	return nil, nil
+}
+
+func (m *MockCustomerRepo) UpdateCustomer(customer *customer_model.Customer, history *customer_model.CustomerEmailHistory) error {
+	if m.updateCustomerFunc != nil {
+		return m.updateCustomerFunc(customer, history)
+	}
+	return nil
+}
+
+func (m *MockCustomerRepo) FindDeactivatedCustomerById(id uint) (*customer_model.Customer, error) {
+	if m.findDeactivated != nil {
+		return m.findDeactivated(id)
+	}
+	return nil, nil
+}
+
+func (m *MockCustomerRepo) DeactivateCustomer(id uint, reason string, modifiedBy string) error {
+	if m.deactivateFunc != nil {
+		return m.deactivateFunc(id, reason, modifiedBy)
+	}
+	return nil
+}
+
+func (m *MockCustomerRepo) ReactivateCustomer(id uint, modifiedBy string) error {
+	if m.reactivateFunc != nil {
+		return m.reactivateFunc(id, modifiedBy)
+	}
+	return nil
+}
+
+func (m *MockCustomerRepo) AddPointsCustomer(id uint, points int64) (int64, error) {
+	if m.addPointsFunc != nil {
+		return m.addPointsFunc(id, points)
+	}
+	return points, nil
+}
+
+func (m *MockCustomerRepo) DeductPointsCustomer(id uint, points int64) (int64, error) {
+	if m.deductPointsFunc != nil {
+		return m.deductPointsFunc(id, points)
+	}
+	return 0, nil
+}
+
+func (m *MockCustomerRepo) SetPointsCustomer(id uint, points int64) error {
+	if m.setPointsFunc != nil {
+		return m.setPointsFunc(id, points)
+	}
+	return nil
+}
+
+func (m *MockCustomerRepo) FindCustomerByReferralCode(code string) (*customer_model.Customer, error) {
+	if m.findByReferralFunc != nil {
+		return m.findByReferralFunc(code)
+	}
+	return nil, nil
+}
+
+func (m *MockCustomerRepo) CountReferralCustomer(referrerId uint) (int64, error) {
+	if m.countReferralFunc != nil {
+		return m.countReferralFunc(referrerId)
 	}
+	return 0, nil
+}
+
+func (m *MockCustomerRepo) MarkReferralRewarded(id uint) error {
+	if m.markRewardedFunc != nil {
+		return m.markRewardedFunc(id)
+	}
+	return nil
+}
+
+func (m *MockCustomerRepo) ReferralReport() ([]*customer_model.ReferralReport, error) {
+	if m.referralReportFunc != nil {
+		return m.referralReportFunc()
+	}
+	return []*customer_model.ReferralReport{}, nil
+}
+
+type MockAuditRepo struct {
+	audit_model.IAuditRepo
+	entries []*audit_model.AuditLog
+}
+
+func (m *MockAuditRepo) WithContext(ctx context.Context) audit_model.IAuditRepo {
+	return m
+}
+
+func (m *MockAuditRepo) CreateAuditLog(auditLog *audit_model.AuditLog) error {
+	m.entries = append(m.entries, auditLog)
 	return nil
 }
 
@@ -154,18 +418,22 @@ func TestTransactionRedeemPoint_Success(t *testing.T) {
 			}
 			return nil, errors.New("customer not found")
 		},
-		updatePointsFunc: func(id uint, newPoints int64) error {
-			if id == 1 && newPoints == 800 {
-				return nil
+		deductPointsFunc: func(id uint, points int64) (int64, error) {
+			if id == 1 && points == 200 {
+				return 800, nil
 			}
-			return errors.New("update points failed")
+			return 0, errors.New("update points failed")
 		},
 	}
 
+	mockAuditRepo := &MockAuditRepo{}
+
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
 		voucherRepo:     mockVoucherRepo,
 		customerRepo:    mockCustomerRepo,
+		auditRepo:       mockAuditRepo,
 	}
 
 	req := &pbTransaction.TransactionRedeemPointReq{
@@ -203,6 +471,12 @@ func TestTransactionRedeemPoint_Success(t *testing.T) {
 	if result.Data.Total != 200 {
 		t.Errorf("Expected total to be 200, got %d", result.Data.Total)
 	}
+	if len(mockAuditRepo.entries) != 2 {
+		t.Fatalf("Expected the transaction and the points change to be audited, got %d entries", len(mockAuditRepo.entries))
+	}
+	if entry := mockAuditRepo.entries[1]; entry.EntityType != constants.AuditEntityCustomer || entry.Before != `{"points":1000}` || entry.After != `{"points":800}` {
+		t.Errorf("Expected customer points 1000 -> 800 in the audit log, got %+v", entry)
+	}
 }
 
 func TestTransactionRedeemPoint_ValidationError_EmptyCustomerId(t *testing.T) {
@@ -211,9 +485,11 @@ func TestTransactionRedeemPoint_ValidationError_EmptyCustomerId(t *testing.T) {
 	mockCustomerRepo := &MockCustomerRepo{}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
 		voucherRepo:     mockVoucherRepo,
 		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.TransactionRedeemPointReq{
@@ -241,9 +517,11 @@ func TestTransactionRedeemPoint_ValidationError_EmptyVoucherId(t *testing.T) {
 	mockCustomerRepo := &MockCustomerRepo{}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
 		voucherRepo:     mockVoucherRepo,
 		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.TransactionRedeemPointReq{
@@ -271,9 +549,11 @@ func TestTransactionRedeemPoint_ValidationError_EmptyQuantity(t *testing.T) {
 	mockCustomerRepo := &MockCustomerRepo{}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
 		voucherRepo:     mockVoucherRepo,
 		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.TransactionRedeemPointReq{
@@ -295,6 +575,32 @@ func TestTransactionRedeemPoint_ValidationError_EmptyQuantity(t *testing.T) {
 	}
 }
 
+func TestTransactionRedeemPoint_ValidationError_NegativeQuantity(t *testing.T) {
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: &MockTransactionRepo{},
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo:    &MockCustomerRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	req := &pbTransaction.TransactionRedeemPointReq{
+		CustomerId: 1,
+		VoucherId:  1,
+		Quantity:   -1,
+	}
+
+	result, err := service.TransactionRedeemPoint(context.Background(), req)
+
+	var appErr error_base.AppError
+	if !errors.As(err, &appErr) || len(appErr.Fields) != 1 || appErr.Fields[0].Field != "quantity" || appErr.Fields[0].Rule != "gte" {
+		t.Errorf("Expected a gte violation on quantity, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
 func TestTransactionRedeemPoint_CustomerNotFound(t *testing.T) {
 	mockTransactionRepo := &MockTransactionRepo{}
 	mockVoucherRepo := &MockVoucherRepo{}
@@ -305,9 +611,11 @@ func TestTransactionRedeemPoint_CustomerNotFound(t *testing.T) {
 	}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
 		voucherRepo:     mockVoucherRepo,
 		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.TransactionRedeemPointReq{
@@ -329,6 +637,40 @@ func TestTransactionRedeemPoint_CustomerNotFound(t *testing.T) {
 	}
 }
 
+func TestTransactionRedeemPoint_CustomerDeactivated(t *testing.T) {
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return nil, errors.New("record not found")
+		},
+		findDeactivated: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, IsDeleted: true, DeactivatedReason: "fraud"}, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: &MockTransactionRepo{},
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	req := &pbTransaction.TransactionRedeemPointReq{
+		CustomerId: 1,
+		VoucherId:  1,
+		Quantity:   1,
+	}
+
+	result, err := service.TransactionRedeemPoint(context.Background(), req)
+
+	if err == nil || err.Error() != "customer is deactivated" {
+		t.Errorf("Expected deactivated error, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
 func TestTransactionRedeemPoint_VoucherNotFound(t *testing.T) {
 	mockCustomer := &customer_model.Customer{
 		ID:     1,
@@ -351,9 +693,11 @@ func TestTransactionRedeemPoint_VoucherNotFound(t *testing.T) {
 	}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
 		voucherRepo:     mockVoucherRepo,
 		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.TransactionRedeemPointReq{
@@ -405,9 +749,11 @@ func TestTransactionRedeemPoint_NotEnoughPoints(t *testing.T) {
 	}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
 		voucherRepo:     mockVoucherRepo,
 		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.TransactionRedeemPointReq{
@@ -466,9 +812,11 @@ func TestTransactionRedeemPoint_CreateTransactionError(t *testing.T) {
 	}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
 		voucherRepo:     mockVoucherRepo,
 		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.TransactionRedeemPointReq{
@@ -519,15 +867,17 @@ func TestTransactionRedeemPoint_UpdatePointsError(t *testing.T) {
 			}
 			return nil, errors.New("customer not found")
 		},
-		updatePointsFunc: func(id uint, newPoints int64) error {
-			return errors.New("update points failed")
+		deductPointsFunc: func(id uint, points int64) (int64, error) {
+			return 0, errors.New("update points failed")
 		},
 	}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
 		voucherRepo:     mockVoucherRepo,
 		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.TransactionRedeemPointReq{
@@ -576,13 +926,15 @@ func TestListTransaction_Success(t *testing.T) {
 	}
 
 	mockTransactionRepo := &MockTransactionRepo{
-		listTransactionFunc: func(req *pbTransaction.ListTransactionReq) ([]*transaction_model.Transaction, error) {
-			return mockTransactions, nil
+		listTransactionFunc: func(req *pbTransaction.ListTransactionReq, page *pagination.Page) ([]*transaction_model.Transaction, int64, error) {
+			return mockTransactions, int64(len(mockTransactions)), nil
 		},
 	}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.ListTransactionReq{}
@@ -624,13 +976,15 @@ func TestListTransaction_Success(t *testing.T) {
 
 func TestListTransaction_EmptyList(t *testing.T) {
 	mockTransactionRepo := &MockTransactionRepo{
-		listTransactionFunc: func(req *pbTransaction.ListTransactionReq) ([]*transaction_model.Transaction, error) {
-			return []*transaction_model.Transaction{}, nil
+		listTransactionFunc: func(req *pbTransaction.ListTransactionReq, page *pagination.Page) ([]*transaction_model.Transaction, int64, error) {
+			return []*transaction_model.Transaction{}, 0, nil
 		},
 	}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.ListTransactionReq{}
@@ -651,13 +1005,15 @@ func TestListTransaction_EmptyList(t *testing.T) {
 func TestListTransaction_RepositoryError(t *testing.T) {
 	expectedError := errors.New("database error")
 	mockTransactionRepo := &MockTransactionRepo{
-		listTransactionFunc: func(req *pbTransaction.ListTransactionReq) ([]*transaction_model.Transaction, error) {
-			return []*transaction_model.Transaction{}, expectedError
+		listTransactionFunc: func(req *pbTransaction.ListTransactionReq, page *pagination.Page) ([]*transaction_model.Transaction, int64, error) {
+			return []*transaction_model.Transaction{}, 0, expectedError
 		},
 	}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.ListTransactionReq{}
@@ -675,6 +1031,95 @@ func TestListTransaction_RepositoryError(t *testing.T) {
 	}
 }
 
+func TestListTransaction_WithFiltersAndSort(t *testing.T) {
+	now := time.Now()
+	var gotReq *pbTransaction.ListTransactionReq
+	mockTransactionRepo := &MockTransactionRepo{
+		listTransactionFunc: func(req *pbTransaction.ListTransactionReq, page *pagination.Page) ([]*transaction_model.Transaction, int64, error) {
+			gotReq = req
+			return []*transaction_model.Transaction{
+				{ID: 3, Total: 900, RedeemDate: now, CreatedDate: now, ModifiedDate: now},
+				{ID: 7, Total: 500, RedeemDate: now, CreatedDate: now, ModifiedDate: now},
+				{ID: 2, Total: 300, RedeemDate: now, CreatedDate: now, ModifiedDate: now},
+			}, 3, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	startDate := "2025-01-01"
+	endDate := "2025-01-31"
+	brandId := int32(4)
+	req := &pbTransaction.ListTransactionReq{
+		StartDate: &startDate,
+		EndDate:   &endDate,
+		BrandId:   &brandId,
+		SortBy:    constants.TransactionSortByTotal,
+		SortOrder: constants.SortOrderDesc,
+		PageSize:  2,
+	}
+
+	result, err := service.ListTransaction(context.Background(), req)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if gotReq.GetStartDate() != "2025-01-01 00:00:00" || gotReq.GetEndDate() != "2025-01-31 23:59:59" {
+		t.Errorf("Expected date range to cover whole days, got %s - %s", gotReq.GetStartDate(), gotReq.GetEndDate())
+	}
+	if gotReq.GetBrandId() != 4 {
+		t.Errorf("Expected brandId filter to be passed to repository, got %d", gotReq.GetBrandId())
+	}
+	if len(result.Data) != 2 {
+		t.Errorf("Expected 2 transactions, got %d", len(result.Data))
+	}
+
+	cursor, err := pagination.DecodeToken(result.NextPageToken)
+	if err != nil {
+		t.Errorf("Expected valid next page token, got %v", err)
+	}
+	if cursor.ID != 7 || cursor.Value != "500" {
+		t.Errorf("Expected cursor after transaction 7 with total 500, got %+v", cursor)
+	}
+}
+
+func TestListTransaction_InvalidFilters(t *testing.T) {
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: &MockTransactionRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	invalidDate := "31-01-2025"
+	startDate := "2025-02-01"
+	endDate := "2025-01-01"
+	invalidStatus := int32(99)
+	minTotal := int64(500)
+	maxTotal := int64(100)
+	cases := []*pbTransaction.ListTransactionReq{
+		{SortBy: "name"},
+		{SortOrder: "up"},
+		{StartDate: &invalidDate},
+		{StartDate: &startDate, EndDate: &endDate},
+		{Status: &invalidStatus},
+		{MinTotal: &minTotal, MaxTotal: &maxTotal},
+		{SortBy: constants.TransactionSortByTotal, PageToken: pagination.EncodeToken(pagination.Cursor{ID: 1, Value: "abc"})},
+	}
+	for _, req := range cases {
+		result, err := service.ListTransaction(context.Background(), req)
+		if err == nil {
+			t.Errorf("Expected validation error for %v", req)
+		}
+		if result == nil {
+			t.Error("Expected result to not be nil on validation error")
+		}
+	}
+}
+
 func TestDetailTransaction_Success(t *testing.T) {
 	now := time.Now()
 	mockTransaction := &transaction_model.Transaction{
@@ -691,8 +1136,8 @@ func TestDetailTransaction_Success(t *testing.T) {
 	}
 
 	mockTransactionRepo := &MockTransactionRepo{
-		findByIdFunc: func(id uint) (*transaction_model.Transaction, error) {
-			if id == 1 {
+		detailTransactionFunc: func(req *pbTransaction.DetailTransactionReq) (*transaction_model.Transaction, error) {
+			if req.Id == 1 {
 				return mockTransaction, nil
 			}
 			return nil, errors.New("transaction not found")
@@ -700,7 +1145,10 @@ func TestDetailTransaction_Success(t *testing.T) {
 	}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
+		voucherRepo:     &MockVoucherRepo{},
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.DetailTransactionReq{
@@ -741,15 +1189,90 @@ func TestDetailTransaction_Success(t *testing.T) {
 	}
 }
 
+func TestDetailTransaction_DeletedVoucher(t *testing.T) {
+	now := time.Now()
+	mockTransactionRepo := &MockTransactionRepo{
+		detailTransactionFunc: func(req *pbTransaction.DetailTransactionReq) (*transaction_model.Transaction, error) {
+			return &transaction_model.Transaction{ID: uint(req.Id), CustomerID: 1, VoucherID: 5, RedeemDate: now}, nil
+		},
+	}
+	mockVoucherRepo := &MockVoucherRepo{
+		findDeletedFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, Name: "Old Voucher", IsDeleted: true, DeletedReason: "discontinued"}, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     mockVoucherRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	result, err := service.DetailTransaction(context.Background(), &pbTransaction.DetailTransactionReq{Id: 1})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Voucher == nil {
+		t.Fatal("Expected Voucher to be resolved")
+	}
+	if result.Voucher.Name != "Old Voucher" || !*result.Voucher.IsDeleted {
+		t.Errorf("Expected deleted voucher details, got %+v", result.Voucher)
+	}
+	if result.Voucher.DeletedReason != "discontinued" {
+		t.Errorf("Expected deleted reason to be discontinued, got %s", result.Voucher.DeletedReason)
+	}
+}
+
+func TestDetailTransaction_Embed(t *testing.T) {
+	mockTransactionRepo := &MockTransactionRepo{
+		detailTransactionFunc: func(req *pbTransaction.DetailTransactionReq) (*transaction_model.Transaction, error) {
+			if !req.Embed {
+				t.Error("Expected embed to be passed to the repo")
+			}
+			return &transaction_model.Transaction{
+				ID:           uint(req.Id),
+				VoucherID:    5,
+				VoucherName:  "Coffee",
+				VoucherCode:  "COF-1",
+				BrandName:    "Kopi Co",
+				CustomerName: "John Doe",
+			}, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     &MockVoucherRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	result, err := service.DetailTransaction(context.Background(), &pbTransaction.DetailTransactionReq{Id: 1, Embed: true})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Data.VoucherName != "Coffee" || result.Data.VoucherCode != "COF-1" {
+		t.Errorf("Expected voucher details, got %s/%s", result.Data.VoucherName, result.Data.VoucherCode)
+	}
+	if result.Data.BrandName != "Kopi Co" || result.Data.CustomerName != "John Doe" {
+		t.Errorf("Expected brand and customer names, got %s/%s", result.Data.BrandName, result.Data.CustomerName)
+	}
+}
+
 func TestDetailTransaction_NotFound(t *testing.T) {
 	mockTransactionRepo := &MockTransactionRepo{
-		findByIdFunc: func(id uint) (*transaction_model.Transaction, error) {
+		detailTransactionFunc: func(req *pbTransaction.DetailTransactionReq) (*transaction_model.Transaction, error) {
 			return nil, errors.New("transaction not found")
 		},
 	}
 
 	service := &TransactionService{
+		transactor:      MockTransactor{},
 		transactionRepo: mockTransactionRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
 	req := &pbTransaction.DetailTransactionReq{
@@ -761,33 +1284,112 @@ func TestDetailTransaction_NotFound(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error, got nil")
 	}
+	if !errors.Is(err, error_base.ErrNotFound) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
 	if result != nil {
 		t.Error("Expected result to be nil")
 	}
 }
 
-func TestCalculateTotalPointRedeem(t *testing.T) {
-	total := CalculateTotalPointRedeem(100, 3)
-	if total != 300 {
-		t.Errorf("Expected total to be 300, got %d", total)
-	}
+func customerContext(customerId uint) context.Context {
+	return auth.WithCaller(context.Background(), &auth.Caller{ID: "customer", Role: constants.RoleCustomer, CustomerID: customerId})
+}
 
-	total = CalculateTotalPointRedeem(50, 1)
-	if total != 50 {
-		t.Errorf("Expected total to be 50, got %d", total)
+func TestTransactionRedeemPoint_OtherCustomerForbidden(t *testing.T) {
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			t.Error("Expected customer lookup to be skipped")
+			return nil, nil
+		},
+	}
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: &MockTransactionRepo{},
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
 	}
 
-	total = CalculateTotalPointRedeem(0, 5)
-	if total != 0 {
-		t.Errorf("Expected total to be 0, got %d", total)
+	req := &pbTransaction.TransactionRedeemPointReq{CustomerId: 2, VoucherId: 1, Quantity: 1}
+	result, err := service.TransactionRedeemPoint(customerContext(1), req)
+
+	if !errors.Is(err, error_base.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
 	}
 }
 
-func TestRedundantPointsCustomer(t *testing.T) {
-	redundant := RedundantPointsCustomer(200, 1000)
-	if redundant != 800 {
-		t.Errorf("Expected redundant points to be 800, got %d", redundant)
-	}
+func TestListTransaction_CustomerDefaultsToSelf(t *testing.T) {
+	var filtered *int32
+	mockTransactionRepo := &MockTransactionRepo{
+		listTransactionFunc: func(req *pbTransaction.ListTransactionReq, page *pagination.Page) ([]*transaction_model.Transaction, int64, error) {
+			filtered = req.CustomerId
+			return []*transaction_model.Transaction{}, 0, nil
+		},
+	}
+	service := &TransactionService{transactionRepo: mockTransactionRepo, auditRepo: &MockAuditRepo{}}
+
+	_, err := service.ListTransaction(customerContext(5), &pbTransaction.ListTransactionReq{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if filtered == nil || *filtered != 5 {
+		t.Errorf("Expected list to be filtered to customer 5, got %v", filtered)
+	}
+
+	otherId := int32(6)
+	_, err = service.ListTransaction(customerContext(5), &pbTransaction.ListTransactionReq{CustomerId: &otherId})
+	if !errors.Is(err, error_base.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestDetailTransaction_CustomerOwnership(t *testing.T) {
+	recipientId := uint(3)
+	mockTransactionRepo := &MockTransactionRepo{
+		detailTransactionFunc: func(req *pbTransaction.DetailTransactionReq) (*transaction_model.Transaction, error) {
+			return &transaction_model.Transaction{ID: uint(req.Id), CustomerID: 2, VoucherID: 1, RecipientID: &recipientId}, nil
+		},
+	}
+	service := &TransactionService{transactionRepo: mockTransactionRepo, voucherRepo: &MockVoucherRepo{}}
+	req := &pbTransaction.DetailTransactionReq{Id: 1}
+
+	for customerId, allowed := range map[uint]bool{2: true, 3: true, 4: false} {
+		_, err := service.DetailTransaction(customerContext(customerId), req)
+		if allowed && err != nil {
+			t.Errorf("Expected customer %d to read the transaction, got %v", customerId, err)
+		}
+		if !allowed && !errors.Is(err, error_base.ErrForbidden) {
+			t.Errorf("Expected ErrForbidden for customer %d, got %v", customerId, err)
+		}
+	}
+}
+
+func TestCalculateTotalPointRedeem(t *testing.T) {
+	total := CalculateTotalPointRedeem(100, 3)
+	if total != 300 {
+		t.Errorf("Expected total to be 300, got %d", total)
+	}
+
+	total = CalculateTotalPointRedeem(50, 1)
+	if total != 50 {
+		t.Errorf("Expected total to be 50, got %d", total)
+	}
+
+	total = CalculateTotalPointRedeem(0, 5)
+	if total != 0 {
+		t.Errorf("Expected total to be 0, got %d", total)
+	}
+}
+
+func TestRedundantPointsCustomer(t *testing.T) {
+	redundant := RedundantPointsCustomer(200, 1000)
+	if redundant != 800 {
+		t.Errorf("Expected redundant points to be 800, got %d", redundant)
+	}
 
 	redundant = RedundantPointsCustomer(100, 100)
 	if redundant != 0 {
@@ -813,3 +1415,835 @@ func TestIsAbleToRedeem(t *testing.T) {
 		t.Error("Expected to not be able to redeem 50 points from 30")
 	}
 }
+
+func TestGiftVoucher_Success(t *testing.T) {
+	mockTransactionRepo := &MockTransactionRepo{
+		createTransactionFunc: func(transaction *transaction_model.Transaction) (*transaction_model.Transaction, error) {
+			if transaction.Status != constants.TransactionStatusGiftPending {
+				t.Errorf("Expected status to be gift pending, got %d", transaction.Status)
+			}
+			if transaction.RecipientID == nil || *transaction.RecipientID != 2 {
+				t.Error("Expected recipient ID to be 2")
+			}
+			if transaction.GiftExpiredDate == nil {
+				t.Error("Expected gift expired date to be set")
+			}
+			transaction.ID = 1
+			return transaction, nil
+		},
+	}
+
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: 1, CostInPoint: 100}, nil
+		},
+	}
+
+	updatedPoints := int64(-1)
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: 1, Points: 1000}, nil
+		},
+		findByEmailFunc: func(email string) (*customer_model.Customer, error) {
+			if email == "friend@example.com" {
+				return &customer_model.Customer{ID: 2, Email: email}, nil
+			}
+			return nil, errors.New("customer not found")
+		},
+		deductPointsFunc: func(id uint, points int64) (int64, error) {
+			if id != 1 {
+				t.Errorf("Expected sender points to be updated, got customer %d", id)
+			}
+			updatedPoints = 1000 - points
+			return updatedPoints, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     mockVoucherRepo,
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	req := &pbTransaction.GiftVoucherReq{
+		SenderId:       1,
+		RecipientEmail: "friend@example.com",
+		VoucherId:      1,
+		Quantity:       2,
+		GiftMessage:    "Happy birthday!",
+	}
+
+	result, err := service.GiftVoucher(context.Background(), req)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Fatal("Expected IsSuccess to be true")
+	}
+	if result.Data.RecipientId == nil || *result.Data.RecipientId != 2 {
+		t.Error("Expected recipient ID to be 2")
+	}
+	if result.Data.GiftMessage != "Happy birthday!" {
+		t.Errorf("Expected gift message to be 'Happy birthday!', got '%s'", result.Data.GiftMessage)
+	}
+	if updatedPoints != 800 {
+		t.Errorf("Expected sender points to be 800, got %d", updatedPoints)
+	}
+}
+
+func TestGiftVoucher_PricedAtLockedCost(t *testing.T) {
+	var created *transaction_model.Transaction
+	mockTransactionRepo := &MockTransactionRepo{
+		createTransactionFunc: func(transaction *transaction_model.Transaction) (*transaction_model.Transaction, error) {
+			created = transaction
+			transaction.ID = 1
+			return transaction, nil
+		},
+	}
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: 1, CostInPoint: 100}, nil
+		},
+		findForShareFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: 1, CostInPoint: 150}, nil
+		},
+	}
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: 1, Points: 1000}, nil
+		},
+		findByEmailFunc: func(email string) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: 2, Email: email}, nil
+		},
+		deductPointsFunc: func(id uint, points int64) (int64, error) {
+			return 1000 - points, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     mockVoucherRepo,
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	req := &pbTransaction.GiftVoucherReq{SenderId: 1, RecipientEmail: "friend@example.com", VoucherId: 1, Quantity: 2}
+	_, err := service.GiftVoucher(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if created.VoucherCostInPoint != 150 || created.Total != 300 {
+		t.Errorf("Expected the gift to be priced at the locked cost 150, got cost %d total %d", created.VoucherCostInPoint, created.Total)
+	}
+}
+
+func TestGiftVoucher_RecipientNotFound(t *testing.T) {
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: 1, Points: 1000}, nil
+		},
+		findByEmailFunc: func(email string) (*customer_model.Customer, error) {
+			return nil, errors.New("customer not found")
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: &MockTransactionRepo{},
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	req := &pbTransaction.GiftVoucherReq{
+		SenderId:       1,
+		RecipientEmail: "unknown@example.com",
+		VoucherId:      1,
+		Quantity:       1,
+	}
+
+	result, err := service.GiftVoucher(context.Background(), req)
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestGiftVoucher_ToSelf(t *testing.T) {
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: 1, Email: "me@example.com", Points: 1000}, nil
+		},
+		findByEmailFunc: func(email string) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: 1, Email: "me@example.com", Points: 1000}, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: &MockTransactionRepo{},
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	req := &pbTransaction.GiftVoucherReq{
+		SenderId:       1,
+		RecipientEmail: "me@example.com",
+		VoucherId:      1,
+		Quantity:       1,
+	}
+
+	result, err := service.GiftVoucher(context.Background(), req)
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestGiftVoucher_ValidationError_InvalidEmail(t *testing.T) {
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: &MockTransactionRepo{},
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo:    &MockCustomerRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	req := &pbTransaction.GiftVoucherReq{
+		SenderId:       1,
+		RecipientEmail: "not-an-email",
+		VoucherId:      1,
+		Quantity:       1,
+	}
+
+	result, err := service.GiftVoucher(context.Background(), req)
+
+	if err == nil {
+		t.Error("Expected validation error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestClaimGiftVoucher_Success(t *testing.T) {
+	recipientId := uint(2)
+	expiredDate := time.Now().Add(time.Hour)
+	updatedStatus := int32(0)
+
+	mockTransactionRepo := &MockTransactionRepo{
+		findByIdFunc: func(id uint) (*transaction_model.Transaction, error) {
+			return &transaction_model.Transaction{
+				ID:              id,
+				CustomerID:      1,
+				Status:          constants.TransactionStatusGiftPending,
+				RecipientID:     &recipientId,
+				GiftExpiredDate: &expiredDate,
+			}, nil
+		},
+		updateStatusFunc: func(id uint, from int32, to int32) error {
+			updatedStatus = to
+			return nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo: &MockCustomerRepo{
+			findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+				return &customer_model.Customer{ID: id}, nil
+			},
+		},
+		auditRepo: &MockAuditRepo{},
+	}
+
+	result, err := service.ClaimGiftVoucher(context.Background(), &pbTransaction.ClaimGiftVoucherReq{TransactionId: 1, RecipientId: 2})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Fatal("Expected IsSuccess to be true")
+	}
+	if updatedStatus != constants.TransactionStatusGiftClaimed {
+		t.Errorf("Expected status to be gift claimed, got %d", updatedStatus)
+	}
+}
+
+func TestClaimGiftVoucher_WrongRecipient(t *testing.T) {
+	recipientId := uint(2)
+
+	mockTransactionRepo := &MockTransactionRepo{
+		findByIdFunc: func(id uint) (*transaction_model.Transaction, error) {
+			return &transaction_model.Transaction{
+				ID:          id,
+				Status:      constants.TransactionStatusGiftPending,
+				RecipientID: &recipientId,
+			}, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo:    &MockCustomerRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	result, err := service.ClaimGiftVoucher(context.Background(), &pbTransaction.ClaimGiftVoucherReq{TransactionId: 1, RecipientId: 3})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestClaimGiftVoucher_Expired(t *testing.T) {
+	recipientId := uint(2)
+	expiredDate := time.Now().Add(-time.Hour)
+
+	mockTransactionRepo := &MockTransactionRepo{
+		findByIdFunc: func(id uint) (*transaction_model.Transaction, error) {
+			return &transaction_model.Transaction{
+				ID:              id,
+				Status:          constants.TransactionStatusGiftPending,
+				RecipientID:     &recipientId,
+				GiftExpiredDate: &expiredDate,
+			}, nil
+		},
+		updateStatusFunc: func(id uint, from int32, to int32) error {
+			t.Error("Expected status not to be updated")
+			return nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo:    &MockCustomerRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	result, err := service.ClaimGiftVoucher(context.Background(), &pbTransaction.ClaimGiftVoucherReq{TransactionId: 1, RecipientId: 2})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestExpireGiftVoucher_RefundsSender(t *testing.T) {
+	recipientId := uint(2)
+	expiredDate := time.Now().Add(-time.Hour)
+	updatedStatus := map[uint]int32{}
+	refundedPoints := int64(0)
+
+	mockTransactionRepo := &MockTransactionRepo{
+		listExpiredGiftFunc: func(now time.Time) ([]*transaction_model.Transaction, error) {
+			return []*transaction_model.Transaction{
+				{ID: 10, CustomerID: 1, Total: 300, Status: constants.TransactionStatusGiftPending, RecipientID: &recipientId, GiftExpiredDate: &expiredDate},
+			}, nil
+		},
+		updateStatusFunc: func(id uint, from int32, to int32) error {
+			if from != constants.TransactionStatusGiftPending {
+				t.Errorf("Expected only pending gifts to expire, got status %d", from)
+			}
+			updatedStatus[id] = to
+			return nil
+		},
+	}
+
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			t.Error("Expected the sender to be credited by id, deactivated or not")
+			return nil, nil
+		},
+		addPointsFunc: func(id uint, points int64) (int64, error) {
+			refundedPoints = 200 + points
+			return refundedPoints, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	expired, err := service.ExpireGiftVoucher(context.Background())
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if expired != 1 {
+		t.Errorf("Expected 1 expired gift, got %d", expired)
+	}
+	if updatedStatus[10] != constants.TransactionStatusGiftExpired {
+		t.Errorf("Expected status to be gift expired, got %d", updatedStatus[10])
+	}
+	if refundedPoints != 500 {
+		t.Errorf("Expected sender points to be 500, got %d", refundedPoints)
+	}
+}
+
+func TestClaimGiftVoucher_RecipientDeactivated(t *testing.T) {
+	recipientId := uint(2)
+	expiredDate := time.Now().Add(time.Hour)
+
+	mockTransactionRepo := &MockTransactionRepo{
+		findByIdFunc: func(id uint) (*transaction_model.Transaction, error) {
+			return &transaction_model.Transaction{
+				ID:              id,
+				CustomerID:      1,
+				Status:          constants.TransactionStatusGiftPending,
+				RecipientID:     &recipientId,
+				GiftExpiredDate: &expiredDate,
+			}, nil
+		},
+		updateStatusFunc: func(id uint, from int32, to int32) error {
+			t.Error("Expected status not to be updated")
+			return nil
+		},
+	}
+
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return nil, gorm.ErrRecordNotFound
+		},
+		findDeactivated: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, IsDeleted: true}, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	result, err := service.ClaimGiftVoucher(context.Background(), &pbTransaction.ClaimGiftVoucherReq{TransactionId: 1, RecipientId: 2})
+
+	if !errors.Is(err, error_base.ErrInvalidState) {
+		t.Errorf("Expected ErrInvalidState, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestClaimGiftVoucher_ExpiredConcurrently(t *testing.T) {
+	recipientId := uint(2)
+	expiredDate := time.Now().Add(time.Hour)
+
+	mockTransactionRepo := &MockTransactionRepo{
+		findByIdFunc: func(id uint) (*transaction_model.Transaction, error) {
+			return &transaction_model.Transaction{
+				ID:              id,
+				Status:          constants.TransactionStatusGiftPending,
+				RecipientID:     &recipientId,
+				GiftExpiredDate: &expiredDate,
+			}, nil
+		},
+		updateStatusFunc: func(id uint, from int32, to int32) error {
+			return gorm.ErrRecordNotFound
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo: &MockCustomerRepo{
+			findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+				return &customer_model.Customer{ID: id}, nil
+			},
+		},
+		auditRepo: &MockAuditRepo{},
+	}
+
+	result, err := service.ClaimGiftVoucher(context.Background(), &pbTransaction.ClaimGiftVoucherReq{TransactionId: 1, RecipientId: 2})
+
+	if !errors.Is(err, error_base.ErrInvalidState) {
+		t.Errorf("Expected ErrInvalidState, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestExpireGiftVoucher_SkipsClaimedConcurrently(t *testing.T) {
+	recipientId := uint(2)
+	expiredDate := time.Now().Add(-time.Hour)
+
+	mockTransactionRepo := &MockTransactionRepo{
+		listExpiredGiftFunc: func(now time.Time) ([]*transaction_model.Transaction, error) {
+			return []*transaction_model.Transaction{
+				{ID: 10, CustomerID: 1, Total: 300, Status: constants.TransactionStatusGiftPending, RecipientID: &recipientId, GiftExpiredDate: &expiredDate},
+			}, nil
+		},
+		updateStatusFunc: func(id uint, from int32, to int32) error {
+			return gorm.ErrRecordNotFound
+		},
+	}
+
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, Points: 200}, nil
+		},
+		addPointsFunc: func(id uint, points int64) (int64, error) {
+			t.Error("Expected a claimed gift not to be refunded")
+			return 0, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	expired, err := service.ExpireGiftVoucher(context.Background())
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if expired != 0 {
+		t.Errorf("Expected no expired gift, got %d", expired)
+	}
+}
+
+func TestStartGiftExpiryJob_StopsOnCancel(t *testing.T) {
+	runs := make(chan struct{}, 10)
+	mockTransactionRepo := &MockTransactionRepo{
+		listExpiredGiftFunc: func(now time.Time) ([]*transaction_model.Transaction, error) {
+			runs <- struct{}{}
+			return nil, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     &MockVoucherRepo{},
+		customerRepo:    &MockCustomerRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := service.StartGiftExpiryJob(ctx, time.Millisecond)
+
+	select {
+	case <-runs:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the job to run")
+	}
+
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the job to stop once its context is cancelled")
+	}
+
+	for len(runs) > 0 {
+		<-runs
+	}
+	time.Sleep(10 * time.Millisecond)
+	if len(runs) != 0 {
+		t.Error("Expected no runs after the job stopped")
+	}
+}
+
+func TestTransactionRedeemPoint_PointsSpentConcurrently(t *testing.T) {
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, CostInPoint: 100}, nil
+		},
+	}
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, Points: 1000}, nil
+		},
+		deductPointsFunc: func(id uint, points int64) (int64, error) {
+			return 0, gorm.ErrRecordNotFound
+		},
+	}
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: &MockTransactionRepo{},
+		voucherRepo:     mockVoucherRepo,
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	result, err := service.TransactionRedeemPoint(context.Background(), &pbTransaction.TransactionRedeemPointReq{CustomerId: 1, VoucherId: 1, Quantity: 1})
+
+	if !errors.Is(err, error_base.ErrInsufficientPoints) {
+		t.Errorf("Expected ErrInsufficientPoints, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestTransactionRedeemPoint_FirstRedemptionRewardsReferral(t *testing.T) {
+	referrerId := uint(5)
+	mockCustomer := &customer_model.Customer{ID: 1, Points: 1000, ReferredByID: &referrerId}
+
+	mockTransactionRepo := &MockTransactionRepo{}
+
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: 1, CostInPoint: 100}, nil
+		},
+	}
+
+	rewarded := false
+	points := map[uint]int64{1: 1000, referrerId: 100}
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			if id == referrerId {
+				return &customer_model.Customer{ID: referrerId, Points: 100}, nil
+			}
+			return mockCustomer, nil
+		},
+		addPointsFunc: func(id uint, added int64) (int64, error) {
+			points[id] += added
+			return points[id], nil
+		},
+		deductPointsFunc: func(id uint, deducted int64) (int64, error) {
+			points[id] -= deducted
+			return points[id], nil
+		},
+		markRewardedFunc: func(id uint) error {
+			rewarded = id == 1
+			return nil
+		},
+	}
+
+	mockAuditRepo := &MockAuditRepo{}
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     mockVoucherRepo,
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       mockAuditRepo,
+	}
+
+	result, err := service.TransactionRedeemPoint(context.Background(), &pbTransaction.TransactionRedeemPointReq{CustomerId: 1, VoucherId: 1, Quantity: 1})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Fatal("Expected IsSuccess to be true")
+	}
+	if !rewarded {
+		t.Error("Expected referee to be marked as rewarded")
+	}
+	audited := false
+	for _, entry := range mockAuditRepo.entries {
+		audited = audited || (entry.EntityID == 1 && entry.After == `{"referral_rewarded":true}`)
+	}
+	if !audited {
+		t.Error("Expected the referral reward to be audited")
+	}
+	if points[1] != 900+constants.DefaultReferralRefereeBonusPoints {
+		t.Errorf("Expected referee points to be %d, got %d", 900+constants.DefaultReferralRefereeBonusPoints, points[1])
+	}
+	if points[referrerId] != 100+constants.DefaultReferralReferrerBonusPoints {
+		t.Errorf("Expected referrer points to be %d, got %d", 100+constants.DefaultReferralReferrerBonusPoints, points[referrerId])
+	}
+}
+
+func TestTransactionRedeemPoint_ReferralAlreadyRewarded(t *testing.T) {
+	referrerId := uint(5)
+	mockCustomer := &customer_model.Customer{ID: 1, Points: 1000, ReferredByID: &referrerId, ReferralRewarded: true}
+
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: 1, CostInPoint: 100}, nil
+		},
+	}
+
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return mockCustomer, nil
+		},
+		deductPointsFunc: func(id uint, points int64) (int64, error) {
+			if id != 1 || points != 100 {
+				t.Errorf("Expected only the redemption to update points, got customer %d with %d", id, points)
+			}
+			return 900, nil
+		},
+		addPointsFunc: func(id uint, points int64) (int64, error) {
+			t.Errorf("Expected no bonus, got %d points for customer %d", points, id)
+			return 0, nil
+		},
+		markRewardedFunc: func(id uint) error {
+			t.Error("Expected referral not to be rewarded twice")
+			return nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: &MockTransactionRepo{},
+		voucherRepo:     mockVoucherRepo,
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	result, err := service.TransactionRedeemPoint(context.Background(), &pbTransaction.TransactionRedeemPointReq{CustomerId: 1, VoucherId: 1, Quantity: 1})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+}
+
+func TestTransactionRedeemPoint_ReferralRewardedConcurrently(t *testing.T) {
+	referrerId := uint(5)
+	mockCustomer := &customer_model.Customer{ID: 1, Points: 1000, ReferredByID: &referrerId}
+
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: 1, CostInPoint: 100}, nil
+		},
+	}
+
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return mockCustomer, nil
+		},
+		markRewardedFunc: func(id uint) error {
+			return gorm.ErrRecordNotFound
+		},
+		addPointsFunc: func(id uint, points int64) (int64, error) {
+			t.Errorf("Expected no second bonus, got %d points for customer %d", points, id)
+			return 0, nil
+		},
+	}
+
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: &MockTransactionRepo{},
+		voucherRepo:     mockVoucherRepo,
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	result, err := service.TransactionRedeemPoint(context.Background(), &pbTransaction.TransactionRedeemPointReq{CustomerId: 1, VoucherId: 1, Quantity: 1})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+}
+
+func TestTransactionRedeemPoint_ApiKeyOtherBrandForbidden(t *testing.T) {
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, BrandID: 1, CostInPoint: 100}, nil
+		},
+	}
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, Points: 1000}, nil
+		},
+	}
+	mockTransactionRepo := &MockTransactionRepo{
+		createTransactionFunc: func(transaction *transaction_model.Transaction) (*transaction_model.Transaction, error) {
+			t.Error("Expected no transaction to be created")
+			return transaction, nil
+		},
+	}
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     mockVoucherRepo,
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{ID: "api_key:abc", Role: constants.RoleApiKey, BrandID: 2, CustomerID: 1, Scopes: []string{constants.ScopeCodesBurn}})
+
+	req := &pbTransaction.TransactionRedeemPointReq{CustomerId: 1, VoucherId: 1, Quantity: 1}
+	result, err := service.TransactionRedeemPoint(ctx, req)
+
+	if !errors.Is(err, error_base.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestTransactionRedeemPoint_ApiKeyOtherCustomerForbidden(t *testing.T) {
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, BrandID: 1, CostInPoint: 100}, nil
+		},
+	}
+	mockCustomerRepo := &MockCustomerRepo{
+		findByIdFunc: func(id uint) (*customer_model.Customer, error) {
+			return &customer_model.Customer{ID: id, Points: 1000}, nil
+		},
+	}
+	mockTransactionRepo := &MockTransactionRepo{
+		createTransactionFunc: func(transaction *transaction_model.Transaction) (*transaction_model.Transaction, error) {
+			t.Error("Expected no transaction to be created")
+			return transaction, nil
+		},
+	}
+	service := &TransactionService{
+		transactor:      MockTransactor{},
+		transactionRepo: mockTransactionRepo,
+		voucherRepo:     mockVoucherRepo,
+		customerRepo:    mockCustomerRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+	req := &pbTransaction.TransactionRedeemPointReq{CustomerId: 1, VoucherId: 1, Quantity: 1}
+
+	// A key of the voucher's own brand, with no customer token or with another customer's.
+	for _, customerId := range []uint{0, 2} {
+		ctx := auth.WithCaller(context.Background(), &auth.Caller{ID: "api_key:abc", Role: constants.RoleApiKey, BrandID: 1, CustomerID: customerId, Scopes: []string{constants.ScopeCodesBurn}})
+		result, err := service.TransactionRedeemPoint(ctx, req)
+
+		if !errors.Is(err, error_base.ErrForbidden) {
+			t.Errorf("Expected ErrForbidden for customer %d, got %v", customerId, err)
+		}
+		if result == nil || result.IsSuccess {
+			t.Error("Expected IsSuccess to be false")
+		}
+	}
+}
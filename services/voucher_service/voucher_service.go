@@ -3,56 +3,108 @@ package voucher_service
 import (
 	"context"
 	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
 	"customer-voucher-service/constants/message"
 	"customer-voucher-service/db"
+	"customer-voucher-service/models/audit_model"
 	"customer-voucher-service/models/brand_model"
+	"customer-voucher-service/models/category_model"
+	"customer-voucher-service/models/transaction_model"
 	"customer-voucher-service/models/voucher_model"
 	pbVoucher "customer-voucher-service/protogen/voucher"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/pagination"
+	"customer-voucher-service/utils/transactor"
 	"customer-voucher-service/utils/validator"
 	"errors"
+	"strings"
+
+	"gorm.io/gorm"
 )
 
 type IVoucherService interface {
 	CreateVoucher(ctx context.Context, req *pbVoucher.CreateVoucherReq) (*pbVoucher.CreateVoucherRes, error)
 	ListVoucher(ctx context.Context, req *pbVoucher.ListVoucherReq) (*pbVoucher.ListVoucherRes, error)
 	DetailVoucher(ctx context.Context, req *pbVoucher.DetailVoucherReq) (*pbVoucher.DetailVoucherRes, error)
+	UpdateVoucher(ctx context.Context, req *pbVoucher.UpdateVoucherReq) (*pbVoucher.UpdateVoucherRes, error)
+	DeleteVoucher(ctx context.Context, req *pbVoucher.DeleteVoucherReq) (*pbVoucher.DeleteVoucherRes, error)
+	RestoreVoucher(ctx context.Context, req *pbVoucher.RestoreVoucherReq) (*pbVoucher.RestoreVoucherRes, error)
+	SetVoucherTags(ctx context.Context, req *pbVoucher.SetVoucherTagsReq) (*pbVoucher.SetVoucherTagsRes, error)
+	ListTag(ctx context.Context, req *pbVoucher.ListTagReq) (*pbVoucher.ListTagRes, error)
+	DeleteTag(ctx context.Context, req *pbVoucher.DeleteTagReq) (*pbVoucher.DeleteTagRes, error)
 }
 
 type VoucherService struct {
 	pbVoucher.UnimplementedVoucherServiceServer
-	voucherRepo voucher_model.IVoucherRepo
-	brandRepo   brand_model.IBrandRepo
+	voucherRepo     voucher_model.IVoucherRepo
+	brandRepo       brand_model.IBrandRepo
+	categoryRepo    category_model.ICategoryRepo
+	transactionRepo transaction_model.ITransactionRepo
+	auditRepo       audit_model.IAuditRepo
+	transactor      transactor.Transactor
 }
 
 func NewVoucherService() *VoucherService {
 	return &VoucherService{
-		voucherRepo: voucher_model.NewVoucherRepo(db.DB),
-		brandRepo:   brand_model.NewBrandRepo(db.DB),
+		voucherRepo:     voucher_model.NewVoucherRepo(db.DB),
+		brandRepo:       brand_model.NewBrandRepo(db.DB),
+		categoryRepo:    category_model.NewCategoryRepo(db.DB),
+		transactionRepo: transaction_model.NewTransactionRepo(db.DB),
+		auditRepo:       audit_model.NewAuditRepo(db.DB),
+		transactor:      transactor.New(db.DB),
 	}
 }
 
+// RequestRules are the field rules of each method, checked by the gRPC validation
+// interceptor. The methods check them again for HTTP calls.
+var RequestRules = validator.Rules{
+	pbVoucher.VoucherServiceCreateVoucherFullMethodName:  validator.RuleFor(validateCreateVoucherReq),
+	pbVoucher.VoucherServiceUpdateVoucherFullMethodName:  validator.RuleFor(validateUpdateVoucherReq),
+	pbVoucher.VoucherServiceDeleteVoucherFullMethodName:  validator.RuleFor(validateDeleteVoucherReq),
+	pbVoucher.VoucherServiceRestoreVoucherFullMethodName: validator.RuleFor(validateRestoreVoucherReq),
+	pbVoucher.VoucherServiceSetVoucherTagsFullMethodName: validator.RuleFor(validateSetVoucherTagsReq),
+}
+
 type createVoucherReqValidate struct {
-	BrandId     int32  `validate:"required"`
-	Name        string `validate:"required,max=255"`
-	Description string `validate:max=255"`
-	CostInPoint int64  `validate:"required"`
-	VoucherCode string `validate:"required,max=255"`
+	BrandId     int32    `validate:"required"`
+	Name        string   `validate:"required,max=255"`
+	Description string   `validate:"max=255"`
+	CostInPoint int64    `validate:"required,gte=1"`
+	VoucherCode string   `validate:"required,max=255,vouchercode"`
+	Tags        []string `validate:"max=20,dive,required,max=100"`
 }
 
-func (s *VoucherService) CreateVoucher(ctx context.Context, req *pbVoucher.CreateVoucherReq) (*pbVoucher.CreateVoucherRes, error) {
-	validateReq := createVoucherReqValidate{
+func validateCreateVoucherReq(ctx context.Context, req *pbVoucher.CreateVoucherReq) error {
+	return validator.ValidateReqField(createVoucherReqValidate{
 		BrandId:     req.BrandId,
 		Name:        req.Name,
 		Description: req.Description,
 		CostInPoint: req.CostInPoint,
-		VoucherCode: req.VoucherCode,
-	}
-	if err := validator.ValidateReqField(validateReq); err != nil {
+		VoucherCode: strings.ToUpper(req.VoucherCode),
+		Tags:        req.Tags,
+	})
+}
+
+func (s *VoucherService) CreateVoucher(ctx context.Context, req *pbVoucher.CreateVoucherReq) (*pbVoucher.CreateVoucherRes, error) {
+	// Codes are stored in uppercase, so clients that send "promo-10" keep working.
+	req.VoucherCode = strings.ToUpper(req.VoucherCode)
+	if err := validateCreateVoucherReq(ctx, req); err != nil {
 		return &pbVoucher.CreateVoucherRes{IsSuccess: false}, err
 	}
 	resBrand, err := s.brandRepo.FindBrandById(uint(req.BrandId))
 	if err != nil || resBrand == nil {
-		return &pbVoucher.CreateVoucherRes{IsSuccess: false}, errors.New(message.NotFoundMessage("brand"))
+		return &pbVoucher.CreateVoucherRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("brand"))
+	}
+	if err := auth.AuthorizeBrand(ctx, resBrand.ID); err != nil {
+		return &pbVoucher.CreateVoucherRes{IsSuccess: false}, err
+	}
+	var categoryId *uint
+	if req.CategoryId != nil {
+		resCategory, err := s.categoryRepo.FindCategoryById(uint(*req.CategoryId))
+		if err != nil || resCategory == nil {
+			return &pbVoucher.CreateVoucherRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("category"))
+		}
+		categoryId = &resCategory.ID
 	}
 	voucher := &voucher_model.Voucher{
 		BrandID:     resBrand.ID,
@@ -60,8 +112,20 @@ func (s *VoucherService) CreateVoucher(ctx context.Context, req *pbVoucher.Creat
 		Description: req.Description,
 		CostInPoint: req.CostInPoint,
 		VoucherCode: req.VoucherCode,
+		CategoryID:  categoryId,
 	}
-	err = s.voucherRepo.CreateVoucher(voucher)
+	// New tags are rolled back with the voucher, so a failed create leaves none behind.
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		tags, err := s.voucherRepo.WithContext(ctx).FindOrCreateTags(NormalizeTags(req.Tags))
+		if err != nil {
+			return err
+		}
+		voucher.Tags = tags
+		if err := s.voucherRepo.WithContext(ctx).CreateVoucher(voucher); err != nil {
+			return err
+		}
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionCreate, constants.AuditEntityVoucher, voucher.ID, nil, voucher)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -69,10 +133,24 @@ func (s *VoucherService) CreateVoucher(ctx context.Context, req *pbVoucher.Creat
 }
 
 func (s *VoucherService) ListVoucher(ctx context.Context, req *pbVoucher.ListVoucherReq) (*pbVoucher.ListVoucherRes, error) {
-	result, err := s.voucherRepo.ListVoucher(req)
+	if req.MinCostInPoint != nil && req.MaxCostInPoint != nil && *req.MinCostInPoint > *req.MaxCostInPoint {
+		return &pbVoucher.ListVoucherRes{}, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("costInPoint range"))
+	}
+	if req.Tag != nil {
+		tag := normalizeTag(*req.Tag)
+		req.Tag = &tag
+	}
+
+	page, err := pagination.NewPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return &pbVoucher.ListVoucherRes{}, err
+	}
+
+	result, total, err := s.voucherRepo.ListVoucher(req, page)
 	if err != nil {
 		return nil, err
 	}
+	result, hasNext := pagination.Trim(result, page.Size)
 	list := []*pbVoucher.Voucher{}
 
 	for _, cust := range result {
@@ -87,18 +165,25 @@ func (s *VoucherService) ListVoucher(ctx context.Context, req *pbVoucher.ListVou
 			CreatedDate:  cust.CreatedDate.Format(constants.FormatDate),
 			ModifiedDate: cust.ModifiedDate.Format(constants.FormatDate),
 			IsDeleted:    &cust.IsDeleted,
+			CategoryId:   toPbCategoryId(cust.CategoryID),
+			Tags:         toPbTags(cust.Tags),
 		}
 		list = append(list, &data)
 	}
-	return &pbVoucher.ListVoucherRes{
-		Data: list,
-	}, nil
+	res := &pbVoucher.ListVoucherRes{
+		Data:       list,
+		TotalCount: total,
+	}
+	if hasNext {
+		res.NextPageToken = pagination.EncodeToken(pagination.Cursor{ID: result[len(result)-1].ID})
+	}
+	return res, nil
 }
 
 func (s *VoucherService) DetailVoucher(ctx context.Context, req *pbVoucher.DetailVoucherReq) (*pbVoucher.DetailVoucherRes, error) {
 	result, err := s.voucherRepo.FindVoucherById(uint(req.Id))
-	if err != nil {
-		return nil, err
+	if err != nil || result == nil {
+		return nil, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("voucher"))
 	}
 
 	isDeleted := result.IsDeleted
@@ -112,9 +197,323 @@ func (s *VoucherService) DetailVoucher(ctx context.Context, req *pbVoucher.Detai
 		CreatedDate:  result.CreatedDate.Format(constants.FormatDate),
 		ModifiedDate: result.ModifiedDate.Format(constants.FormatDate),
 		IsDeleted:    &isDeleted,
+		CategoryId:   toPbCategoryId(result.CategoryID),
+		Tags:         toPbTags(result.Tags),
 	}
 
 	return &pbVoucher.DetailVoucherRes{
 		Data: data,
 	}, nil
 }
+
+type updateVoucherReqValidate struct {
+	Id          int32  `validate:"required"`
+	Name        string `validate:"required,max=255"`
+	Description string `validate:"max=255"`
+	CostInPoint int64  `validate:"required,gte=1"`
+	ModifiedBy  string `validate:"max=255"`
+}
+
+func validateUpdateVoucherReq(ctx context.Context, req *pbVoucher.UpdateVoucherReq) error {
+	return validator.ValidateReqField(updateVoucherReqValidate{
+		Id:          req.Id,
+		Name:        req.Name,
+		Description: req.Description,
+		CostInPoint: req.CostInPoint,
+		ModifiedBy:  auth.Actor(ctx, req.ModifiedBy),
+	})
+}
+
+func (s *VoucherService) UpdateVoucher(ctx context.Context, req *pbVoucher.UpdateVoucherReq) (*pbVoucher.UpdateVoucherRes, error) {
+	req.ModifiedBy = auth.Actor(ctx, req.ModifiedBy)
+	if err := validateUpdateVoucherReq(ctx, req); err != nil {
+		return &pbVoucher.UpdateVoucherRes{IsSuccess: false}, err
+	}
+	resVoucher, err := s.voucherRepo.FindVoucherById(uint(req.Id))
+	if err != nil || resVoucher == nil {
+		return &pbVoucher.UpdateVoucherRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("voucher"))
+	}
+	if err := auth.AuthorizeBrand(ctx, resVoucher.BrandID); err != nil {
+		return &pbVoucher.UpdateVoucherRes{IsSuccess: false}, err
+	}
+	categoryId := resVoucher.CategoryID
+	if req.CategoryId != nil {
+		categoryId = nil
+		if *req.CategoryId != 0 {
+			resCategory, err := s.categoryRepo.FindCategoryById(uint(*req.CategoryId))
+			if err != nil || resCategory == nil {
+				return &pbVoucher.UpdateVoucherRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("category"))
+			}
+			categoryId = &resCategory.ID
+		}
+	}
+	voucher := &voucher_model.Voucher{
+		ID:          resVoucher.ID,
+		Name:        req.Name,
+		Description: req.Description,
+		CostInPoint: req.CostInPoint,
+		CategoryID:  categoryId,
+		ModifiedBy:  req.ModifiedBy,
+	}
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		// Pending gifts were paid for at the old cost, so the price is frozen until they are claimed
+		// or expire. The voucher row stays locked until commit, so no gift can be created in between.
+		if req.CostInPoint != resVoucher.CostInPoint {
+			if _, err := s.voucherRepo.WithContext(ctx).FindVoucherByIdForUpdate(resVoucher.ID); err != nil {
+				return err
+			}
+			pending, err := s.transactionRepo.WithContext(ctx).CountPendingTransactionByVoucher(resVoucher.ID)
+			if err != nil {
+				return err
+			}
+			if pending > 0 {
+				return error_base.ErrInvalidState.WithMessage("costInPoint cannot be changed while the voucher has pending gift transactions")
+			}
+		}
+		if err := s.voucherRepo.WithContext(ctx).UpdateVoucher(voucher); err != nil {
+			return err
+		}
+		after := *resVoucher
+		after.Name, after.Description, after.CostInPoint, after.CategoryID, after.ModifiedBy = req.Name, req.Description, req.CostInPoint, categoryId, req.ModifiedBy
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionUpdate, constants.AuditEntityVoucher, resVoucher.ID, resVoucher, &after)
+	})
+	if errors.Is(err, error_base.ErrInvalidState) {
+		return &pbVoucher.UpdateVoucherRes{IsSuccess: false}, err
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbVoucher.UpdateVoucherRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("voucher"))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pbVoucher.UpdateVoucherRes{IsSuccess: true}, nil
+}
+
+type deleteVoucherReqValidate struct {
+	Id         int32  `validate:"required"`
+	Reason     string `validate:"required,max=255"`
+	ModifiedBy string `validate:"max=255"`
+}
+
+func validateDeleteVoucherReq(ctx context.Context, req *pbVoucher.DeleteVoucherReq) error {
+	return validator.ValidateReqField(deleteVoucherReqValidate{
+		Id:         req.Id,
+		Reason:     req.Reason,
+		ModifiedBy: auth.Actor(ctx, req.ModifiedBy),
+	})
+}
+
+func (s *VoucherService) DeleteVoucher(ctx context.Context, req *pbVoucher.DeleteVoucherReq) (*pbVoucher.DeleteVoucherRes, error) {
+	req.ModifiedBy = auth.Actor(ctx, req.ModifiedBy)
+	if err := validateDeleteVoucherReq(ctx, req); err != nil {
+		return &pbVoucher.DeleteVoucherRes{IsSuccess: false}, err
+	}
+	resVoucher, err := s.voucherRepo.FindVoucherById(uint(req.Id))
+	if err != nil || resVoucher == nil {
+		return &pbVoucher.DeleteVoucherRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("voucher"))
+	}
+	if err := auth.AuthorizeBrand(ctx, resVoucher.BrandID); err != nil {
+		return &pbVoucher.DeleteVoucherRes{IsSuccess: false}, err
+	}
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.voucherRepo.WithContext(ctx).DeleteVoucher(resVoucher.ID, req.Reason, req.ModifiedBy); err != nil {
+			return err
+		}
+		after := *resVoucher
+		after.IsDeleted, after.DeletedReason, after.ModifiedBy = true, req.Reason, req.ModifiedBy
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionDelete, constants.AuditEntityVoucher, resVoucher.ID, resVoucher, &after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbVoucher.DeleteVoucherRes{IsSuccess: true}, nil
+}
+
+type restoreVoucherReqValidate struct {
+	Id         int32  `validate:"required"`
+	ModifiedBy string `validate:"max=255"`
+}
+
+func validateRestoreVoucherReq(ctx context.Context, req *pbVoucher.RestoreVoucherReq) error {
+	return validator.ValidateReqField(restoreVoucherReqValidate{
+		Id:         req.Id,
+		ModifiedBy: auth.Actor(ctx, req.ModifiedBy),
+	})
+}
+
+func (s *VoucherService) RestoreVoucher(ctx context.Context, req *pbVoucher.RestoreVoucherReq) (*pbVoucher.RestoreVoucherRes, error) {
+	req.ModifiedBy = auth.Actor(ctx, req.ModifiedBy)
+	if err := validateRestoreVoucherReq(ctx, req); err != nil {
+		return &pbVoucher.RestoreVoucherRes{IsSuccess: false}, err
+	}
+	resVoucher, err := s.voucherRepo.FindVoucherByIdWithDeleted(uint(req.Id))
+	if err != nil || resVoucher == nil || !resVoucher.IsDeleted {
+		return &pbVoucher.RestoreVoucherRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("deleted voucher"))
+	}
+	if err := auth.AuthorizeBrand(ctx, resVoucher.BrandID); err != nil {
+		return &pbVoucher.RestoreVoucherRes{IsSuccess: false}, err
+	}
+	// A voucher cannot come back into a catalog whose brand is gone.
+	resBrand, err := s.brandRepo.FindBrandById(resVoucher.BrandID)
+	if err != nil || resBrand == nil {
+		return &pbVoucher.RestoreVoucherRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("brand"))
+	}
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		if err := s.voucherRepo.WithContext(ctx).RestoreVoucher(resVoucher.ID, req.ModifiedBy); err != nil {
+			return err
+		}
+		after := *resVoucher
+		after.IsDeleted, after.DeletedReason, after.ModifiedBy = false, "", req.ModifiedBy
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionRestore, constants.AuditEntityVoucher, resVoucher.ID, resVoucher, &after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbVoucher.RestoreVoucherRes{IsSuccess: true}, nil
+}
+
+type setVoucherTagsReqValidate struct {
+	VoucherId int32    `validate:"required"`
+	Tags      []string `validate:"max=20,dive,required,max=100"`
+}
+
+func validateSetVoucherTagsReq(ctx context.Context, req *pbVoucher.SetVoucherTagsReq) error {
+	return validator.ValidateReqField(setVoucherTagsReqValidate{
+		VoucherId: req.VoucherId,
+		Tags:      req.Tags,
+	})
+}
+
+func (s *VoucherService) SetVoucherTags(ctx context.Context, req *pbVoucher.SetVoucherTagsReq) (*pbVoucher.SetVoucherTagsRes, error) {
+	if err := validateSetVoucherTagsReq(ctx, req); err != nil {
+		return &pbVoucher.SetVoucherTagsRes{IsSuccess: false}, err
+	}
+	resVoucher, err := s.voucherRepo.FindVoucherById(uint(req.VoucherId))
+	if err != nil || resVoucher == nil {
+		return &pbVoucher.SetVoucherTagsRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("voucher"))
+	}
+	if err := auth.AuthorizeBrand(ctx, resVoucher.BrandID); err != nil {
+		return &pbVoucher.SetVoucherTagsRes{IsSuccess: false}, err
+	}
+	// Replace overwrites resVoucher.Tags, keep a copy of the old ones for the audit log.
+	before := *resVoucher
+	err = s.transactor.Run(ctx, func(ctx context.Context) error {
+		tags, err := s.voucherRepo.WithContext(ctx).FindOrCreateTags(NormalizeTags(req.Tags))
+		if err != nil {
+			return err
+		}
+		if err := s.voucherRepo.WithContext(ctx).ReplaceVoucherTags(resVoucher, tags); err != nil {
+			return err
+		}
+		after := before
+		after.Tags = tags
+		return audit_model.Record(ctx, s.auditRepo, constants.AuditActionUpdate, constants.AuditEntityVoucher, resVoucher.ID, &before, &after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbVoucher.SetVoucherTagsRes{IsSuccess: true}, nil
+}
+
+func (s *VoucherService) ListTag(ctx context.Context, req *pbVoucher.ListTagReq) (*pbVoucher.ListTagRes, error) {
+	page, err := pagination.NewPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return &pbVoucher.ListTagRes{}, err
+	}
+
+	result, total, err := s.voucherRepo.ListTag(page)
+	if err != nil {
+		return nil, err
+	}
+	result, hasNext := pagination.Trim(result, page.Size)
+	list := []*pbVoucher.Tag{}
+
+	for _, t := range result {
+		list = append(list, &pbVoucher.Tag{
+			Id:   int32(t.ID),
+			Name: t.Name,
+		})
+	}
+	res := &pbVoucher.ListTagRes{
+		Data:       list,
+		TotalCount: total,
+	}
+	if hasNext {
+		last := result[len(result)-1]
+		res.NextPageToken = pagination.EncodeToken(pagination.Cursor{ID: last.ID, Value: last.Name})
+	}
+	return res, nil
+}
+
+// DeleteTag removes a tag from the catalog and from every voucher carrying it.
+func (s *VoucherService) DeleteTag(ctx context.Context, req *pbVoucher.DeleteTagReq) (*pbVoucher.DeleteTagRes, error) {
+	if req.Id == 0 {
+		return &pbVoucher.DeleteTagRes{IsSuccess: false}, error_base.ErrValidationFailed.WithMessage(message.RequiredMessage("id"))
+	}
+	err := s.transactor.Run(ctx, func(ctx context.Context) error {
+		tag, vouchers, err := s.voucherRepo.WithContext(ctx).DeleteTag(uint(req.Id))
+		if err != nil {
+			return err
+		}
+		err = audit_model.Record(ctx, s.auditRepo, constants.AuditActionDelete, constants.AuditEntityTag, tag.ID, tag, nil)
+		if err != nil {
+			return err
+		}
+		for _, voucher := range vouchers {
+			after := *voucher
+			after.Tags = []voucher_model.Tag{}
+			for _, t := range voucher.Tags {
+				if t.ID != tag.ID {
+					after.Tags = append(after.Tags, t)
+				}
+			}
+			err = audit_model.Record(ctx, s.auditRepo, constants.AuditActionUpdate, constants.AuditEntityVoucher, voucher.ID, voucher, &after)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbVoucher.DeleteTagRes{IsSuccess: false}, error_base.ErrNotFound.WithMessage(message.NotFoundMessage("tag"))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pbVoucher.DeleteTagRes{IsSuccess: true}, nil
+}
+
+// NormalizeTags lowercases and trims free-form tags and drops duplicates.
+func NormalizeTags(tags []string) []string {
+	seen := map[string]bool{}
+	result := []string{}
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+func toPbCategoryId(categoryId *uint) *int32 {
+	if categoryId == nil {
+		return nil
+	}
+	id := int32(*categoryId)
+	return &id
+}
+
+func toPbTags(tags []voucher_model.Tag) []string {
+	names := []string{}
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
@@ -2,18 +2,61 @@ package voucher_service
 
 import (
 	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/models/audit_model"
 	"customer-voucher-service/models/brand_model"
+	"customer-voucher-service/models/category_model"
+	"customer-voucher-service/models/transaction_model"
 	"customer-voucher-service/models/voucher_model"
+	pbCategory "customer-voucher-service/protogen/category"
 	pbVoucher "customer-voucher-service/protogen/voucher"
+	"customer-voucher-service/utils/auth"
+	"customer-voucher-service/utils/pagination"
 	"errors"
+	"strings"
 	"testing"
 	"time"
+
+	"gorm.io/gorm"
 )
 
+type MockTransactor struct{}
+
+func (MockTransactor) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// DepthTransactor tells the mocks how many transactions they run in.
+type DepthTransactor struct {
+	depth *int
+}
+
+func (t DepthTransactor) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	*t.depth++
+	defer func() { *t.depth-- }()
+	return fn(ctx)
+}
+
 type MockVoucherRepo struct {
 	createVoucherFunc func(voucher *voucher_model.Voucher) error
-	listVoucherFunc   func(req *pbVoucher.ListVoucherReq) ([]*voucher_model.Voucher, error)
+	listVoucherFunc   func(req *pbVoucher.ListVoucherReq, page *pagination.Page) ([]*voucher_model.Voucher, int64, error)
 	findByIdFunc      func(id uint) (*voucher_model.Voucher, error)
+	findForUpdateFunc func(id uint) (*voucher_model.Voucher, error)
+	findForShareFunc  func(id uint) (*voucher_model.Voucher, error)
+	findOrCreateTags  func(names []string) ([]voucher_model.Tag, error)
+	replaceTagsFunc   func(voucher *voucher_model.Voucher, tags []voucher_model.Tag) error
+	listTagFunc       func(page *pagination.Page) ([]*voucher_model.Tag, int64, error)
+	deleteTagFunc     func(id uint) (*voucher_model.Tag, []*voucher_model.Voucher, error)
+
+	updateVoucherFunc func(voucher *voucher_model.Voucher) error
+	findDeletedFunc   func(id uint) (*voucher_model.Voucher, error)
+	deleteFunc        func(id uint, reason string, modifiedBy string) error
+	restoreFunc       func(id uint, modifiedBy string) error
+}
+
+func (m *MockVoucherRepo) WithContext(ctx context.Context) voucher_model.IVoucherRepo {
+	return m
 }
 
 func (m *MockVoucherRepo) CreateVoucher(voucher *voucher_model.Voucher) error {
@@ -23,11 +66,11 @@ func (m *MockVoucherRepo) CreateVoucher(voucher *voucher_model.Voucher) error {
 	return nil
 }
 
-func (m *MockVoucherRepo) ListVoucher(req *pbVoucher.ListVoucherReq) ([]*voucher_model.Voucher, error) {
+func (m *MockVoucherRepo) ListVoucher(req *pbVoucher.ListVoucherReq, page *pagination.Page) ([]*voucher_model.Voucher, int64, error) {
 	if m.listVoucherFunc != nil {
-		return m.listVoucherFunc(req)
+		return m.listVoucherFunc(req, page)
 	}
-	return []*voucher_model.Voucher{}, nil
+	return []*voucher_model.Voucher{}, 0, nil
 }
 
 func (m *MockVoucherRepo) FindVoucherById(id uint) (*voucher_model.Voucher, error) {
@@ -37,10 +80,93 @@ func (m *MockVoucherRepo) FindVoucherById(id uint) (*voucher_model.Voucher, erro
 	return nil, nil
 }
 
+func (m *MockVoucherRepo) FindVoucherByIdForUpdate(id uint) (*voucher_model.Voucher, error) {
+	if m.findForUpdateFunc != nil {
+		return m.findForUpdateFunc(id)
+	}
+	return m.FindVoucherById(id)
+}
+
+func (m *MockVoucherRepo) FindVoucherByIdForShare(id uint) (*voucher_model.Voucher, error) {
+	if m.findForShareFunc != nil {
+		return m.findForShareFunc(id)
+	}
+	return m.FindVoucherById(id)
+}
+
+func (m *MockVoucherRepo) UpdateVoucher(voucher *voucher_model.Voucher) error {
+	if m.updateVoucherFunc != nil {
+		return m.updateVoucherFunc(voucher)
+	}
+	return nil
+}
+
+func (m *MockVoucherRepo) FindVoucherByIdWithDeleted(id uint) (*voucher_model.Voucher, error) {
+	if m.findDeletedFunc != nil {
+		return m.findDeletedFunc(id)
+	}
+	return nil, nil
+}
+
+func (m *MockVoucherRepo) DeleteVoucher(id uint, reason string, modifiedBy string) error {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(id, reason, modifiedBy)
+	}
+	return nil
+}
+
+func (m *MockVoucherRepo) RestoreVoucher(id uint, modifiedBy string) error {
+	if m.restoreFunc != nil {
+		return m.restoreFunc(id, modifiedBy)
+	}
+	return nil
+}
+
+func (m *MockVoucherRepo) FindOrCreateTags(names []string) ([]voucher_model.Tag, error) {
+	if m.findOrCreateTags != nil {
+		return m.findOrCreateTags(names)
+	}
+	tags := []voucher_model.Tag{}
+	for i, name := range names {
+		tags = append(tags, voucher_model.Tag{ID: uint(i + 1), Name: name})
+	}
+	return tags, nil
+}
+
+func (m *MockVoucherRepo) ReplaceVoucherTags(voucher *voucher_model.Voucher, tags []voucher_model.Tag) error {
+	if m.replaceTagsFunc != nil {
+		return m.replaceTagsFunc(voucher, tags)
+	}
+	return nil
+}
+
+func (m *MockVoucherRepo) ListTag(page *pagination.Page) ([]*voucher_model.Tag, int64, error) {
+	if m.listTagFunc != nil {
+		return m.listTagFunc(page)
+	}
+	return []*voucher_model.Tag{}, 0, nil
+}
+
+func (m *MockVoucherRepo) DeleteTag(id uint) (*voucher_model.Tag, []*voucher_model.Voucher, error) {
+	if m.deleteTagFunc != nil {
+		return m.deleteTagFunc(id)
+	}
+	return &voucher_model.Tag{ID: id}, []*voucher_model.Voucher{}, nil
+}
+
 type MockBrandRepo struct {
-	createBrandFunc func(brand *brand_model.Brand) error
-	listBrandFunc   func() ([]*brand_model.Brand, error)
-	findByIdFunc    func(id uint) (*brand_model.Brand, error)
+	createBrandFunc  func(brand *brand_model.Brand) error
+	listBrandFunc    func(page *pagination.Page) ([]*brand_model.Brand, int64, error)
+	findByIdFunc     func(id uint) (*brand_model.Brand, error)
+	findDeletedFunc  func(id uint) (*brand_model.Brand, error)
+	updateFunc       func(brand *brand_model.Brand) error
+	countVoucherFunc func(brandId uint) (int64, error)
+	deleteFunc       func(id uint, modifiedBy string, cascadeVouchers bool) ([]*voucher_model.Voucher, error)
+	restoreFunc      func(id uint, modifiedBy string) error
+}
+
+func (m *MockBrandRepo) WithContext(ctx context.Context) brand_model.IBrandRepo {
+	return m
 }
 
 func (m *MockBrandRepo) CreateBrand(brand *brand_model.Brand) error {
@@ -50,11 +176,11 @@ func (m *MockBrandRepo) CreateBrand(brand *brand_model.Brand) error {
 	return nil
 }
 
-func (m *MockBrandRepo) ListBrand() ([]*brand_model.Brand, error) {
+func (m *MockBrandRepo) ListBrand(page *pagination.Page) ([]*brand_model.Brand, int64, error) {
 	if m.listBrandFunc != nil {
-		return m.listBrandFunc()
+		return m.listBrandFunc(page)
 	}
-	return []*brand_model.Brand{}, nil
+	return []*brand_model.Brand{}, 0, nil
 }
 
 func (m *MockBrandRepo) FindBrandById(id uint) (*brand_model.Brand, error) {
@@ -64,6 +190,115 @@ func (m *MockBrandRepo) FindBrandById(id uint) (*brand_model.Brand, error) {
 	return nil, nil
 }
 
+func (m *MockBrandRepo) FindDeletedBrandById(id uint) (*brand_model.Brand, error) {
+	if m.findDeletedFunc != nil {
+		return m.findDeletedFunc(id)
+	}
+	return nil, nil
+}
+
+func (m *MockBrandRepo) UpdateBrand(brand *brand_model.Brand) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(brand)
+	}
+	return nil
+}
+
+func (m *MockBrandRepo) CountActiveVoucher(brandId uint) (int64, error) {
+	if m.countVoucherFunc != nil {
+		return m.countVoucherFunc(brandId)
+	}
+	return 0, nil
+}
+
+func (m *MockBrandRepo) DeleteBrand(id uint, modifiedBy string, cascadeVouchers bool) ([]*voucher_model.Voucher, error) {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(id, modifiedBy, cascadeVouchers)
+	}
+	return []*voucher_model.Voucher{}, nil
+}
+
+func (m *MockBrandRepo) RestoreBrand(id uint, modifiedBy string) error {
+	if m.restoreFunc != nil {
+		return m.restoreFunc(id, modifiedBy)
+	}
+	return nil
+}
+
+type MockCategoryRepo struct {
+	findByIdFunc func(id uint) (*category_model.Category, error)
+}
+
+func (m *MockCategoryRepo) WithContext(ctx context.Context) category_model.ICategoryRepo {
+	return m
+}
+
+func (m *MockCategoryRepo) CreateCategory(category *category_model.Category) error {
+	return nil
+}
+
+func (m *MockCategoryRepo) ListCategory(req *pbCategory.ListCategoryReq, page *pagination.Page) ([]*category_model.Category, int64, error) {
+	return []*category_model.Category{}, 0, nil
+}
+
+func (m *MockCategoryRepo) FindCategoryById(id uint) (*category_model.Category, error) {
+	if m.findByIdFunc != nil {
+		return m.findByIdFunc(id)
+	}
+	return nil, nil
+}
+
+func (m *MockCategoryRepo) UpdateCategory(category *category_model.Category) error {
+	return nil
+}
+
+func (m *MockCategoryRepo) DeleteCategory(id uint) ([]uint, error) {
+	return []uint{}, nil
+}
+
+func (m *MockCategoryRepo) ListDescendantCategoryId(id uint) ([]uint, error) {
+	return []uint{id}, nil
+}
+
+func (m *MockCategoryRepo) CountChildCategory(id uint) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockCategoryRepo) CountActiveVoucher(id uint) (int64, error) {
+	return 0, nil
+}
+
+// MockTransactionRepo only stubs what VoucherService uses; any other call panics on the nil embedded interface.
+type MockTransactionRepo struct {
+	transaction_model.ITransactionRepo
+	countPendingFunc func(voucherId uint) (int64, error)
+}
+
+func (m *MockTransactionRepo) WithContext(ctx context.Context) transaction_model.ITransactionRepo {
+	return m
+}
+
+func (m *MockTransactionRepo) CountPendingTransactionByVoucher(voucherId uint) (int64, error) {
+	if m.countPendingFunc != nil {
+		return m.countPendingFunc(voucherId)
+	}
+	return 0, nil
+}
+
+type MockAuditRepo struct {
+	audit_model.IAuditRepo
+	entries []*audit_model.AuditLog
+}
+
+func (m *MockAuditRepo) WithContext(ctx context.Context) audit_model.IAuditRepo {
+	return m
+}
+
+func (m *MockAuditRepo) CreateAuditLog(auditLog *audit_model.AuditLog) error {
+	m.entries = append(m.entries, auditLog)
+	return nil
+}
+
 func TestCreateVoucher_Success(t *testing.T) {
 	mockBrand := &brand_model.Brand{
 		ID:   1,
@@ -101,8 +336,10 @@ func TestCreateVoucher_Success(t *testing.T) {
 	}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
 		brandRepo:   mockBrandRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.CreateVoucherReq{
@@ -131,8 +368,10 @@ func TestCreateVoucher_ValidationError_EmptyBrandId(t *testing.T) {
 	mockBrandRepo := &MockBrandRepo{}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
 		brandRepo:   mockBrandRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.CreateVoucherReq{
@@ -161,8 +400,10 @@ func TestCreateVoucher_ValidationError_EmptyName(t *testing.T) {
 	mockBrandRepo := &MockBrandRepo{}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
 		brandRepo:   mockBrandRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.CreateVoucherReq{
@@ -191,8 +432,10 @@ func TestCreateVoucher_ValidationError_NameTooLong(t *testing.T) {
 	mockBrandRepo := &MockBrandRepo{}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
 		brandRepo:   mockBrandRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	longName := ""
@@ -226,8 +469,10 @@ func TestCreateVoucher_ValidationError_DescriptionTooLong(t *testing.T) {
 	mockBrandRepo := &MockBrandRepo{}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
 		brandRepo:   mockBrandRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	longDescription := ""
@@ -261,8 +506,10 @@ func TestCreateVoucher_ValidationError_EmptyCostInPoint(t *testing.T) {
 	mockBrandRepo := &MockBrandRepo{}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
 		brandRepo:   mockBrandRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.CreateVoucherReq{
@@ -291,8 +538,10 @@ func TestCreateVoucher_ValidationError_EmptyVoucherCode(t *testing.T) {
 	mockBrandRepo := &MockBrandRepo{}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
 		brandRepo:   mockBrandRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.CreateVoucherReq{
@@ -316,13 +565,125 @@ func TestCreateVoucher_ValidationError_EmptyVoucherCode(t *testing.T) {
 	}
 }
 
+func TestCreateVoucher_ValidationError_InvalidVoucherCode(t *testing.T) {
+	service := &VoucherService{
+		transactor:  MockTransactor{},
+		voucherRepo: &MockVoucherRepo{},
+		brandRepo:   &MockBrandRepo{},
+		auditRepo:   &MockAuditRepo{},
+	}
+
+	req := &pbVoucher.CreateVoucherReq{
+		BrandId:     1,
+		Name:        "Test Voucher",
+		CostInPoint: -100,
+		VoucherCode: "test 001",
+	}
+
+	result, err := service.CreateVoucher(context.Background(), req)
+
+	var appErr error_base.AppError
+	if !errors.As(err, &appErr) {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	rules := map[string]string{}
+	for _, field := range appErr.Fields {
+		rules[field.Field] = field.Rule
+	}
+	if rules["costInPoint"] != "gte" || rules["voucherCode"] != "vouchercode" || len(rules) != 2 {
+		t.Errorf("Expected violations on costInPoint and voucherCode, got %+v", appErr.Fields)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestCreateVoucher_TagsCreatedInTransaction(t *testing.T) {
+	var depth, tagsDepth int
+	service := &VoucherService{
+		transactor: DepthTransactor{depth: &depth},
+		voucherRepo: &MockVoucherRepo{
+			findOrCreateTags: func(names []string) ([]voucher_model.Tag, error) {
+				tagsDepth = depth
+				return []voucher_model.Tag{{ID: 1, Name: names[0]}}, nil
+			},
+			createVoucherFunc: func(voucher *voucher_model.Voucher) error {
+				if len(voucher.Tags) != 1 {
+					t.Errorf("Expected the voucher to carry its tag, got %+v", voucher.Tags)
+				}
+				return errors.New("duplicate voucher code")
+			},
+		},
+		brandRepo: &MockBrandRepo{
+			findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+				return &brand_model.Brand{ID: id}, nil
+			},
+		},
+		auditRepo: &MockAuditRepo{},
+	}
+
+	req := &pbVoucher.CreateVoucherReq{
+		BrandId:     1,
+		Name:        "Test Voucher",
+		CostInPoint: 100,
+		VoucherCode: "TEST001",
+		Tags:        []string{"food"},
+	}
+
+	_, err := service.CreateVoucher(context.Background(), req)
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if tagsDepth != 1 {
+		t.Errorf("Expected tags to be created in the voucher's transaction, got depth %d", tagsDepth)
+	}
+}
+
+func TestCreateVoucher_LowercaseVoucherCode(t *testing.T) {
+	var saved string
+	service := &VoucherService{
+		transactor: MockTransactor{},
+		voucherRepo: &MockVoucherRepo{
+			createVoucherFunc: func(voucher *voucher_model.Voucher) error {
+				saved = voucher.VoucherCode
+				return nil
+			},
+		},
+		brandRepo: &MockBrandRepo{
+			findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+				return &brand_model.Brand{ID: id}, nil
+			},
+		},
+		auditRepo: &MockAuditRepo{},
+	}
+
+	req := &pbVoucher.CreateVoucherReq{
+		BrandId:     1,
+		Name:        "Test Voucher",
+		CostInPoint: 100,
+		VoucherCode: "promo-10",
+	}
+
+	result, err := service.CreateVoucher(context.Background(), req)
+
+	if err != nil || !result.IsSuccess {
+		t.Fatalf("Expected a lowercase code to be accepted, got %v", err)
+	}
+	if saved != "PROMO-10" {
+		t.Errorf("Expected voucher code to be saved as 'PROMO-10', got '%s'", saved)
+	}
+}
+
 func TestCreateVoucher_ValidationError_VoucherCodeTooLong(t *testing.T) {
 	mockVoucherRepo := &MockVoucherRepo{}
 	mockBrandRepo := &MockBrandRepo{}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
 		brandRepo:   mockBrandRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	longVoucherCode := ""
@@ -360,8 +721,10 @@ func TestCreateVoucher_BrandNotFound(t *testing.T) {
 	}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
 		brandRepo:   mockBrandRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.CreateVoucherReq{
@@ -407,8 +770,10 @@ func TestCreateVoucher_CreateVoucherError(t *testing.T) {
 	}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
 		brandRepo:   mockBrandRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.CreateVoucherReq{
@@ -457,13 +822,15 @@ func TestListVoucher_Success(t *testing.T) {
 	}
 
 	mockVoucherRepo := &MockVoucherRepo{
-		listVoucherFunc: func(req *pbVoucher.ListVoucherReq) ([]*voucher_model.Voucher, error) {
-			return mockVouchers, nil
+		listVoucherFunc: func(req *pbVoucher.ListVoucherReq, page *pagination.Page) ([]*voucher_model.Voucher, int64, error) {
+			return mockVouchers, int64(len(mockVouchers)), nil
 		},
 	}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.ListVoucherReq{}
@@ -527,13 +894,15 @@ func TestListVoucher_Success(t *testing.T) {
 
 func TestListVoucher_EmptyList(t *testing.T) {
 	mockVoucherRepo := &MockVoucherRepo{
-		listVoucherFunc: func(req *pbVoucher.ListVoucherReq) ([]*voucher_model.Voucher, error) {
-			return []*voucher_model.Voucher{}, nil
+		listVoucherFunc: func(req *pbVoucher.ListVoucherReq, page *pagination.Page) ([]*voucher_model.Voucher, int64, error) {
+			return []*voucher_model.Voucher{}, 0, nil
 		},
 	}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.ListVoucherReq{}
@@ -554,13 +923,15 @@ func TestListVoucher_EmptyList(t *testing.T) {
 func TestListVoucher_RepositoryError(t *testing.T) {
 	expectedError := errors.New("database error")
 	mockVoucherRepo := &MockVoucherRepo{
-		listVoucherFunc: func(req *pbVoucher.ListVoucherReq) ([]*voucher_model.Voucher, error) {
-			return []*voucher_model.Voucher{}, expectedError
+		listVoucherFunc: func(req *pbVoucher.ListVoucherReq, page *pagination.Page) ([]*voucher_model.Voucher, int64, error) {
+			return []*voucher_model.Voucher{}, 0, expectedError
 		},
 	}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.ListVoucherReq{}
@@ -595,16 +966,18 @@ func TestListVoucher_WithBrandFilter(t *testing.T) {
 	}
 
 	mockVoucherRepo := &MockVoucherRepo{
-		listVoucherFunc: func(req *pbVoucher.ListVoucherReq) ([]*voucher_model.Voucher, error) {
+		listVoucherFunc: func(req *pbVoucher.ListVoucherReq, page *pagination.Page) ([]*voucher_model.Voucher, int64, error) {
 			if req.BrandId != nil && *req.BrandId == 1 {
-				return mockVouchers, nil
+				return mockVouchers, int64(len(mockVouchers)), nil
 			}
-			return []*voucher_model.Voucher{}, nil
+			return []*voucher_model.Voucher{}, 0, nil
 		},
 	}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	brandId := int32(1)
@@ -652,7 +1025,9 @@ func TestDetailVoucher_Success(t *testing.T) {
 	}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.DetailVoucherReq{
@@ -701,7 +1076,9 @@ func TestDetailVoucher_NotFound(t *testing.T) {
 	}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.DetailVoucherReq{
@@ -713,6 +1090,9 @@ func TestDetailVoucher_NotFound(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error, got nil")
 	}
+	if !errors.Is(err, error_base.ErrNotFound) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
 	if result != nil {
 		t.Error("Expected result to be nil")
 	}
@@ -742,7 +1122,9 @@ func TestDetailVoucher_WithDeletedVoucher(t *testing.T) {
 	}
 
 	service := &VoucherService{
+		transactor:  MockTransactor{},
 		voucherRepo: mockVoucherRepo,
+		auditRepo:   &MockAuditRepo{},
 	}
 
 	req := &pbVoucher.DetailVoucherReq{
@@ -764,3 +1146,854 @@ func TestDetailVoucher_WithDeletedVoucher(t *testing.T) {
 		t.Error("Expected voucher to be deleted")
 	}
 }
+
+func TestCreateVoucher_WithCategoryAndTags(t *testing.T) {
+	mockVoucherRepo := &MockVoucherRepo{
+		createVoucherFunc: func(voucher *voucher_model.Voucher) error {
+			if voucher.CategoryID == nil || *voucher.CategoryID != 3 {
+				t.Error("Expected category ID to be 3")
+			}
+			if len(voucher.Tags) != 2 || voucher.Tags[0].Name != "coffee" || voucher.Tags[1].Name != "weekend" {
+				t.Errorf("Expected tags [coffee weekend], got %v", voucher.Tags)
+			}
+			return nil
+		},
+	}
+
+	mockBrandRepo := &MockBrandRepo{
+		findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+			return &brand_model.Brand{ID: id}, nil
+		},
+	}
+
+	mockCategoryRepo := &MockCategoryRepo{
+		findByIdFunc: func(id uint) (*category_model.Category, error) {
+			return &category_model.Category{ID: id}, nil
+		},
+	}
+
+	service := &VoucherService{
+		transactor:   MockTransactor{},
+		voucherRepo:  mockVoucherRepo,
+		brandRepo:    mockBrandRepo,
+		categoryRepo: mockCategoryRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	categoryId := int32(3)
+	req := &pbVoucher.CreateVoucherReq{
+		BrandId:     1,
+		Name:        "Test Voucher",
+		CostInPoint: 100,
+		VoucherCode: "TEST001",
+		CategoryId:  &categoryId,
+		Tags:        []string{" Coffee", "weekend", "COFFEE"},
+	}
+
+	result, err := service.CreateVoucher(context.Background(), req)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+}
+
+func TestCreateVoucher_CategoryNotFound(t *testing.T) {
+	mockVoucherRepo := &MockVoucherRepo{
+		createVoucherFunc: func(voucher *voucher_model.Voucher) error {
+			t.Error("Expected voucher not to be created")
+			return nil
+		},
+	}
+
+	mockBrandRepo := &MockBrandRepo{
+		findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+			return &brand_model.Brand{ID: id}, nil
+		},
+	}
+
+	mockCategoryRepo := &MockCategoryRepo{
+		findByIdFunc: func(id uint) (*category_model.Category, error) {
+			return nil, errors.New("category not found")
+		},
+	}
+
+	service := &VoucherService{
+		transactor:   MockTransactor{},
+		voucherRepo:  mockVoucherRepo,
+		brandRepo:    mockBrandRepo,
+		categoryRepo: mockCategoryRepo,
+		auditRepo:    &MockAuditRepo{},
+	}
+
+	categoryId := int32(99)
+	req := &pbVoucher.CreateVoucherReq{
+		BrandId:     1,
+		Name:        "Test Voucher",
+		CostInPoint: 100,
+		VoucherCode: "TEST001",
+		CategoryId:  &categoryId,
+	}
+
+	result, err := service.CreateVoucher(context.Background(), req)
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestListVoucher_WithCatalogFilters(t *testing.T) {
+	categoryId := int32(2)
+	tag := "  Coffee "
+	minCost := int64(100)
+	maxCost := int64(500)
+
+	mockVoucherRepo := &MockVoucherRepo{
+		listVoucherFunc: func(req *pbVoucher.ListVoucherReq, page *pagination.Page) ([]*voucher_model.Voucher, int64, error) {
+			if req.GetCategoryId() != 2 || req.GetMinCostInPoint() != 100 || req.GetMaxCostInPoint() != 500 {
+				t.Errorf("Expected filters to be passed to repository, got %v", req)
+			}
+			if req.GetTag() != "coffee" {
+				t.Errorf("Expected tag to be normalized to 'coffee', got '%s'", req.GetTag())
+			}
+			categoryIdUint := uint(2)
+			return []*voucher_model.Voucher{
+				{ID: 1, BrandID: 1, Name: "Kopi", CostInPoint: 200, CategoryID: &categoryIdUint, Tags: []voucher_model.Tag{{ID: 1, Name: "coffee"}}},
+			}, 1, nil
+		},
+	}
+
+	service := &VoucherService{
+		transactor:  MockTransactor{},
+		voucherRepo: mockVoucherRepo,
+		brandRepo:   &MockBrandRepo{},
+		auditRepo:   &MockAuditRepo{},
+	}
+
+	req := &pbVoucher.ListVoucherReq{
+		CategoryId:     &categoryId,
+		Tag:            &tag,
+		MinCostInPoint: &minCost,
+		MaxCostInPoint: &maxCost,
+	}
+
+	result, err := service.ListVoucher(context.Background(), req)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if len(result.Data) != 1 {
+		t.Fatalf("Expected 1 voucher, got %d", len(result.Data))
+	}
+	if result.Data[0].GetCategoryId() != 2 {
+		t.Errorf("Expected category ID to be 2, got %d", result.Data[0].GetCategoryId())
+	}
+	if len(result.Data[0].Tags) != 1 || result.Data[0].Tags[0] != "coffee" {
+		t.Errorf("Expected tags [coffee], got %v", result.Data[0].Tags)
+	}
+}
+
+func TestListVoucher_InvalidCostRange(t *testing.T) {
+	minCost := int64(500)
+	maxCost := int64(100)
+
+	service := &VoucherService{
+		transactor:  MockTransactor{},
+		voucherRepo: &MockVoucherRepo{},
+		brandRepo:   &MockBrandRepo{},
+		auditRepo:   &MockAuditRepo{},
+	}
+
+	result, err := service.ListVoucher(context.Background(), &pbVoucher.ListVoucherReq{MinCostInPoint: &minCost, MaxCostInPoint: &maxCost})
+
+	if err == nil {
+		t.Error("Expected validation error, got nil")
+	}
+	if result == nil {
+		t.Error("Expected result to not be nil")
+	}
+}
+
+func TestSetVoucherTags_Success(t *testing.T) {
+	replaced := []voucher_model.Tag{}
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id}, nil
+		},
+		replaceTagsFunc: func(voucher *voucher_model.Voucher, tags []voucher_model.Tag) error {
+			replaced = tags
+			return nil
+		},
+	}
+
+	service := &VoucherService{
+		transactor:  MockTransactor{},
+		voucherRepo: mockVoucherRepo,
+		brandRepo:   &MockBrandRepo{},
+		auditRepo:   &MockAuditRepo{},
+	}
+
+	result, err := service.SetVoucherTags(context.Background(), &pbVoucher.SetVoucherTagsReq{VoucherId: 1, Tags: []string{"Food", "food", "Drink"}})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+	if len(replaced) != 2 {
+		t.Errorf("Expected 2 tags, got %d", len(replaced))
+	}
+}
+
+func TestSetVoucherTags_VoucherNotFound(t *testing.T) {
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return nil, errors.New("voucher not found")
+		},
+	}
+
+	service := &VoucherService{
+		transactor:  MockTransactor{},
+		voucherRepo: mockVoucherRepo,
+		brandRepo:   &MockBrandRepo{},
+		auditRepo:   &MockAuditRepo{},
+	}
+
+	result, err := service.SetVoucherTags(context.Background(), &pbVoucher.SetVoucherTagsReq{VoucherId: 1, Tags: []string{"food"}})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestListTag_Paged(t *testing.T) {
+	var gotPage *pagination.Page
+	mockVoucherRepo := &MockVoucherRepo{
+		listTagFunc: func(page *pagination.Page) ([]*voucher_model.Tag, int64, error) {
+			gotPage = page
+			return []*voucher_model.Tag{
+				{ID: 4, Name: "coffee"},
+				{ID: 2, Name: "promo"},
+				{ID: 9, Name: "weekend"},
+			}, 5, nil
+		},
+	}
+
+	service := &VoucherService{
+		transactor:  MockTransactor{},
+		voucherRepo: mockVoucherRepo,
+	}
+
+	req := &pbVoucher.ListTagReq{
+		PageSize:  2,
+		PageToken: pagination.EncodeToken(pagination.Cursor{ID: 1, Value: "breakfast"}),
+	}
+
+	result, err := service.ListTag(context.Background(), req)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if gotPage.Size != 2 || gotPage.Cursor == nil || gotPage.Cursor.Value != "breakfast" {
+		t.Errorf("Expected page size 2 after 'breakfast', got %+v", gotPage)
+	}
+	if len(result.Data) != 2 || result.TotalCount != 5 {
+		t.Errorf("Expected 2 of 5 tags, got %d of %d", len(result.Data), result.TotalCount)
+	}
+
+	cursor, err := pagination.DecodeToken(result.NextPageToken)
+	if err != nil {
+		t.Errorf("Expected valid next page token, got %v", err)
+	}
+	if cursor.ID != 2 || cursor.Value != "promo" {
+		t.Errorf("Expected next page to start after tag 'promo', got %+v", cursor)
+	}
+}
+
+func TestDeleteTag_Success(t *testing.T) {
+	var deletedId uint
+	mockVoucherRepo := &MockVoucherRepo{
+		deleteTagFunc: func(id uint) (*voucher_model.Tag, []*voucher_model.Voucher, error) {
+			deletedId = id
+			return &voucher_model.Tag{ID: id, Name: "coffee"}, []*voucher_model.Voucher{}, nil
+		},
+	}
+
+	service := &VoucherService{
+		transactor:  MockTransactor{},
+		voucherRepo: mockVoucherRepo,
+		auditRepo:   &MockAuditRepo{},
+	}
+
+	result, err := service.DeleteTag(context.Background(), &pbVoucher.DeleteTagReq{Id: 7})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+	if deletedId != 7 {
+		t.Errorf("Expected tag 7 to be deleted, got %d", deletedId)
+	}
+}
+
+func TestDeleteTag_Audited(t *testing.T) {
+	coffee := voucher_model.Tag{ID: 7, Name: "coffee"}
+	promo := voucher_model.Tag{ID: 8, Name: "promo"}
+	mockVoucherRepo := &MockVoucherRepo{
+		deleteTagFunc: func(id uint) (*voucher_model.Tag, []*voucher_model.Voucher, error) {
+			return &coffee, []*voucher_model.Voucher{{ID: 3, Tags: []voucher_model.Tag{coffee, promo}}}, nil
+		},
+	}
+	auditRepo := &MockAuditRepo{}
+
+	service := &VoucherService{
+		transactor:  MockTransactor{},
+		voucherRepo: mockVoucherRepo,
+		auditRepo:   auditRepo,
+	}
+
+	_, err := service.DeleteTag(context.Background(), &pbVoucher.DeleteTagReq{Id: 7})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(auditRepo.entries) != 2 {
+		t.Fatalf("Expected the tag and its voucher to be audited, got %d entries", len(auditRepo.entries))
+	}
+	tag := auditRepo.entries[0]
+	if tag.Action != constants.AuditActionDelete || tag.EntityType != constants.AuditEntityTag || tag.EntityID != 7 {
+		t.Errorf("Expected a delete entry for tag 7, got %+v", tag)
+	}
+	voucher := auditRepo.entries[1]
+	if voucher.EntityType != constants.AuditEntityVoucher || voucher.EntityID != 3 ||
+		!strings.Contains(voucher.Before, `"coffee"`) || strings.Contains(voucher.After, `"coffee"`) || !strings.Contains(voucher.After, `"promo"`) {
+		t.Errorf("Expected voucher 3 to lose only the coffee tag, got %+v", voucher)
+	}
+}
+
+func TestDeleteTag_NotFound(t *testing.T) {
+	mockVoucherRepo := &MockVoucherRepo{
+		deleteTagFunc: func(id uint) (*voucher_model.Tag, []*voucher_model.Voucher, error) {
+			return nil, nil, gorm.ErrRecordNotFound
+		},
+	}
+
+	service := &VoucherService{
+		transactor:  MockTransactor{},
+		voucherRepo: mockVoucherRepo,
+		auditRepo:   &MockAuditRepo{},
+	}
+
+	result, err := service.DeleteTag(context.Background(), &pbVoucher.DeleteTagReq{Id: 7})
+
+	if !errors.Is(err, error_base.ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestUpdateVoucher_Success(t *testing.T) {
+	var updated *voucher_model.Voucher
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, Name: "Old", CostInPoint: 100}, nil
+		},
+		updateVoucherFunc: func(voucher *voucher_model.Voucher) error {
+			updated = voucher
+			return nil
+		},
+	}
+
+	service := &VoucherService{
+		transactor:      MockTransactor{},
+		voucherRepo:     mockVoucherRepo,
+		transactionRepo: &MockTransactionRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	req := &pbVoucher.UpdateVoucherReq{Id: 1, Name: "New", Description: "Desc", CostInPoint: 150, ModifiedBy: "admin"}
+	result, err := service.UpdateVoucher(context.Background(), req)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+	if updated == nil || updated.ID != 1 || updated.Name != "New" || updated.CostInPoint != 150 || updated.ModifiedBy != "admin" {
+		t.Errorf("Expected voucher to be updated, got %+v", updated)
+	}
+}
+
+func TestUpdateVoucher_ChangeCategory(t *testing.T) {
+	oldCategoryId := uint(2)
+	var updated *voucher_model.Voucher
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, Name: "Old", CostInPoint: 100, CategoryID: &oldCategoryId}, nil
+		},
+		updateVoucherFunc: func(voucher *voucher_model.Voucher) error {
+			updated = voucher
+			return nil
+		},
+	}
+	mockCategoryRepo := &MockCategoryRepo{
+		findByIdFunc: func(id uint) (*category_model.Category, error) {
+			return &category_model.Category{ID: id}, nil
+		},
+	}
+
+	service := &VoucherService{
+		transactor:      MockTransactor{},
+		voucherRepo:     mockVoucherRepo,
+		categoryRepo:    mockCategoryRepo,
+		transactionRepo: &MockTransactionRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	categoryId := int32(5)
+	req := &pbVoucher.UpdateVoucherReq{Id: 1, Name: "Old", CostInPoint: 100, ModifiedBy: "admin", CategoryId: &categoryId}
+	_, err := service.UpdateVoucher(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if updated == nil || updated.CategoryID == nil || *updated.CategoryID != 5 {
+		t.Errorf("Expected category 5, got %+v", updated)
+	}
+
+	categoryId = 0
+	_, err = service.UpdateVoucher(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if updated.CategoryID != nil {
+		t.Errorf("Expected category to be cleared, got %d", *updated.CategoryID)
+	}
+
+	req.CategoryId = nil
+	_, err = service.UpdateVoucher(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if updated.CategoryID == nil || *updated.CategoryID != oldCategoryId {
+		t.Errorf("Expected category to be kept, got %+v", updated.CategoryID)
+	}
+}
+
+func TestUpdateVoucher_CategoryNotFound(t *testing.T) {
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, Name: "Old", CostInPoint: 100}, nil
+		},
+		updateVoucherFunc: func(voucher *voucher_model.Voucher) error {
+			t.Error("Expected voucher not to be updated")
+			return nil
+		},
+	}
+
+	service := &VoucherService{
+		transactor:      MockTransactor{},
+		voucherRepo:     mockVoucherRepo,
+		categoryRepo:    &MockCategoryRepo{},
+		transactionRepo: &MockTransactionRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	categoryId := int32(9)
+	result, err := service.UpdateVoucher(context.Background(), &pbVoucher.UpdateVoucherReq{Id: 1, Name: "Old", CostInPoint: 100, ModifiedBy: "admin", CategoryId: &categoryId})
+
+	if !errors.Is(err, error_base.ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestUpdateVoucher_ModifiedByFromCaller(t *testing.T) {
+	var updated *voucher_model.Voucher
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, Name: "Old", CostInPoint: 100}, nil
+		},
+		updateVoucherFunc: func(voucher *voucher_model.Voucher) error {
+			updated = voucher
+			return nil
+		},
+	}
+
+	service := &VoucherService{
+		transactor:      MockTransactor{},
+		voucherRepo:     mockVoucherRepo,
+		transactionRepo: &MockTransactionRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{ID: "user-1", Role: constants.RoleAdmin})
+	req := &pbVoucher.UpdateVoucherReq{Id: 1, Name: "New", CostInPoint: 100, ModifiedBy: "someone-else"}
+	_, err := service.UpdateVoucher(ctx, req)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if updated == nil || updated.ModifiedBy != "user-1" {
+		t.Errorf("Expected ModifiedBy to be the caller, got %+v", updated)
+	}
+}
+
+func TestUpdateVoucher_WithoutModifiedBy(t *testing.T) {
+	updateCalled := false
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, Name: "Old", CostInPoint: 100}, nil
+		},
+		updateVoucherFunc: func(voucher *voucher_model.Voucher) error {
+			updateCalled = true
+			return nil
+		},
+	}
+
+	service := &VoucherService{
+		transactor:      MockTransactor{},
+		voucherRepo:     mockVoucherRepo,
+		transactionRepo: &MockTransactionRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{ID: "user-1", Role: constants.RoleAdmin})
+	result, err := service.UpdateVoucher(ctx, &pbVoucher.UpdateVoucherReq{Id: 1, Name: "New", CostInPoint: 100})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result == nil || !result.IsSuccess || !updateCalled {
+		t.Error("Expected the voucher to be updated")
+	}
+}
+
+func TestUpdateVoucher_ValidationError(t *testing.T) {
+	service := &VoucherService{
+		transactor:      MockTransactor{},
+		voucherRepo:     &MockVoucherRepo{},
+		transactionRepo: &MockTransactionRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	cases := []*pbVoucher.UpdateVoucherReq{
+		{Name: "New", CostInPoint: 100, ModifiedBy: "admin"},
+		{Id: 1, CostInPoint: 100, ModifiedBy: "admin"},
+		{Id: 1, Name: "New", ModifiedBy: "admin"},
+		{Id: 1, Name: "New", CostInPoint: -1, ModifiedBy: "admin"},
+		{Id: 1, Name: "New", CostInPoint: 100, ModifiedBy: strings.Repeat("a", 256)},
+	}
+	for _, req := range cases {
+		result, err := service.UpdateVoucher(context.Background(), req)
+		if err == nil {
+			t.Errorf("Expected validation error for %v", req)
+		}
+		if result == nil || result.IsSuccess {
+			t.Error("Expected IsSuccess to be false")
+		}
+	}
+}
+
+func TestUpdateVoucher_VoucherNotFound(t *testing.T) {
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return nil, errors.New("voucher not found")
+		},
+	}
+
+	service := &VoucherService{
+		transactor:      MockTransactor{},
+		voucherRepo:     mockVoucherRepo,
+		transactionRepo: &MockTransactionRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	result, err := service.UpdateVoucher(context.Background(), &pbVoucher.UpdateVoucherReq{Id: 1, Name: "New", CostInPoint: 100, ModifiedBy: "admin"})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestUpdateVoucher_CostChangeBlockedByPendingGift(t *testing.T) {
+	updateCalled := false
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, Name: "Old", CostInPoint: 100}, nil
+		},
+		updateVoucherFunc: func(voucher *voucher_model.Voucher) error {
+			updateCalled = true
+			return nil
+		},
+	}
+	mockTransactionRepo := &MockTransactionRepo{
+		countPendingFunc: func(voucherId uint) (int64, error) {
+			return 2, nil
+		},
+	}
+
+	service := &VoucherService{
+		transactor:      MockTransactor{},
+		voucherRepo:     mockVoucherRepo,
+		transactionRepo: mockTransactionRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	result, err := service.UpdateVoucher(context.Background(), &pbVoucher.UpdateVoucherReq{Id: 1, Name: "Old", CostInPoint: 200, ModifiedBy: "admin"})
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+	if updateCalled {
+		t.Error("Expected voucher not to be updated")
+	}
+
+	result, err = service.UpdateVoucher(context.Background(), &pbVoucher.UpdateVoucherReq{Id: 1, Name: "Renamed", CostInPoint: 100, ModifiedBy: "admin"})
+	if err != nil {
+		t.Errorf("Expected no error when cost is unchanged, got %v", err)
+	}
+	if result == nil || !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+}
+
+func TestUpdateVoucher_PendingGiftCheckedUnderLock(t *testing.T) {
+	depth := 0
+	var steps []string
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, Name: "Old", CostInPoint: 100}, nil
+		},
+		findForUpdateFunc: func(id uint) (*voucher_model.Voucher, error) {
+			if depth == 0 {
+				t.Error("Expected the voucher to be locked inside the transaction")
+			}
+			steps = append(steps, "lock")
+			return &voucher_model.Voucher{ID: id, CostInPoint: 100}, nil
+		},
+	}
+	mockTransactionRepo := &MockTransactionRepo{
+		countPendingFunc: func(voucherId uint) (int64, error) {
+			if depth == 0 {
+				t.Error("Expected pending gifts to be counted inside the transaction")
+			}
+			steps = append(steps, "count")
+			return 0, nil
+		},
+	}
+
+	service := &VoucherService{
+		transactor:      DepthTransactor{depth: &depth},
+		voucherRepo:     mockVoucherRepo,
+		transactionRepo: mockTransactionRepo,
+		auditRepo:       &MockAuditRepo{},
+	}
+
+	result, err := service.UpdateVoucher(context.Background(), &pbVoucher.UpdateVoucherReq{Id: 1, Name: "Old", CostInPoint: 200, ModifiedBy: "admin"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+	if strings.Join(steps, ",") != "lock,count" {
+		t.Errorf("Expected the voucher to be locked before counting pending gifts, got %v", steps)
+	}
+}
+
+func TestDeleteVoucher_Success(t *testing.T) {
+	var deletedReason string
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id}, nil
+		},
+		deleteFunc: func(id uint, reason string, modifiedBy string) error {
+			deletedReason = reason
+			return nil
+		},
+	}
+	service := &VoucherService{transactor: MockTransactor{}, voucherRepo: mockVoucherRepo, auditRepo: &MockAuditRepo{}}
+
+	req := &pbVoucher.DeleteVoucherReq{Id: 1, Reason: "discontinued", ModifiedBy: "admin"}
+	result, err := service.DeleteVoucher(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.IsSuccess {
+		t.Error("Expected IsSuccess to be true")
+	}
+	if deletedReason != "discontinued" {
+		t.Errorf("Expected reason to be stored, got %s", deletedReason)
+	}
+}
+
+func TestDeleteVoucher_ReasonRequired(t *testing.T) {
+	service := &VoucherService{transactor: MockTransactor{}, voucherRepo: &MockVoucherRepo{}}
+
+	result, err := service.DeleteVoucher(context.Background(), &pbVoucher.DeleteVoucherReq{Id: 1, ModifiedBy: "admin"})
+
+	if err == nil {
+		t.Error("Expected validation error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestRestoreVoucher_Success(t *testing.T) {
+	restored := false
+	mockVoucherRepo := &MockVoucherRepo{
+		findDeletedFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, BrandID: 1, IsDeleted: true}, nil
+		},
+		restoreFunc: func(id uint, modifiedBy string) error {
+			restored = true
+			return nil
+		},
+	}
+	mockBrandRepo := &MockBrandRepo{
+		findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+			return &brand_model.Brand{ID: id}, nil
+		},
+	}
+	service := &VoucherService{transactor: MockTransactor{}, voucherRepo: mockVoucherRepo, brandRepo: mockBrandRepo, auditRepo: &MockAuditRepo{}}
+
+	result, err := service.RestoreVoucher(context.Background(), &pbVoucher.RestoreVoucherReq{Id: 1, ModifiedBy: "admin"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.IsSuccess || !restored {
+		t.Error("Expected voucher to be restored")
+	}
+}
+
+func TestRestoreVoucher_BrandDeleted(t *testing.T) {
+	mockVoucherRepo := &MockVoucherRepo{
+		findDeletedFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, BrandID: 1, IsDeleted: true}, nil
+		},
+		restoreFunc: func(id uint, modifiedBy string) error {
+			t.Error("Expected RestoreVoucher not to be called")
+			return nil
+		},
+	}
+	mockBrandRepo := &MockBrandRepo{
+		findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+			return nil, errors.New("record not found")
+		},
+	}
+	service := &VoucherService{transactor: MockTransactor{}, voucherRepo: mockVoucherRepo, brandRepo: mockBrandRepo, auditRepo: &MockAuditRepo{}}
+
+	result, err := service.RestoreVoucher(context.Background(), &pbVoucher.RestoreVoucherReq{Id: 1, ModifiedBy: "admin"})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestRestoreVoucher_NotDeleted(t *testing.T) {
+	mockVoucherRepo := &MockVoucherRepo{
+		findDeletedFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, BrandID: 1}, nil
+		},
+	}
+	service := &VoucherService{transactor: MockTransactor{}, voucherRepo: mockVoucherRepo, brandRepo: &MockBrandRepo{}}
+
+	result, err := service.RestoreVoucher(context.Background(), &pbVoucher.RestoreVoucherReq{Id: 1, ModifiedBy: "admin"})
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestUpdateVoucher_BrandOperator(t *testing.T) {
+	mockVoucherRepo := &MockVoucherRepo{
+		findByIdFunc: func(id uint) (*voucher_model.Voucher, error) {
+			return &voucher_model.Voucher{ID: id, BrandID: 2, Name: "Old", CostInPoint: 100}, nil
+		},
+	}
+	service := &VoucherService{
+		transactor:      MockTransactor{},
+		voucherRepo:     mockVoucherRepo,
+		transactionRepo: &MockTransactionRepo{},
+		auditRepo:       &MockAuditRepo{},
+	}
+	req := &pbVoucher.UpdateVoucherReq{Id: 1, Name: "New", CostInPoint: 100, ModifiedBy: "operator"}
+
+	owner := auth.WithCaller(context.Background(), &auth.Caller{ID: "operator", Role: constants.RoleBrandOperator, BrandID: 2})
+	if _, err := service.UpdateVoucher(owner, req); err != nil {
+		t.Errorf("Expected operator of the brand to update, got %v", err)
+	}
+
+	other := auth.WithCaller(context.Background(), &auth.Caller{ID: "operator", Role: constants.RoleBrandOperator, BrandID: 3})
+	result, err := service.UpdateVoucher(other, req)
+	if !errors.Is(err, error_base.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+	if result == nil || result.IsSuccess {
+		t.Error("Expected IsSuccess to be false")
+	}
+}
+
+func TestCreateVoucher_BrandOperatorOtherBrand(t *testing.T) {
+	mockBrandRepo := &MockBrandRepo{
+		findByIdFunc: func(id uint) (*brand_model.Brand, error) {
+			return &brand_model.Brand{ID: id}, nil
+		},
+	}
+	mockVoucherRepo := &MockVoucherRepo{
+		createVoucherFunc: func(voucher *voucher_model.Voucher) error {
+			t.Error("Expected CreateVoucher not to be called")
+			return nil
+		},
+	}
+	service := &VoucherService{transactor: MockTransactor{}, voucherRepo: mockVoucherRepo, brandRepo: mockBrandRepo, categoryRepo: &MockCategoryRepo{}}
+
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{ID: "operator", Role: constants.RoleBrandOperator, BrandID: 3})
+	req := &pbVoucher.CreateVoucherReq{BrandId: 1, Name: "Voucher", CostInPoint: 100, VoucherCode: "V-1"}
+	_, err := service.CreateVoucher(ctx, req)
+
+	if !errors.Is(err, error_base.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	result := NormalizeTags([]string{" Food ", "food", "", "DRINK"})
+
+	if len(result) != 2 || result[0] != "food" || result[1] != "drink" {
+		t.Errorf("Expected [food drink], got %v", result)
+	}
+}
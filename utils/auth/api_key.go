@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"customer-voucher-service/constants"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// ApiKeyAuthenticator resolves a partner API key to its caller. It returns
+// ErrInvalidCredentials for an unknown or revoked key.
+type ApiKeyAuthenticator interface {
+	AuthenticateApiKey(ctx context.Context, key string) (*Caller, error)
+}
+
+// GenerateApiKey returns a new key in the form cvs_<prefix>_<secret>. The prefix is
+// stored in plain text to look the key up, the whole key only as HashApiKey.
+func GenerateApiKey() (key string, prefix string, err error) {
+	prefixBytes := make([]byte, 6)
+	if _, err = rand.Read(prefixBytes); err != nil {
+		return "", "", err
+	}
+	secret := make([]byte, 32)
+	if _, err = rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	prefix = hex.EncodeToString(prefixBytes)
+	key = constants.ApiKeyPrefix + "_" + prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+	return key, prefix, nil
+}
+
+// HashApiKey is a plain SHA-256, which is enough for a random 256-bit secret.
+func HashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func ApiKeyLookupPrefix(key string) (string, bool) {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != constants.ApiKeyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
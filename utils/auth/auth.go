@@ -0,0 +1,196 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/utils/env"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AlgorithmHMAC = "HS256"
+	AlgorithmRSA  = "RS256"
+)
+
+// Caller is the authenticated identity behind a request.
+type Caller struct {
+	ID         string
+	Name       string
+	Role       string
+	BrandID    uint
+	CustomerID uint
+	// Scopes is only set for API key callers.
+	Scopes []string
+}
+
+type callerKey struct{}
+
+func WithCaller(ctx context.Context, caller *Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+func CallerFromContext(ctx context.Context) (*Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(*Caller)
+	return caller, ok && caller != nil
+}
+
+// Actor is the identity recorded in created_by/modified_by. The authenticated caller
+// wins over the value sent by the client, which is only used for internal calls.
+func Actor(ctx context.Context, fallback string) string {
+	if caller, ok := CallerFromContext(ctx); ok {
+		return caller.ID
+	}
+	return fallback
+}
+
+type Claims struct {
+	Name       string `json:"name,omitempty"`
+	Role       string `json:"role,omitempty"`
+	BrandID    uint   `json:"brandId,omitempty"`
+	CustomerID uint   `json:"customerId,omitempty"`
+	jwt.RegisteredClaims
+}
+
+type Config struct {
+	Algorithm string
+	Secret    []byte
+	PublicKey *rsa.PublicKey
+	Issuer    string
+	Audience  string
+	Leeway    time.Duration
+}
+
+// ConfigFromEnv reads JWT_ALGORITHM (HS256 or RS256), JWT_SECRET for HS256,
+// JWT_PUBLIC_KEY or JWT_PUBLIC_KEY_FILE (PEM) for RS256, and the optional
+// JWT_ISSUER, JWT_AUDIENCE and JWT_LEEWAY_SECONDS.
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Algorithm: env.GetString("JWT_ALGORITHM", AlgorithmHMAC),
+		Issuer:    env.GetString("JWT_ISSUER", ""),
+		Audience:  env.GetString("JWT_AUDIENCE", ""),
+		Leeway:    time.Duration(env.GetInt64("JWT_LEEWAY_SECONDS", 30)) * time.Second,
+	}
+	switch cfg.Algorithm {
+	case AlgorithmHMAC:
+		cfg.Secret = []byte(env.GetString("JWT_SECRET", ""))
+	case AlgorithmRSA:
+		pem := env.GetString("JWT_PUBLIC_KEY", "")
+		if path := env.GetString("JWT_PUBLIC_KEY_FILE", ""); pem == "" && path != "" {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return cfg, err
+			}
+			pem = string(content)
+		}
+		if pem == "" {
+			return cfg, errors.New("JWT_PUBLIC_KEY or JWT_PUBLIC_KEY_FILE is required for RS256")
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM([]byte(pem))
+		if err != nil {
+			return cfg, err
+		}
+		cfg.PublicKey = key
+	}
+	return cfg, nil
+}
+
+type Verifier struct {
+	key    interface{}
+	parser *jwt.Parser
+}
+
+func NewVerifier(cfg Config) (*Verifier, error) {
+	var key interface{}
+	switch cfg.Algorithm {
+	case AlgorithmHMAC:
+		if len(cfg.Secret) == 0 {
+			return nil, errors.New("JWT_SECRET is required for HS256")
+		}
+		key = cfg.Secret
+	case AlgorithmRSA:
+		if cfg.PublicKey == nil {
+			return nil, errors.New("an RSA public key is required for RS256")
+		}
+		key = cfg.PublicKey
+	default:
+		return nil, fmt.Errorf("unsupported JWT algorithm %q", cfg.Algorithm)
+	}
+
+	// Pinning the algorithm stops a token signed with another method, e.g. an
+	// HS256 token "signed" with the RSA public key, from being accepted.
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{cfg.Algorithm}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		options = append(options, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
+	return &Verifier{key: key, parser: jwt.NewParser(options...)}, nil
+}
+
+func NewVerifierFromEnv() (*Verifier, error) {
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return NewVerifier(cfg)
+}
+
+// Verify checks the token and maps its claims to a Caller. It returns
+// ErrInvalidCredentials for a bad token and ErrUserNotFound when the token has no subject.
+func (v *Verifier) Verify(token string) (*Caller, error) {
+	claims := &Claims{}
+	_, err := v.parser.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return v.key, nil
+	})
+	if err != nil {
+		return nil, error_base.ErrInvalidCredentials
+	}
+	if claims.Subject == "" {
+		return nil, error_base.ErrUserNotFound
+	}
+	return &Caller{
+		ID:         claims.Subject,
+		Name:       claims.Name,
+		Role:       claims.Role,
+		BrandID:    claims.BrandID,
+		CustomerID: claims.CustomerID,
+	}, nil
+}
+
+// BindCustomer binds an API key caller to the customer whose token it presents, which is
+// how a partner proves the customer is at the counter. Only customer tokens bind.
+func (v *Verifier) BindCustomer(caller *Caller, token string) (*Caller, error) {
+	customer, err := v.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+	if customer.Role != constants.RoleCustomer || customer.CustomerID == 0 {
+		return nil, error_base.ErrInvalidCredentials
+	}
+	bound := *caller
+	bound.CustomerID = customer.CustomerID
+	return &bound, nil
+}
+
+// BearerToken extracts the token from an "Authorization: Bearer <token>" header value.
+func BearerToken(header string) (string, bool) {
+	scheme, token, found := strings.Cut(strings.TrimSpace(header), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
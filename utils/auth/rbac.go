@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+)
+
+// rolePermissions is the permission model. Admins are not listed, they hold every
+// permission. Ownership (a brand operator's brand, a customer's own transactions)
+// is checked by the services with AuthorizeBrand and AuthorizeCustomer.
+var rolePermissions = map[string]map[string]bool{
+	constants.RoleBrandOperator: {
+		constants.PermissionBrandRead:    true,
+		constants.PermissionVoucherRead:  true,
+		constants.PermissionVoucherWrite: true,
+		constants.PermissionCategoryRead: true,
+		constants.PermissionApiKeyRead:   true,
+		constants.PermissionApiKeyWrite:  true,
+	},
+	constants.RoleCustomer: {
+		constants.PermissionBrandRead:         true,
+		constants.PermissionVoucherRead:       true,
+		constants.PermissionCategoryRead:      true,
+		constants.PermissionTransactionRead:   true,
+		constants.PermissionTransactionRedeem: true,
+	},
+}
+
+// scopePermissions is what each API key scope grants. API key callers get nothing
+// from their role, only from their key's scopes.
+var scopePermissions = map[string][]string{
+	constants.ScopeVouchersRead:  {constants.PermissionBrandRead, constants.PermissionVoucherRead, constants.PermissionCategoryRead},
+	constants.ScopeVouchersWrite: {constants.PermissionVoucherWrite},
+	constants.ScopeCodesBurn:     {constants.PermissionVoucherBurn},
+}
+
+func HasPermission(role string, permission string) bool {
+	if role == constants.RoleAdmin {
+		return true
+	}
+	return rolePermissions[role][permission]
+}
+
+func IsValidScope(scope string) bool {
+	_, ok := scopePermissions[scope]
+	return ok
+}
+
+// HasPermission checks the caller's role, or its scopes for an API key.
+func (c *Caller) HasPermission(permission string) bool {
+	if c.Role != constants.RoleApiKey {
+		return HasPermission(c.Role, permission)
+	}
+	for _, scope := range c.Scopes {
+		for _, granted := range scopePermissions[scope] {
+			if granted == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AuthorizeBrand lets admins through and brand operators and API keys only for their own brand.
+// Calls without a caller are internal and are not checked.
+func AuthorizeBrand(ctx context.Context, brandId uint) error {
+	caller, ok := CallerFromContext(ctx)
+	if !ok || caller.Role == constants.RoleAdmin {
+		return nil
+	}
+	isBrandBound := caller.Role == constants.RoleBrandOperator || caller.Role == constants.RoleApiKey
+	if isBrandBound && caller.BrandID != 0 && caller.BrandID == brandId {
+		return nil
+	}
+	return error_base.ErrForbidden
+}
+
+// AuthorizeCustomer lets admins through and customers only for themselves. An API key
+// that burns codes acts only for the customer whose token it was bound to with
+// BindCustomer, and AuthorizeBurn limits it to its brand's vouchers. Calls without a
+// caller are not checked.
+func AuthorizeCustomer(ctx context.Context, customerId uint) error {
+	caller, ok := CallerFromContext(ctx)
+	if !ok || caller.Role == constants.RoleAdmin {
+		return nil
+	}
+	if caller.Role == constants.RoleCustomer && caller.CustomerID != 0 && caller.CustomerID == customerId {
+		return nil
+	}
+	if caller.Role == constants.RoleApiKey && caller.HasPermission(constants.PermissionVoucherBurn) &&
+		caller.CustomerID != 0 && caller.CustomerID == customerId {
+		return nil
+	}
+	return error_base.ErrForbidden
+}
+
+// AuthorizeBurn limits API keys to burning the codes of their own brand's vouchers.
+// Other callers were already checked by AuthorizeCustomer.
+func AuthorizeBurn(ctx context.Context, brandId uint) error {
+	caller, ok := CallerFromContext(ctx)
+	if !ok || caller.Role != constants.RoleApiKey {
+		return nil
+	}
+	return AuthorizeBrand(ctx, brandId)
+}
@@ -0,0 +1,25 @@
+package env
+
+import (
+	"os"
+	"strconv"
+)
+
+func GetString(key string, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
+func GetInt64(key string, fallback int64) int64 {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fallback
+	}
+	return n
+}
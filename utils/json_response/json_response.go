@@ -1,13 +1,21 @@
 package json_response
 
-import "github.com/gin-gonic/gin"
+import (
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/utils/request_id"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+)
 
 type APIResponse struct {
-	CodeSystem   string      `json:"codeSystem"`
-	Code         string      `json:"code"`
-	Message      string      `json:"message,omitempty"`
-	MessageError string      `json:"messageError,omitempty"`
-	Result       interface{} `json:"result,omitempty"`
+	CodeSystem   string                  `json:"codeSystem"`
+	Code         string                  `json:"code"`
+	Message      string                  `json:"message,omitempty"`
+	MessageError string                  `json:"messageError,omitempty"`
+	RequestID    string                  `json:"requestId,omitempty"`
+	Errors       []error_base.FieldError `json:"errors,omitempty"`
+	Result       interface{}             `json:"result,omitempty"`
 }
 
 func Success(ctx *gin.Context, codeSystem string, result interface{}) {
@@ -15,17 +23,24 @@ func Success(ctx *gin.Context, codeSystem string, result interface{}) {
 		CodeSystem: codeSystem,
 		Code:       "00",
 		Message:    "success",
+		RequestID:  request_id.FromContext(ctx.Request.Context()),
 		Result:     result,
 	})
-	panic(nil)
 }
 
-func Error(ctx *gin.Context, codeSystem string, httpCode int, code string, message string) {
-	ctx.JSON(httpCode, APIResponse{
+// Error answers with the status and code of a domain error, and with a 500 for any
+// other error. It does not stop the handler chain, see gin.Context.Abort.
+func Error(ctx *gin.Context, codeSystem string, err error) {
+	var appErr error_base.AppError
+	if !errors.As(err, &appErr) {
+		appErr = error_base.ErrInternalServer
+	}
+	ctx.JSON(appErr.HttpCode, APIResponse{
 		CodeSystem:   codeSystem,
-		Code:         code,
-		MessageError: message,
+		Code:         appErr.Code,
+		MessageError: appErr.Message,
+		RequestID:    request_id.FromContext(ctx.Request.Context()),
+		Errors:       appErr.Fields,
 		Result:       "",
 	})
-	panic(nil)
 }
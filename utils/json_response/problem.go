@@ -0,0 +1,69 @@
+package json_response
+
+import (
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/utils/env"
+	"customer-voucher-service/utils/request_id"
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details.
+const ProblemContentType = "application/problem+json"
+
+// ProblemDetails is the RFC 7807 body of an error. Code, RequestID and Errors are
+// extension members.
+type ProblemDetails struct {
+	Type      string                  `json:"type"`
+	Title     string                  `json:"title"`
+	Status    int                     `json:"status"`
+	Detail    string                  `json:"detail,omitempty"`
+	Instance  string                  `json:"instance,omitempty"`
+	Code      string                  `json:"code"`
+	RequestID string                  `json:"requestId,omitempty"`
+	Errors    []error_base.FieldError `json:"errors,omitempty"`
+}
+
+// Result answers with the result alone, without the APIResponse envelope.
+func Result(ctx *gin.Context, result interface{}) {
+	ctx.JSON(200, result)
+}
+
+// Problem answers with the problem details of a domain error, and of a 500 for any
+// other error.
+func Problem(ctx *gin.Context, err error) {
+	var appErr error_base.AppError
+	if !errors.As(err, &appErr) {
+		appErr = error_base.ErrInternalServer
+	}
+	ctx.Header("Content-Type", ProblemContentType)
+	ctx.JSON(appErr.HttpCode, ProblemDetails{
+		Type:      ProblemType(appErr),
+		Title:     problemTitle(appErr),
+		Status:    appErr.HttpCode,
+		Detail:    appErr.Message,
+		Instance:  ctx.Request.URL.Path,
+		Code:      appErr.Code,
+		RequestID: request_id.FromContext(ctx.Request.Context()),
+		Errors:    appErr.Fields,
+	})
+}
+
+// ProblemType is the type URI of a domain error under PROBLEM_TYPE_BASE_URI, e.g.
+// /problems/not-found for error_base.ErrNotFound with the default base.
+func ProblemType(appErr error_base.AppError) string {
+	return env.GetString("PROBLEM_TYPE_BASE_URI", constants.DefaultProblemTypeBaseURI) + strings.ReplaceAll(strings.ToLower(appErr.Reason), "_", "-")
+}
+
+// problemTitle reads the reason, which is the same for every occurrence of a type,
+// e.g. "Insufficient points" for INSUFFICIENT_POINTS.
+func problemTitle(appErr error_base.AppError) string {
+	title := strings.ToLower(strings.ReplaceAll(appErr.Reason, "_", " "))
+	if title == "" {
+		return ""
+	}
+	return strings.ToUpper(title[:1]) + title[1:]
+}
@@ -0,0 +1,70 @@
+package pagination
+
+import (
+	"customer-voucher-service/constants"
+	"customer-voucher-service/constants/error_base"
+	"customer-voucher-service/constants/message"
+	"encoding/base64"
+	"encoding/json"
+)
+
+// Cursor points at the last row of the previous page. Value holds the sort
+// key of that row when a list is not ordered by id alone.
+type Cursor struct {
+	ID    uint   `json:"id"`
+	Value string `json:"value,omitempty"`
+}
+
+type Page struct {
+	Size   int
+	Cursor *Cursor
+}
+
+// NewPage validates the page size and decodes the page token of a List request.
+func NewPage(pageSize int32, pageToken string) (*Page, error) {
+	if pageSize < 0 || pageSize > constants.MaxPageSize {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.LimitMessage("pageSize", constants.MaxPageSize, constants.DefaultPageSize))
+	}
+	page := &Page{Size: int(pageSize)}
+	if page.Size == 0 {
+		page.Size = constants.DefaultPageSize
+	}
+	if pageToken != "" {
+		cursor, err := DecodeToken(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		page.Cursor = cursor
+	}
+	return page, nil
+}
+
+// Limit is the number of rows to fetch: one extra row tells whether a next page exists.
+func (p *Page) Limit() int {
+	return p.Size + 1
+}
+
+func EncodeToken(cursor Cursor) string {
+	b, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeToken(token string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("pageToken"))
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(b, &cursor); err != nil || cursor.ID == 0 {
+		return nil, error_base.ErrValidationFailed.WithMessage(message.InvalidFormatMessage("pageToken"))
+	}
+	return &cursor, nil
+}
+
+// Trim drops the extra row fetched by Limit and reports whether there is a next page.
+func Trim[T any](items []T, size int) ([]T, bool) {
+	if len(items) > size {
+		return items[:size], true
+	}
+	return items, false
+}
@@ -0,0 +1,210 @@
+package rate_limit
+
+import (
+	"context"
+	"customer-voucher-service/constants"
+	"customer-voucher-service/utils/env"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limit allows Requests per Period, in bursts of up to Requests. A Limit with no
+// Requests is disabled.
+type Limit struct {
+	Requests int64
+	Period   time.Duration
+}
+
+func (l Limit) Enabled() bool {
+	return l.Requests > 0 && l.Period > 0
+}
+
+type Result struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+// Store keeps the buckets. MemoryStore is enough for a single instance; a shared
+// store, e.g. on Redis, makes the limits hold across instances. Peek answers like
+// Allow without taking a token.
+type Store interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+	Peek(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// ParseLimit reads a limit written as "<requests>/<period>", e.g. "10/1m". A period
+// without a number is one of its unit, so "10/m" is "10/1m".
+func ParseLimit(value string) (Limit, bool) {
+	requestsStr, periodStr, ok := strings.Cut(strings.TrimSpace(value), "/")
+	if !ok {
+		return Limit{}, false
+	}
+	requests, err := strconv.ParseInt(requestsStr, 10, 64)
+	if err != nil || requests < 0 {
+		return Limit{}, false
+	}
+	if periodStr != "" && (periodStr[0] < '0' || periodStr[0] > '9') {
+		periodStr = "1" + periodStr
+	}
+	period, err := time.ParseDuration(periodStr)
+	if err != nil || period <= 0 {
+		return Limit{}, false
+	}
+	return Limit{Requests: requests, Period: period}, true
+}
+
+// LimitFromEnv reads a limit from key and falls back when it is unset or invalid.
+func LimitFromEnv(key string, fallback string) Limit {
+	if limit, ok := ParseLimit(env.GetString(key, fallback)); ok {
+		return limit
+	}
+	limit, _ := ParseLimit(fallback)
+	return limit
+}
+
+// RoutesFromEnv reads per-route limits written as "<route>=<limit>" and separated by
+// commas, e.g. "POST /api/v1/transaction/redemption=5/1m". A route is a REST method
+// and path or a gRPC full method name. Invalid entries are logged and skipped.
+func RoutesFromEnv(key string) map[string]Limit {
+	routes := map[string]Limit{}
+	value := env.GetString(key, "")
+	if value == "" {
+		return routes
+	}
+	for _, entry := range strings.Split(value, ",") {
+		route, limitStr, ok := strings.Cut(entry, "=")
+		route = strings.TrimSpace(route)
+		limit, valid := ParseLimit(limitStr)
+		if !ok || route == "" || !valid {
+			log.Printf("rate limit: ignoring %s entry %q", key, entry)
+			continue
+		}
+		routes[route] = limit
+	}
+	return routes
+}
+
+// MemoryStore is a token bucket per key, refilled continuously. Idle buckets are
+// dropped once they would be full again.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	period  time.Duration
+}
+
+const sweepInterval = time.Minute
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	return s.take(key, limit, 1)
+}
+
+func (s *MemoryStore) Peek(ctx context.Context, key string, limit Limit) (Result, error) {
+	return s.take(key, limit, 0)
+}
+
+// take refills the bucket of key and removes cost tokens if one is left.
+func (s *MemoryStore) take(key string, limit Limit, cost float64) (Result, error) {
+	if !limit.Enabled() {
+		return Result{Allowed: true}, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+	capacity := float64(limit.Requests)
+	perSecond := capacity / limit.Period.Seconds()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, updated: now}
+		s.buckets[key] = b
+	}
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.updated).Seconds()*perSecond)
+	b.updated, b.period = now, limit.Period
+	if b.tokens >= 1 {
+		b.tokens -= cost
+		return Result{Allowed: true}, nil
+	}
+	wait := (1 - b.tokens) / perSecond
+	return Result{Allowed: false, RetryAfter: time.Duration(wait * float64(time.Second))}, nil
+}
+
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if now.Sub(b.updated) >= b.period {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// Limiter applies a named policy, e.g. constants.RateLimitRedemption, with a bucket
+// per policy and caller.
+type Limiter struct {
+	store  Store
+	limits map[string]Limit
+}
+
+func NewLimiter(store Store, limits map[string]Limit) *Limiter {
+	return &Limiter{store: store, limits: limits}
+}
+
+// NewLimiterFromEnv reads each policy from RATE_LIMIT_<POLICY>. Every route in
+// RATE_LIMIT_ROUTES becomes a policy of its own, named after the route.
+func NewLimiterFromEnv(store Store) *Limiter {
+	limits := map[string]Limit{
+		constants.RateLimitRedemption:  LimitFromEnv("RATE_LIMIT_REDEMPTION", constants.DefaultRateLimitRedemption),
+		constants.RateLimitWrite:       LimitFromEnv("RATE_LIMIT_WRITE", constants.DefaultRateLimitWrite),
+		constants.RateLimitAuthFailure: LimitFromEnv("RATE_LIMIT_AUTH_FAILURE", constants.DefaultRateLimitAuthFailure),
+	}
+	for route, limit := range RoutesFromEnv("RATE_LIMIT_ROUTES") {
+		limits[route] = limit
+	}
+	return NewLimiter(store, limits)
+}
+
+// HasPolicy tells whether policy has a limit, including a disabled one.
+func (l *Limiter) HasPolicy(policy string) bool {
+	_, ok := l.limits[policy]
+	return ok
+}
+
+// Allow lets requests through for policies without a limit.
+func (l *Limiter) Allow(ctx context.Context, policy string, key string) (Result, error) {
+	limit, ok := l.limits[policy]
+	if !ok || !limit.Enabled() {
+		return Result{Allowed: true}, nil
+	}
+	return l.store.Allow(ctx, policy+":"+key, limit)
+}
+
+// Peek tells whether Allow would let a request through, without counting it.
+func (l *Limiter) Peek(ctx context.Context, policy string, key string) (Result, error) {
+	limit, ok := l.limits[policy]
+	if !ok || !limit.Enabled() {
+		return Result{Allowed: true}, nil
+	}
+	return l.store.Peek(ctx, policy+":"+key, limit)
+}
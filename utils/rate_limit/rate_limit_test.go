@@ -0,0 +1,119 @@
+package rate_limit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestStore(now *time.Time) *MemoryStore {
+	store := NewMemoryStore()
+	store.now = func() time.Time { return *now }
+	return store
+}
+
+func TestMemoryStore_TokenBucket(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := newTestStore(&now)
+	limit := Limit{Requests: 2, Period: time.Minute}
+
+	for i := 0; i < 2; i++ {
+		result, err := store.Allow(context.Background(), "customer:1", limit)
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+	}
+	result, _ := store.Allow(context.Background(), "customer:1", limit)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 30*time.Second, result.RetryAfter)
+
+	// Other keys have their own bucket.
+	result, _ = store.Allow(context.Background(), "customer:2", limit)
+	assert.True(t, result.Allowed)
+
+	now = now.Add(30 * time.Second)
+	result, _ = store.Allow(context.Background(), "customer:1", limit)
+	assert.True(t, result.Allowed)
+	result, _ = store.Allow(context.Background(), "customer:1", limit)
+	assert.False(t, result.Allowed)
+}
+
+func TestMemoryStore_Peek(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := newTestStore(&now)
+	limit := Limit{Requests: 1, Period: time.Minute}
+
+	for i := 0; i < 3; i++ {
+		result, err := store.Peek(context.Background(), "ip:1", limit)
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+	}
+	store.Allow(context.Background(), "ip:1", limit)
+	result, _ := store.Peek(context.Background(), "ip:1", limit)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, time.Minute, result.RetryAfter)
+}
+
+func TestMemoryStore_SweepsIdleBuckets(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := newTestStore(&now)
+	limit := Limit{Requests: 1, Period: time.Second}
+
+	store.Allow(context.Background(), "ip:1", limit)
+	now = now.Add(2 * time.Minute)
+	store.Allow(context.Background(), "ip:2", limit)
+
+	assert.Len(t, store.buckets, 1)
+}
+
+func TestParseLimit(t *testing.T) {
+	limit, ok := ParseLimit("10/1m")
+	assert.True(t, ok)
+	assert.Equal(t, Limit{Requests: 10, Period: time.Minute}, limit)
+
+	limit, ok = ParseLimit("0/1m")
+	assert.True(t, ok)
+	assert.False(t, limit.Enabled())
+
+	for _, value := range []string{"", "10", "ten/1m", "-1/1m", "10/0s", "10/soon"} {
+		_, ok := ParseLimit(value)
+		assert.False(t, ok, value)
+	}
+}
+
+func TestLimiter_SeparatesPolicies(t *testing.T) {
+	limiter := NewLimiter(NewMemoryStore(), map[string]Limit{
+		"redemption": {Requests: 1, Period: time.Minute},
+		"write":      {Requests: 0, Period: time.Minute},
+	})
+
+	result, _ := limiter.Allow(context.Background(), "redemption", "customer:1")
+	assert.True(t, result.Allowed)
+	result, _ = limiter.Allow(context.Background(), "redemption", "customer:1")
+	assert.False(t, result.Allowed)
+
+	for i := 0; i < 5; i++ {
+		result, _ = limiter.Allow(context.Background(), "write", "customer:1")
+		assert.True(t, result.Allowed)
+		result, _ = limiter.Allow(context.Background(), "unknown", "customer:1")
+		assert.True(t, result.Allowed)
+	}
+}
+
+func TestParseLimit_BareUnit(t *testing.T) {
+	limit, ok := ParseLimit("10/m")
+	assert.True(t, ok)
+	assert.Equal(t, Limit{Requests: 10, Period: time.Minute}, limit)
+}
+
+func TestRoutesFromEnv(t *testing.T) {
+	t.Setenv("RATE_LIMIT_ROUTES", "POST /api/v1/transaction/redemption=5/m, /transaction.TransactionService/TransactionRedeemPoint=3/1h,broken,PUT /x=abc")
+
+	routes := RoutesFromEnv("RATE_LIMIT_ROUTES")
+
+	assert.Equal(t, map[string]Limit{
+		"POST /api/v1/transaction/redemption":                    {Requests: 5, Period: time.Minute},
+		"/transaction.TransactionService/TransactionRedeemPoint": {Requests: 3, Period: time.Hour},
+	}, routes)
+}
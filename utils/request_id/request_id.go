@@ -0,0 +1,34 @@
+package request_id
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+type requestIDKey struct{}
+
+// maxLength caps a request ID sent by the client so it fits the audit_log column.
+const maxLength = 64
+
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Resolve keeps the ID the client sent, or generates one when it is missing or too long.
+func Resolve(id string) string {
+	if id != "" && len(id) <= maxLength {
+		return id
+	}
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,42 @@
+package transactor
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Transactor runs fn in one database transaction, rolled back when fn returns an error.
+// Repos scoped with WithContext to the ctx passed to fn take part in the transaction, so
+// a service can group writes to several repos.
+type Transactor interface {
+	Run(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type txKey struct{}
+
+type GormTransactor struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) *GormTransactor {
+	return &GormTransactor{
+		db: db,
+	}
+}
+
+// Run nests in the transaction ctx already runs in, if any, with a savepoint.
+func (t *GormTransactor) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	return DB(ctx, t.db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// DB returns the transaction ctx runs in, or db when there is none, scoped to ctx so
+// the audit callbacks can stamp the caller. Every repo's WithContext is built on it.
+func DB(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -1,44 +1,196 @@
 package validator
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"reflect"
+	"regexp"
 	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
+	"customer-voucher-service/constants/error_base"
 	"customer-voucher-service/constants/message"
 
 	"github.com/go-playground/validator/v10"
 )
 
-func ValidateReqField(req interface{}) error {
+var voucherCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]*$`)
+
+type customValidation struct {
+	fn      validator.Func
+	message func(label string) string
+}
+
+// customValidations are the tags this service adds to the validator, with the message
+// of a field that fails them.
+var customValidations = map[string]customValidation{
+	"vouchercode": {
+		fn: func(fl validator.FieldLevel) bool {
+			return voucherCodePattern.MatchString(fl.Field().String())
+		},
+		message: message.VoucherCodeMessage,
+	},
+}
+
+var validate = newValidate()
+
+func newValidate() *validator.Validate {
 	v := validator.New()
-	val := reflect.ValueOf(req)
+	for tag, custom := range customValidations {
+		if err := v.RegisterValidation(tag, custom.fn); err != nil {
+			panic(err)
+		}
+	}
+	return v
+}
+
+// ValidateReqField checks every field of req and returns all the violations at once,
+// in error_base.AppError.Fields. The message joins the message of each field.
+func ValidateReqField(req interface{}) error {
 	typ := reflect.TypeOf(req)
 	if typ.Kind() == reflect.Ptr {
 		typ = typ.Elem()
-		val = val.Elem()
-	}
-	if err := v.Struct(req); err != nil {
-		for _, fieldErr := range err.(validator.ValidationErrors) {
-			field, _ := typ.FieldByName(fieldErr.Field())
-			label := field.Tag.Get("label")
-			if label == "" {
-				label = fieldErr.Field()
-			}
-			switch fieldErr.Tag() {
-			case "required":
-				return errors.New(message.RequiredMessage(label))
-			case "email":
-				return errors.New(message.EmailMessage(label))
-			case "max":
-				param := fieldErr.Param()
-				return errors.New(message.MaxLengthMessage(label, toInt(param)))
-				// Tambahkan case lain sesuai kebutuhan
-			}
+	}
+	err := validate.Struct(req)
+	if err == nil {
+		return nil
+	}
+	validationErrs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return error_base.ErrValidationFailed.WithMessage("invalid request")
+	}
+	return validationFailed(validationErrs, typ)
+}
+
+// Rule checks the fields of one gRPC request before the service runs.
+type Rule func(ctx context.Context, req interface{}) error
+
+// Rules are the Rule of each method, by gRPC full method name.
+type Rules map[string]Rule
+
+// RuleFor turns the check a service runs on its own request into a Rule. A request of
+// another type is not checked.
+func RuleFor[T any](check func(ctx context.Context, req T) error) Rule {
+	return func(ctx context.Context, req interface{}) error {
+		if r, ok := req.(T); ok {
+			return check(ctx, r)
+		}
+		return nil
+	}
+}
+
+// BindError turns the error of binding a request body, e.g. with c.ShouldBindJSON,
+// into a validation error. A value of the wrong JSON type and failed binding tags are
+// listed in Fields like the errors of ValidateReqField.
+func BindError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		msg := message.InvalidTypeMessage(typeErr.Field, jsonKind(typeErr.Type))
+		return error_base.ErrValidationFailed.WithMessage(msg).WithFields(error_base.FieldError{
+			Field:   typeErr.Field,
+			Rule:    "type",
+			Message: msg,
+		})
+	}
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return validationFailed(validationErrs, nil)
+	}
+	return error_base.ErrValidationFailed.WithMessage("invalid request body")
+}
+
+// validationFailed reads the labels from the fields of typ, if known.
+func validationFailed(validationErrs validator.ValidationErrors, typ reflect.Type) error {
+	fields := []error_base.FieldError{}
+	messages := []string{}
+	for _, fieldErr := range validationErrs {
+		// Elements of a slice are named e.g. Tags[0] and share the tags of Tags.
+		name := fieldErr.StructField()
+		if i := strings.IndexByte(name, '['); i >= 0 {
+			name = name[:i]
 		}
-		return errors.New("invalid request")
+		var label string
+		if typ != nil {
+			field, _ := typ.FieldByName(name)
+			label = field.Tag.Get("label")
+		}
+		if label == "" {
+			label = fieldErr.Field()
+		}
+		msg := fieldMessage(fieldErr, label)
+		fields = append(fields, error_base.FieldError{
+			Field:   jsonName(fieldErr.Field()),
+			Rule:    fieldErr.Tag(),
+			Message: msg,
+		})
+		messages = append(messages, msg)
+	}
+	return error_base.ErrValidationFailed.WithMessage(strings.Join(messages, "; ")).WithFields(fields...)
+}
+
+func fieldMessage(fieldErr validator.FieldError, label string) string {
+	param := fieldErr.Param()
+	switch fieldErr.Tag() {
+	case "required":
+		return message.RequiredMessage(label)
+	case "email":
+		return message.EmailMessage(label)
+	case "url":
+		return message.URLMessage(label)
+	case "oneof":
+		return message.OneOfMessage(label, strings.Fields(param))
+	case "min", "gte":
+		switch fieldErr.Kind() {
+		case reflect.String:
+			return message.MinLengthMessage(label, toInt(param))
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return message.MinItemsMessage(label, toInt(param))
+		}
+		return message.GteMessage(label, param)
+	case "max", "lte":
+		switch fieldErr.Kind() {
+		case reflect.String:
+			return message.MaxLengthMessage(label, toInt(param))
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return message.MaxItemsMessage(label, toInt(param))
+		}
+		return message.LteMessage(label, param)
+	}
+	if custom, ok := customValidations[fieldErr.Tag()]; ok {
+		return custom.message(label)
+	}
+	return message.InvalidFormatMessage(label)
+}
+
+// jsonName turns a field name into its name in the request body, e.g. CustomerId
+// into customerId.
+func jsonName(field string) string {
+	r, size := utf8.DecodeRuneInString(field)
+	return string(unicode.ToLower(r)) + field[size:]
+}
+
+// jsonKind names the JSON value a Go type is read from, e.g. "an integer" for int32.
+func jsonKind(typ reflect.Type) string {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Slice, reflect.Array:
+		return "an array"
 	}
-	return nil
+	return "an object"
 }
 
 func toInt(s string) int {
@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"customer-voucher-service/constants/error_base"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testReq struct {
+	Name        string   `validate:"required,max=5"`
+	Email       string   `validate:"omitempty,email"`
+	Code        string   `validate:"min=3,vouchercode" label:"voucher code"`
+	Quantity    int64    `validate:"gte=1,lte=10"`
+	SortOrder   string   `validate:"omitempty,oneof=asc desc"`
+	CallbackUrl string   `validate:"omitempty,url"`
+	Tags        []string `validate:"max=2,dive,required"`
+}
+
+func TestValidateReqField_Valid(t *testing.T) {
+	req := testReq{Name: "Bob", Code: "COF-1", Quantity: 1, SortOrder: "asc", CallbackUrl: "https://example.com/hook", Tags: []string{"food"}}
+	assert.NoError(t, ValidateReqField(req))
+	assert.NoError(t, ValidateReqField(&req))
+}
+
+func TestValidateReqField_AllViolations(t *testing.T) {
+	req := testReq{
+		Name:        "Robert",
+		Email:       "bob",
+		Code:        "cof",
+		Quantity:    11,
+		SortOrder:   "up",
+		CallbackUrl: "not a url",
+		Tags:        []string{"food", "", "drink"},
+	}
+	err := ValidateReqField(req)
+
+	var appErr error_base.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.True(t, errors.Is(err, error_base.ErrValidationFailed))
+	assert.Equal(t, []error_base.FieldError{
+		{Field: "name", Rule: "max", Message: "Name must be at most 5 characters"},
+		{Field: "email", Rule: "email", Message: "Email must be a valid email address"},
+		{Field: "code", Rule: "vouchercode", Message: "voucher code must contain only uppercase letters, digits, dashes and underscores"},
+		{Field: "quantity", Rule: "lte", Message: "Quantity must be at most 10"},
+		{Field: "sortOrder", Rule: "oneof", Message: "SortOrder must be one of asc, desc"},
+		{Field: "callbackUrl", Rule: "url", Message: "CallbackUrl must be a valid URL"},
+		{Field: "tags", Rule: "max", Message: "Tags must have at most 2 items"},
+	}, appErr.Fields)
+	assert.Equal(t, "Name must be at most 5 characters; Email must be a valid email address; "+
+		"voucher code must contain only uppercase letters, digits, dashes and underscores; Quantity must be at most 10; "+
+		"SortOrder must be one of asc, desc; CallbackUrl must be a valid URL; Tags must have at most 2 items", appErr.Message)
+}
+
+func TestValidateReqField_MinAndDive(t *testing.T) {
+	err := ValidateReqField(testReq{Name: "Bob", Code: "AB", Quantity: 0, Tags: []string{""}})
+
+	var appErr error_base.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, []error_base.FieldError{
+		{Field: "code", Rule: "min", Message: "voucher code must be at least 3 characters"},
+		{Field: "quantity", Rule: "gte", Message: "Quantity must be at least 1"},
+		{Field: "tags[0]", Rule: "required", Message: "Tags[0] is required"},
+	}, appErr.Fields)
+}
+
+func TestBindError(t *testing.T) {
+	var req struct {
+		Quantity int64 `json:"quantity"`
+	}
+	err := BindError(json.Unmarshal([]byte(`{"quantity": "two"}`), &req))
+
+	var appErr error_base.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, error_base.ErrValidationFailed.Code, appErr.Code)
+	assert.Equal(t, []error_base.FieldError{{Field: "quantity", Rule: "type", Message: "quantity must be an integer"}}, appErr.Fields)
+
+	err = BindError(validate.Struct(testReq{Code: "COF", Quantity: 1}))
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, []error_base.FieldError{{Field: "name", Rule: "required", Message: "Name is required"}}, appErr.Fields)
+
+	err = BindError(json.Unmarshal([]byte(`{"quantity":`), &req))
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, error_base.ErrValidationFailed.Code, appErr.Code)
+	assert.Empty(t, appErr.Fields)
+}